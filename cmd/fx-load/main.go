@@ -0,0 +1,70 @@
+// Command fx-load loads dated FX rates from a CSV or JSON file into the
+// fx_rates table, replacing existing rates for the same pair and date.
+//
+//	fx-load -file rates.csv
+//	fx-load -file rates.json -format json
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/grpc-buf/internal/config"
+	"github.com/grpc-buf/internal/fx"
+	"github.com/grpc-buf/internal/postgres"
+)
+
+const loadTimeout = 2 * time.Minute
+
+func main() {
+	file := flag.String("file", "", "path to the rates file (required)")
+	format := flag.String("format", "", "csv or json; defaults to the file extension")
+	flag.Parse()
+
+	cfg, err := config.Bootstrap()
+	if err != nil {
+		slog.Error("configuration error", "error", err)
+		os.Exit(1)
+	}
+	if *file == "" {
+		slog.Error("-file is required")
+		os.Exit(2)
+	}
+	if *format == "" {
+		*format = strings.TrimPrefix(filepath.Ext(*file), ".")
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		slog.Error("failed to open rates file", "error", err)
+		os.Exit(1)
+	}
+	rates, err := fx.Parse(*format, f)
+	f.Close()
+	if err != nil {
+		slog.Error("failed to parse rates file", "error", err, "file", *file)
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), loadTimeout)
+	defer cancel()
+
+	store, err := postgres.NewDatabaseConnectionFromConfig(ctx, cfg)
+	if err != nil {
+		slog.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	defer store.Close()
+
+	n, err := store.LoadFXRates(ctx, rates)
+	if err != nil {
+		slog.Error("failed to load rates", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("Loaded FX rates", "count", n, "file", *file)
+}
@@ -0,0 +1,108 @@
+// Command reconcile matches settled payments against a processor settlement
+// CSV, read with the reconciliation column mapping from the config, and
+// writes a CSV or JSON report of matched, missing and mismatched rows.
+//
+//	reconcile -file settlement.csv -out report.csv
+//	reconcile -file settlement.csv -out report.json -from 2026-09-01 -to 2026-09-30
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/grpc-buf/internal/config"
+	"github.com/grpc-buf/internal/postgres"
+	"github.com/grpc-buf/internal/reconcile"
+)
+
+const reconcileTimeout = 5 * time.Minute
+
+func main() {
+	file := flag.String("file", "", "path to the settlement CSV (required)")
+	out := flag.String("out", "", "report path; defaults to stdout")
+	format := flag.String("format", "", "csv or json; defaults to the -out extension, else csv")
+	from := flag.String("from", "", "first payment day (YYYY-MM-DD); defaults to the earliest settlement date")
+	to := flag.String("to", "", "last payment day (YYYY-MM-DD); defaults to the latest settlement date")
+	flag.Parse()
+
+	cfg, err := config.Bootstrap()
+	if err != nil {
+		slog.Error("configuration error", "error", err)
+		os.Exit(1)
+	}
+	if *file == "" {
+		slog.Error("-file is required")
+		os.Exit(2)
+	}
+	if *format == "" {
+		*format = strings.TrimPrefix(filepath.Ext(*out), ".")
+		if *format != reconcile.FormatJSON {
+			*format = reconcile.FormatCSV
+		}
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		slog.Error("failed to open settlement file", "error", err)
+		os.Exit(1)
+	}
+	entries, err := reconcile.ParseCSV(f, cfg.Reconciliation)
+	f.Close()
+	if err != nil {
+		slog.Error("failed to parse settlement file", "error", err, "file", *file)
+		os.Exit(1)
+	}
+	start, end := reconcile.Span(entries)
+	for _, d := range []struct {
+		flag string
+		dst  *time.Time
+	}{{*from, &start}, {*to, &end}} {
+		if d.flag == "" {
+			continue
+		}
+		if *d.dst, err = time.Parse(time.DateOnly, d.flag); err != nil {
+			slog.Error("invalid date", "value", d.flag)
+			os.Exit(2)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), reconcileTimeout)
+	defer cancel()
+
+	store, err := postgres.NewDatabaseConnectionFromConfig(ctx, cfg)
+	if err != nil {
+		slog.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	defer store.Close()
+
+	report, err := store.Reconcile(ctx, entries, start, end)
+	if err != nil {
+		slog.Error("failed to reconcile", "error", err)
+		os.Exit(1)
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		of, err := os.Create(*out)
+		if err != nil {
+			slog.Error("failed to create report", "error", err)
+			os.Exit(1)
+		}
+		defer of.Close()
+		w = of
+	}
+	if err := reconcile.Write(*format, w, report); err != nil {
+		slog.Error("failed to write report", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("Settlement reconciled", "rows", len(entries), "matched", report.Matched,
+		"missing_ours", report.MissingOurs, "missing_provider", report.MissingProvider,
+		"amount_mismatch", report.Mismatched)
+}
@@ -0,0 +1,56 @@
+// Package auth carries the authenticated caller through a request context.
+// JWTAuthInterceptor attaches the caller after it verifies a bearer token;
+// service and store code reads it back with UserFromContext. The helpers do
+// not depend on Connect, so transports without bearer tokens, such as MCP,
+// can attach a caller with WithUser.
+package auth
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/grpc-buf/internal/security"
+)
+
+// User is the authenticated caller of a request.
+type User struct {
+	// Subject is the user id (the token's sub claim).
+	Subject string
+	// Roles are the roles granted to the user when the token was issued.
+	Roles []string
+	// TokenID is the access token's jti; empty for callers not
+	// authenticated by token.
+	TokenID string
+	// ExpiresAt is when the access token expires; zero when unknown.
+	ExpiresAt time.Time
+}
+
+// HasRole reports whether u was granted role.
+func (u User) HasRole(role string) bool {
+	return slices.Contains(u.Roles, role)
+}
+
+type userKey struct{}
+
+// WithUser returns a copy of ctx carrying u.
+func WithUser(ctx context.Context, u User) context.Context {
+	return context.WithValue(ctx, userKey{}, u)
+}
+
+// UserFromContext returns the caller attached to ctx and whether there is
+// one. Unauthenticated procedures (see security.auth_skip_suffixes) have no
+// caller.
+func UserFromContext(ctx context.Context) (User, bool) {
+	u, ok := ctx.Value(userKey{}).(User)
+	return u, ok
+}
+
+// UserFromClaims returns the caller described by verified token claims.
+func UserFromClaims(c *security.Claims) User {
+	u := User{Subject: c.Subject, Roles: slices.Clone(c.Roles), TokenID: c.ID}
+	if c.ExpiresAt != nil {
+		u.ExpiresAt = c.ExpiresAt.Time
+	}
+	return u
+}
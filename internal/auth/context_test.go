@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/grpc-buf/internal/security"
+)
+
+func TestUserFromContext(t *testing.T) {
+	if _, ok := UserFromContext(context.Background()); ok {
+		t.Fatal("empty context has a user")
+	}
+
+	exp := time.Now().Add(time.Minute).Truncate(time.Second)
+	claims := &security.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "u1", ID: "jti1", ExpiresAt: jwt.NewNumericDate(exp)},
+		Roles:            []string{"admin"},
+	}
+	ctx := WithUser(context.Background(), UserFromClaims(claims))
+
+	u, ok := UserFromContext(ctx)
+	if !ok {
+		t.Fatal("user missing from context")
+	}
+	if u.Subject != "u1" || u.TokenID != "jti1" || !u.ExpiresAt.Equal(exp) {
+		t.Fatalf("unexpected user %+v", u)
+	}
+	if !u.HasRole("admin") || u.HasRole("auditor") {
+		t.Fatalf("unexpected roles %v", u.Roles)
+	}
+}
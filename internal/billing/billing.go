@@ -0,0 +1,146 @@
+// Package billing computes invoice totals from line items in integer minor
+// units, so every amount is exact in its currency.
+//
+// A line's subtotal is quantity × unit price. An invoice-level discount is
+// spread over the lines in proportion to their subtotals and taken off before
+// tax. Each line's tax is its discounted subtotal times its tax rate, rounded
+// to the currency's minor unit with halves away from zero; the invoice's tax
+// is the sum of the rounded line taxes.
+package billing
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/grpc-buf/internal/money"
+)
+
+// MaxRateBps is the largest tax or discount rate, in basis points (100%).
+const MaxRateBps = 10000
+
+var (
+	// ErrNoLines reports an invoice without line items.
+	ErrNoLines = errors.New("at least one line item is required")
+	// ErrInvalidLine reports a line with a non-positive quantity, a negative
+	// unit price or a tax rate outside [0, MaxRateBps].
+	ErrInvalidLine = errors.New("invalid line item")
+	// ErrInvalidDiscount reports a discount with both or neither of a rate and
+	// an amount, a rate outside (0, MaxRateBps], or an amount that is not
+	// positive or exceeds the subtotal.
+	ErrInvalidDiscount = errors.New("invalid discount")
+)
+
+// Line is an invoice line item. UnitPrice is in minor units and TaxRateBps in
+// basis points (2000 is 20%).
+type Line struct {
+	Quantity   int64
+	UnitPrice  int64
+	TaxRateBps int64
+}
+
+// Discount is an invoice-level discount given either as a rate in basis
+// points or as a fixed amount in minor units. The zero value is no discount.
+type Discount struct {
+	RateBps   int64
+	AmountOff int64
+}
+
+// Amounts holds computed amounts in minor units. Total is Subtotal minus
+// Discount plus Tax.
+type Amounts struct {
+	Subtotal int64
+	Discount int64
+	Tax      int64
+	Total    int64
+}
+
+// Totals is the result of Compute: one Amounts per line, in input order, and
+// their sums for the invoice.
+type Totals struct {
+	Lines []Amounts
+	Amounts
+}
+
+// Compute validates lines and d and returns the line and invoice amounts.
+func Compute(lines []Line, d Discount) (Totals, error) {
+	if len(lines) == 0 {
+		return Totals{}, ErrNoLines
+	}
+	out := Totals{Lines: make([]Amounts, len(lines))}
+	weights := make([]int64, len(lines))
+	for i, l := range lines {
+		if l.Quantity <= 0 || l.UnitPrice < 0 || l.TaxRateBps < 0 || l.TaxRateBps > MaxRateBps {
+			return Totals{}, fmt.Errorf("%w: line %d", ErrInvalidLine, i+1)
+		}
+		sub, err := money.MulMinor(l.UnitPrice, l.Quantity)
+		if err != nil {
+			return Totals{}, err
+		}
+		out.Lines[i].Subtotal = sub
+		weights[i] = sub
+		if out.Subtotal, err = add(out.Subtotal, sub); err != nil {
+			return Totals{}, err
+		}
+	}
+
+	discount, err := discountAmount(out.Subtotal, d)
+	if err != nil {
+		return Totals{}, err
+	}
+	if discount > 0 {
+		shares, err := money.AllocateMinor(discount, weights)
+		if err != nil {
+			return Totals{}, err
+		}
+		for i := range out.Lines {
+			out.Lines[i].Discount = shares[i]
+		}
+	}
+
+	for i, l := range lines {
+		a := &out.Lines[i]
+		net := a.Subtotal - a.Discount
+		if a.Tax, err = money.ApplyBasisPoints(net, l.TaxRateBps); err != nil {
+			return Totals{}, err
+		}
+		if a.Total, err = add(net, a.Tax); err != nil {
+			return Totals{}, err
+		}
+		out.Discount += a.Discount
+		if out.Tax, err = add(out.Tax, a.Tax); err != nil {
+			return Totals{}, err
+		}
+		if out.Total, err = add(out.Total, a.Total); err != nil {
+			return Totals{}, err
+		}
+	}
+	return out, nil
+}
+
+// discountAmount resolves d against subtotal.
+func discountAmount(subtotal int64, d Discount) (int64, error) {
+	switch {
+	case d.RateBps == 0 && d.AmountOff == 0:
+		return 0, nil
+	case d.RateBps != 0 && d.AmountOff != 0:
+		return 0, fmt.Errorf("%w: set a rate or an amount, not both", ErrInvalidDiscount)
+	case d.RateBps != 0:
+		if d.RateBps < 0 || d.RateBps > MaxRateBps {
+			return 0, fmt.Errorf("%w: rate must be between 1 and %d basis points", ErrInvalidDiscount, MaxRateBps)
+		}
+		return money.ApplyBasisPoints(subtotal, d.RateBps)
+	default:
+		if d.AmountOff < 0 || d.AmountOff > subtotal {
+			return 0, fmt.Errorf("%w: amount must be positive and at most the subtotal", ErrInvalidDiscount)
+		}
+		return d.AmountOff, nil
+	}
+}
+
+func add(a, b int64) (int64, error) {
+	c := a + b
+	if (b > 0 && c < a) || (b < 0 && c > a) {
+		return 0, money.ErrOverflow
+	}
+	return c, nil
+}
@@ -0,0 +1,87 @@
+package billing
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		name      string
+		lines     []Line
+		discount  Discount
+		wantLines []Amounts
+		want      Amounts
+	}{
+		{
+			name:      "single taxed line",
+			lines:     []Line{{Quantity: 2, UnitPrice: 1000, TaxRateBps: 2000}},
+			wantLines: []Amounts{{Subtotal: 2000, Tax: 400, Total: 2400}},
+			want:      Amounts{Subtotal: 2000, Tax: 400, Total: 2400},
+		},
+		{
+			name:      "half minor unit of tax rounds up",
+			lines:     []Line{{Quantity: 1, UnitPrice: 125, TaxRateBps: 1000}},
+			wantLines: []Amounts{{Subtotal: 125, Tax: 13, Total: 138}},
+			want:      Amounts{Subtotal: 125, Tax: 13, Total: 138},
+		},
+		{
+			name: "rate discount spread before tax",
+			lines: []Line{
+				{Quantity: 1, UnitPrice: 1000, TaxRateBps: 2000},
+				{Quantity: 3, UnitPrice: 333},
+			},
+			discount: Discount{RateBps: 1000},
+			wantLines: []Amounts{
+				{Subtotal: 1000, Discount: 101, Tax: 180, Total: 1079},
+				{Subtotal: 999, Discount: 99, Total: 900},
+			},
+			want: Amounts{Subtotal: 1999, Discount: 200, Tax: 180, Total: 1979},
+		},
+		{
+			name:      "amount discount",
+			lines:     []Line{{Quantity: 1, UnitPrice: 1000, TaxRateBps: 825}},
+			discount:  Discount{AmountOff: 500},
+			wantLines: []Amounts{{Subtotal: 1000, Discount: 500, Tax: 41, Total: 541}},
+			want:      Amounts{Subtotal: 1000, Discount: 500, Tax: 41, Total: 541},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Compute(tt.lines, tt.discount)
+			if err != nil {
+				t.Fatalf("Compute: %v", err)
+			}
+			if got.Amounts != tt.want {
+				t.Errorf("totals = %+v, want %+v", got.Amounts, tt.want)
+			}
+			if !reflect.DeepEqual(got.Lines, tt.wantLines) {
+				t.Errorf("lines = %+v, want %+v", got.Lines, tt.wantLines)
+			}
+		})
+	}
+}
+
+func TestComputeRejects(t *testing.T) {
+	one := []Line{{Quantity: 1, UnitPrice: 1000}}
+	tests := []struct {
+		name     string
+		lines    []Line
+		discount Discount
+		want     error
+	}{
+		{"no lines", nil, Discount{}, ErrNoLines},
+		{"zero quantity", []Line{{Quantity: 0, UnitPrice: 1}}, Discount{}, ErrInvalidLine},
+		{"negative price", []Line{{Quantity: 1, UnitPrice: -1}}, Discount{}, ErrInvalidLine},
+		{"tax above 100%", []Line{{Quantity: 1, UnitPrice: 1, TaxRateBps: 10001}}, Discount{}, ErrInvalidLine},
+		{"both discounts", one, Discount{RateBps: 100, AmountOff: 1}, ErrInvalidDiscount},
+		{"discount above subtotal", one, Discount{AmountOff: 1001}, ErrInvalidDiscount},
+		{"negative discount rate", one, Discount{RateBps: -1}, ErrInvalidDiscount},
+	}
+	for _, tt := range tests {
+		if _, err := Compute(tt.lines, tt.discount); !errors.Is(err, tt.want) {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
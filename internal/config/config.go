@@ -3,10 +3,15 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/netip"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
+	"time"
 
+	"github.com/grpc-buf/internal/money"
+	"github.com/grpc-buf/internal/vault"
 	"github.com/kelseyhightower/envconfig"
 	"gopkg.in/yaml.v3"
 )
@@ -32,13 +37,215 @@ type SecurityConfig struct {
 	JWTIssuer        string   `yaml:"jwt_issuer" envconfig:"JWT_ISSUER"`
 	JWTAudience      string   `yaml:"jwt_audience" envconfig:"JWT_AUDIENCE"`
 	AuthSkipSuffixes []string `yaml:"auth_skip_suffixes" envconfig:"AUTH_SKIP_SUFFIXES"`
+	// AccessTokenTTL is the lifetime of JWT access tokens (e.g. "15m").
+	AccessTokenTTL string `yaml:"access_token_ttl" envconfig:"ACCESS_TOKEN_TTL"`
+	// RefreshTokenTTL is the lifetime of refresh tokens (e.g. "720h"). Each
+	// refresh issues a replacement with a fresh lifetime.
+	RefreshTokenTTL string `yaml:"refresh_token_ttl" envconfig:"REFRESH_TOKEN_TTL"`
+	// RefreshTokenPruneInterval is how often expired refresh tokens are
+	// deleted (e.g. "1h").
+	RefreshTokenPruneInterval string `yaml:"refresh_token_prune_interval" envconfig:"REFRESH_TOKEN_PRUNE_INTERVAL"`
+	// Denylist stores revoked access tokens.
+	Denylist DenylistConfig `yaml:"denylist" envconfig:"DENYLIST"`
+	// Authorization is the role-based access policy enforced after
+	// authentication.
+	Authorization AuthorizationConfig `yaml:"authorization" envconfig:"AUTHORIZATION"`
+}
+
+// PermissionAll, granted to a role, grants every permission.
+const PermissionAll = "*"
+
+// AuthorizationConfig maps roles to permissions and procedures to the
+// permission they require. Entries are merged over the defaults set in Load.
+// Every procedure that requires authentication must resolve to a permission;
+// the server refuses to start otherwise.
+type AuthorizationConfig struct {
+	// Roles maps each role to the permissions it grants.
+	Roles map[string][]string `yaml:"roles" envconfig:"ROLES"`
+	// Procedures maps a procedure ("/rpc.user.v1.UserService/LoginUser") or
+	// every procedure of a service ("/rpc.user.v1.UserService/*") to the
+	// permission it requires. Exact entries take precedence over service
+	// entries.
+	Procedures map[string]string `yaml:"procedures" envconfig:"PROCEDURES"`
+}
+
+// Token denylist backends.
+const (
+	DenylistPostgres = "postgres"
+	DenylistMemory   = "memory"
+)
+
+// DenylistConfig selects where revoked access tokens are recorded.
+type DenylistConfig struct {
+	// Backend is DenylistPostgres (shared by all replicas; the default) or
+	// DenylistMemory (per process, lost on restart).
+	Backend string `yaml:"backend" envconfig:"BACKEND"`
+	// PruneInterval is how often expired entries are dropped (e.g. "5m").
+	PruneInterval string `yaml:"prune_interval" envconfig:"PRUNE_INTERVAL"`
+}
+
+type PaymentsConfig struct {
+	IdempotencyTTL string `yaml:"idempotency_ttl" envconfig:"IDEMPOTENCY_TTL"`
+	// IdempotencyPruneInterval is how often expired idempotency keys are
+	// deleted (e.g. "1h").
+	IdempotencyPruneInterval string          `yaml:"idempotency_prune_interval" envconfig:"IDEMPOTENCY_PRUNE_INTERVAL"`
+	Processor                ProcessorConfig `yaml:"processor" envconfig:"PROCESSOR"`
+	Fraud                    FraudConfig     `yaml:"fraud" envconfig:"FRAUD"`
+	Vault                    VaultConfig     `yaml:"vault" envconfig:"VAULT"`
+	// DisputeEvidenceWindow is the default time allowed for adding evidence
+	// to a new dispute (e.g. "168h").
+	DisputeEvidenceWindow string `yaml:"dispute_evidence_window" envconfig:"DISPUTE_EVIDENCE_WINDOW"`
+}
+
+// VaultConfig configures the card vault behind TokenizationService.
+type VaultConfig struct {
+	// Key is the base64-encoded 32-byte AES key card numbers are encrypted
+	// with. Tokenization is disabled while it is empty.
+	Key string `yaml:"key" envconfig:"KEY"`
+	// AllowUnknownTokens lets payments use card tokens the vault did not
+	// issue, such as the fake processor's magic tokens. Vault tokens are
+	// still checked for expiry.
+	AllowUnknownTokens bool `yaml:"allow_unknown_tokens" envconfig:"ALLOW_UNKNOWN_TOKENS"`
+}
+
+// Fraud rule actions. An empty action means FraudActionDeny.
+const (
+	FraudActionDeny   = "deny"
+	FraudActionReview = "review"
+)
+
+// FraudConfig holds the rules MakePayment screens each attempt with before
+// charging. A rule left at its zero value is off. Windows use
+// time.ParseDuration syntax.
+type FraudConfig struct {
+	// MaxAmount caps a single payment per currency code.
+	MaxAmount AmountRule `yaml:"max_amount" envconfig:"MAX_AMOUNT"`
+	// TokenVelocity caps the attempts per card_token within the window.
+	TokenVelocity VelocityRule `yaml:"token_velocity" envconfig:"TOKEN_VELOCITY"`
+	// IPTokens caps the distinct card tokens tried from one client IP within
+	// the window.
+	IPTokens VelocityRule `yaml:"ip_tokens" envconfig:"IP_TOKENS"`
+	// BlockedNames matches the cardholder name against regular expressions,
+	// case-insensitively.
+	BlockedNames PatternRule `yaml:"blocked_names" envconfig:"BLOCKED_NAMES"`
+	// TrustedProxies lists the addresses or CIDR ranges of proxies whose
+	// X-Forwarded-For and X-Real-IP headers name the client for IPTokens.
+	// Other callers are identified by their peer address.
+	TrustedProxies []string `yaml:"trusted_proxies" envconfig:"TRUSTED_PROXIES"`
+	// OnError decides payments whose checks cannot be completed because the
+	// attempt history cannot be read or recorded: FraudActionDeny (the
+	// default) refuses them, FraudActionReview charges them for review.
+	OnError string `yaml:"on_error" envconfig:"ON_ERROR"`
+}
+
+// AmountRule limits payment amounts. Limits maps currency codes to decimal
+// amounts such as "5000.00".
+type AmountRule struct {
+	Action string            `yaml:"action" envconfig:"ACTION"`
+	Limits map[string]string `yaml:"limits" envconfig:"LIMITS"`
+}
+
+// VelocityRule allows at most Max events per Window; Max 0 disables it.
+type VelocityRule struct {
+	Action string `yaml:"action" envconfig:"ACTION"`
+	Max    int    `yaml:"max" envconfig:"MAX"`
+	Window string `yaml:"window" envconfig:"WINDOW"`
+}
+
+// PatternRule matches text against regular expressions.
+type PatternRule struct {
+	Action   string   `yaml:"action" envconfig:"ACTION"`
+	Patterns []string `yaml:"patterns" envconfig:"PATTERNS"`
+}
+
+// ProcessorConfig selects the payment processor the datastore charges cards
+// through. "fake" is the only built-in provider.
+type ProcessorConfig struct {
+	Provider    string `yaml:"provider" envconfig:"PROVIDER"`
+	FakeLatency string `yaml:"fake_latency" envconfig:"FAKE_LATENCY"`
+	// AllowFake permits the fake provider in production, where it would
+	// report charges as captured without moving any money.
+	AllowFake bool `yaml:"allow_fake" envconfig:"ALLOW_FAKE"`
+	// WebhookSecret verifies events POSTed by the provider; the webhook
+	// endpoint is disabled while it is empty.
+	WebhookSecret string `yaml:"webhook_secret" envconfig:"WEBHOOK_SECRET"`
+	// WebhookTolerance is how far an event timestamp may be from now.
+	WebhookTolerance string `yaml:"webhook_tolerance" envconfig:"WEBHOOK_TOLERANCE"`
+}
+
+// DunningConfig schedules overdue detection and payment reminders for
+// invoices with a due date. Interval uses time.ParseDuration syntax.
+type DunningConfig struct {
+	// ReminderDays are the days after the due date on which reminders are
+	// sent, in increasing order. Empty disables reminders.
+	ReminderDays []int  `yaml:"reminder_days" envconfig:"REMINDER_DAYS"`
+	Interval     string `yaml:"interval" envconfig:"INTERVAL"`
+	BatchSize    int    `yaml:"batch_size" envconfig:"BATCH_SIZE"`
+}
+
+// SubscriptionsConfig tunes the scheduler that invoices subscriptions.
+// Interval and AutoPayRetryInterval use time.ParseDuration syntax.
+type SubscriptionsConfig struct {
+	Interval  string `yaml:"interval" envconfig:"INTERVAL"`
+	BatchSize int    `yaml:"batch_size" envconfig:"BATCH_SIZE"`
+	// AutoPayRetryInterval is the wait before an invoice payment that failed
+	// for a reason other than a decline is attempted again.
+	AutoPayRetryInterval string `yaml:"autopay_retry_interval" envconfig:"AUTOPAY_RETRY_INTERVAL"`
+	// AutoPayMaxAttempts bounds the payment attempts per invoice; after the
+	// last one the invoice is left to dunning.
+	AutoPayMaxAttempts int `yaml:"autopay_max_attempts" envconfig:"AUTOPAY_MAX_ATTEMPTS"`
+}
+
+// ReconciliationConfig describes the processor's settlement CSV files.
+type ReconciliationConfig struct {
+	Columns SettlementColumns `yaml:"columns" envconfig:"COLUMNS"`
+	// DateLayout is the Go time layout of the date column, time.DateOnly
+	// when empty.
+	DateLayout string `yaml:"date_layout" envconfig:"DATE_LAYOUT"`
+	// AmountMinorUnits is set when amounts are integer minor units (cents)
+	// rather than decimals.
+	AmountMinorUnits bool `yaml:"amount_minor_units" envconfig:"AMOUNT_MINOR_UNITS"`
+}
+
+// SettlementColumns names the header of each settlement field; empty names
+// fall back to the field's own name. Reference is the processor's payment
+// reference, compared with payments.processor_ref.
+type SettlementColumns struct {
+	Reference string `yaml:"reference" envconfig:"REFERENCE"`
+	Amount    string `yaml:"amount" envconfig:"AMOUNT"`
+	Currency  string `yaml:"currency" envconfig:"CURRENCY"`
+	Date      string `yaml:"date" envconfig:"DATE"`
+}
+
+// WebhooksConfig tunes outbound webhook delivery. Durations use
+// time.ParseDuration syntax.
+type WebhooksConfig struct {
+	// MaxAttempts is the number of attempts before a delivery is FAILED.
+	MaxAttempts int `yaml:"max_attempts" envconfig:"MAX_ATTEMPTS"`
+	// InitialBackoff is the delay after the first failure; it doubles after
+	// each further failure up to MaxBackoff.
+	InitialBackoff string `yaml:"initial_backoff" envconfig:"INITIAL_BACKOFF"`
+	MaxBackoff     string `yaml:"max_backoff" envconfig:"MAX_BACKOFF"`
+	// Timeout bounds each HTTP attempt.
+	Timeout      string `yaml:"timeout" envconfig:"TIMEOUT"`
+	PollInterval string `yaml:"poll_interval" envconfig:"POLL_INTERVAL"`
+	BatchSize    int    `yaml:"batch_size" envconfig:"BATCH_SIZE"`
+	// AllowPrivateTargets accepts http endpoint URLs and loopback, private
+	// and link-local hosts. It is meant for local development; otherwise
+	// endpoints must be public https URLs.
+	AllowPrivateTargets bool `yaml:"allow_private_targets" envconfig:"ALLOW_PRIVATE_TARGETS"`
 }
 
 type Config struct {
-	Environment string         `yaml:"environment" envconfig:"ENVIRONMENT"`
-	Server      ServerConfig   `yaml:"server" envconfig:"SERVER"`
-	Database    DatabaseConfig `yaml:"database" envconfig:"DATABASE"`
-	Security    SecurityConfig `yaml:"security" envconfig:"SECURITY"`
+	Environment    string               `yaml:"environment" envconfig:"ENVIRONMENT"`
+	Server         ServerConfig         `yaml:"server" envconfig:"SERVER"`
+	Database       DatabaseConfig       `yaml:"database" envconfig:"DATABASE"`
+	Security       SecurityConfig       `yaml:"security" envconfig:"SECURITY"`
+	Payments       PaymentsConfig       `yaml:"payments" envconfig:"PAYMENTS"`
+	Webhooks       WebhooksConfig       `yaml:"webhooks" envconfig:"WEBHOOKS"`
+	Dunning        DunningConfig        `yaml:"dunning" envconfig:"DUNNING"`
+	Subscriptions  SubscriptionsConfig  `yaml:"subscriptions" envconfig:"SUBSCRIPTIONS"`
+	Reconciliation ReconciliationConfig `yaml:"reconciliation" envconfig:"RECONCILIATION"`
 }
 
 // Load hydrates configuration from an optional YAML file and environment variables.
@@ -58,7 +265,70 @@ func Load(path string) (*Config, error) {
 			ConnectTimeout: "60s",
 		},
 		Security: SecurityConfig{
-			AuthSkipSuffixes: []string{"/RegisterUser", "/LoginUser"},
+			AuthSkipSuffixes:          []string{"/RegisterUser", "/LoginUser", "/RefreshToken"},
+			AccessTokenTTL:            "15m",
+			RefreshTokenTTL:           "720h",
+			RefreshTokenPruneInterval: "1h",
+			Denylist:                  DenylistConfig{Backend: DenylistPostgres, PruneInterval: "5m"},
+			Authorization: AuthorizationConfig{
+				Roles: map[string][]string{
+					"user":    {"payments.use", "cards.tokenize", "expenses.use", "subscriptions.use", "sessions.logout"},
+					"finance": {"payments.manage", "payments.refund", "disputes.resolve", "invoices.mark_paid", "settlements.reconcile", "ledger.read"},
+					"admin":   {PermissionAll},
+				},
+				Procedures: map[string]string{
+					"/rpc.payment.v1.PaymentService/*":                   "payments.manage",
+					"/rpc.payment.v1.PaymentService/MakePayment":         "payments.use",
+					"/rpc.payment.v1.PaymentService/PayInvoice":          "payments.use",
+					"/rpc.payment.v1.PaymentService/PayInvoices":         "payments.use",
+					"/rpc.payment.v1.PaymentService/RefundPayment":       "payments.refund",
+					"/rpc.payment.v1.PaymentService/CreateRefund":        "payments.refund",
+					"/rpc.payment.v1.PaymentService/ResolveDispute":      "disputes.resolve",
+					"/rpc.payment.v1.PaymentService/MarkInvoicePaid":     "invoices.mark_paid",
+					"/rpc.payment.v1.PaymentService/ReconcileSettlement": "settlements.reconcile",
+					"/rpc.payment.v1.PaymentService/GetLedgerBalances":   "ledger.read",
+					"/rpc.tokenization.v1.TokenizationService/*":         "cards.tokenize",
+					"/rpc.expense.v1.ExpenseService/*":                   "expenses.use",
+					"/rpc.subscription.v1.SubscriptionService/*":         "subscriptions.use",
+					"/rpc.webhook.v1.WebhookService/*":                   "webhooks.manage",
+					"/rpc.user.v1.UserService/LogoutUser":                "sessions.logout",
+					"/rpc.user.v1.UserService/RevokeUserSessions":        "sessions.revoke",
+				},
+			},
+		},
+		Payments: PaymentsConfig{
+			IdempotencyTTL:           "24h",
+			IdempotencyPruneInterval: "1h",
+			Processor:                ProcessorConfig{Provider: "fake", WebhookTolerance: "5m"},
+			DisputeEvidenceWindow:    "168h",
+		},
+		Webhooks: WebhooksConfig{
+			MaxAttempts:    8,
+			InitialBackoff: "30s",
+			MaxBackoff:     "1h",
+			Timeout:        "10s",
+			PollInterval:   "5s",
+			BatchSize:      20,
+		},
+		Dunning: DunningConfig{
+			ReminderDays: []int{3, 7, 14},
+			Interval:     "1m",
+			BatchSize:    100,
+		},
+		Subscriptions: SubscriptionsConfig{
+			Interval:             "1m",
+			BatchSize:            50,
+			AutoPayRetryInterval: "5m",
+			AutoPayMaxAttempts:   5,
+		},
+		Reconciliation: ReconciliationConfig{
+			Columns: SettlementColumns{
+				Reference: "reference",
+				Amount:    "amount",
+				Currency:  "currency",
+				Date:      "date",
+			},
+			DateLayout: time.DateOnly,
 		},
 	}
 	if strings.TrimSpace(path) != "" {
@@ -79,6 +349,8 @@ func Load(path string) (*Config, error) {
 	}
 	cfg.Database.URL = os.ExpandEnv(cfg.Database.URL)
 	cfg.Security.JWTSecret = os.ExpandEnv(cfg.Security.JWTSecret)
+	cfg.Payments.Processor.WebhookSecret = os.ExpandEnv(cfg.Payments.Processor.WebhookSecret)
+	cfg.Payments.Vault.Key = os.ExpandEnv(cfg.Payments.Vault.Key)
 	return &cfg, nil
 }
 
@@ -96,18 +368,280 @@ func (c *Config) Validate() error {
 	if c.Server.Port <= 0 || c.Server.Port > 65535 {
 		return fmt.Errorf("invalid server.port: %d", c.Server.Port)
 	}
+	if v := strings.TrimSpace(c.Payments.IdempotencyTTL); v != "" {
+		if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+			return fmt.Errorf("invalid payments.idempotency_ttl: %q", v)
+		}
+	}
+	if v := strings.TrimSpace(c.Payments.IdempotencyPruneInterval); v != "" {
+		if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+			return fmt.Errorf("invalid payments.idempotency_prune_interval: %q", v)
+		}
+	}
+	switch p := strings.ToLower(strings.TrimSpace(c.Payments.Processor.Provider)); p {
+	case "", "fake":
+	default:
+		return fmt.Errorf("unknown payments.processor.provider: %q", p)
+	}
+	if v := strings.TrimSpace(c.Payments.Processor.FakeLatency); v != "" {
+		if d, err := time.ParseDuration(v); err != nil || d < 0 {
+			return fmt.Errorf("invalid payments.processor.fake_latency: %q", v)
+		}
+	}
+	if v := strings.TrimSpace(c.Payments.Processor.WebhookTolerance); v != "" {
+		if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+			return fmt.Errorf("invalid payments.processor.webhook_tolerance: %q", v)
+		}
+	}
+	if v := strings.TrimSpace(c.Payments.DisputeEvidenceWindow); v != "" {
+		if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+			return fmt.Errorf("invalid payments.dispute_evidence_window: %q", v)
+		}
+	}
+	if err := c.Payments.Fraud.validate(); err != nil {
+		return err
+	}
+	if k := strings.TrimSpace(c.Payments.Vault.Key); k != "" {
+		if _, err := vault.NewCipher(k); err != nil {
+			return fmt.Errorf("invalid payments.vault.key: %w", err)
+		}
+	}
+	if err := c.Webhooks.validate(); err != nil {
+		return err
+	}
+	if err := c.Dunning.validate(); err != nil {
+		return err
+	}
+	if err := c.Subscriptions.validate(); err != nil {
+		return err
+	}
+	if err := c.Reconciliation.validate(); err != nil {
+		return err
+	}
+	for name, v := range map[string]string{
+		"security.access_token_ttl":             c.Security.AccessTokenTTL,
+		"security.refresh_token_ttl":            c.Security.RefreshTokenTTL,
+		"security.refresh_token_prune_interval": c.Security.RefreshTokenPruneInterval,
+		"security.denylist.prune_interval":      c.Security.Denylist.PruneInterval,
+	} {
+		if v = strings.TrimSpace(v); v != "" {
+			if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+				return fmt.Errorf("invalid %s: %q", name, v)
+			}
+		}
+	}
+	switch b := strings.ToLower(strings.TrimSpace(c.Security.Denylist.Backend)); b {
+	case "", DenylistPostgres, DenylistMemory:
+	default:
+		return fmt.Errorf("unknown security.denylist.backend: %q", b)
+	}
+	if err := c.Security.validateAuthorization(); err != nil {
+		return err
+	}
 	if strings.TrimSpace(c.Security.JWTSecret) == "" {
 		if s := strings.TrimSpace(os.Getenv("JWT_SECRET")); s != "" {
 			c.Security.JWTSecret = s
 		}
 	}
-	if strings.EqualFold(c.Environment, "production") {
+	if c.isProduction() {
 		if strings.TrimSpace(c.Database.URL) == "" {
 			return fmt.Errorf("database.url is required in production")
 		}
 		if strings.TrimSpace(c.Security.JWTSecret) == "" {
 			return fmt.Errorf("security.jwt_secret is required in production")
 		}
+		if strings.TrimSpace(c.Payments.Vault.Key) == "" {
+			return fmt.Errorf("payments.vault.key is required in production")
+		}
+		// Every accepted provider is the fake one, which approves charges
+		// without moving money.
+		if !c.Payments.Processor.AllowFake {
+			return fmt.Errorf("the fake payment processor is not allowed in production unless payments.processor.allow_fake is set")
+		}
+	}
+	return nil
+}
+
+// isProduction reports whether c is for the production environment, under
+// either of the names ConfigPath accepts for it.
+func (c *Config) isProduction() bool {
+	switch strings.ToLower(strings.TrimSpace(c.Environment)) {
+	case "prod", "production":
+		return true
+	}
+	return false
+}
+
+// procedurePattern matches security.authorization.procedures keys; whether
+// they name registered procedures is checked at server startup.
+var procedurePattern = regexp.MustCompile(`^/[A-Za-z_][A-Za-z0-9_.]*/(\*|[A-Za-z_][A-Za-z0-9_]*)$`)
+
+func (s SecurityConfig) validateAuthorization() error {
+	a := s.Authorization
+	granted := map[string]bool{}
+	for role, perms := range a.Roles {
+		if strings.TrimSpace(role) == "" {
+			return fmt.Errorf("security.authorization.roles: empty role name")
+		}
+		for _, p := range perms {
+			if strings.TrimSpace(p) == "" {
+				return fmt.Errorf("security.authorization.roles.%s: empty permission", role)
+			}
+			granted[p] = true
+		}
+	}
+	for proc, perm := range a.Procedures {
+		if !procedurePattern.MatchString(proc) {
+			return fmt.Errorf("security.authorization.procedures: invalid procedure %q", proc)
+		}
+		if strings.TrimSpace(perm) == "" {
+			return fmt.Errorf("security.authorization.procedures[%s]: empty permission", proc)
+		}
+		if !granted[perm] && !granted[PermissionAll] {
+			return fmt.Errorf("security.authorization.procedures[%s]: permission %q is granted by no role", proc, perm)
+		}
+		for _, suf := range s.AuthSkipSuffixes {
+			if strings.HasSuffix(proc, suf) {
+				return fmt.Errorf("security.authorization.procedures[%s]: procedure skips authentication (auth_skip_suffixes)", proc)
+			}
+		}
+	}
+	return nil
+}
+
+func (f FraudConfig) validate() error {
+	for _, a := range []struct{ name, action string }{
+		{"max_amount", f.MaxAmount.Action},
+		{"token_velocity", f.TokenVelocity.Action},
+		{"ip_tokens", f.IPTokens.Action},
+		{"blocked_names", f.BlockedNames.Action},
+	} {
+		switch strings.ToLower(strings.TrimSpace(a.action)) {
+		case "", FraudActionDeny, FraudActionReview:
+		default:
+			return fmt.Errorf("invalid payments.fraud.%s.action: %q", a.name, a.action)
+		}
+	}
+	switch strings.ToLower(strings.TrimSpace(f.OnError)) {
+	case "", FraudActionDeny, FraudActionReview:
+	default:
+		return fmt.Errorf("invalid payments.fraud.on_error: %q", f.OnError)
+	}
+	for currency, limit := range f.MaxAmount.Limits {
+		if v, err := money.ParseMinor(limit, currency); err != nil || v <= 0 {
+			return fmt.Errorf("invalid payments.fraud.max_amount.limits.%s: %q", currency, limit)
+		}
+	}
+	for _, v := range []struct {
+		name string
+		rule VelocityRule
+	}{
+		{"token_velocity", f.TokenVelocity},
+		{"ip_tokens", f.IPTokens},
+	} {
+		if v.rule.Max < 0 {
+			return fmt.Errorf("invalid payments.fraud.%s.max: %d", v.name, v.rule.Max)
+		}
+		if v.rule.Max == 0 {
+			continue
+		}
+		if w, err := time.ParseDuration(strings.TrimSpace(v.rule.Window)); err != nil || w <= 0 {
+			return fmt.Errorf("invalid payments.fraud.%s.window: %q", v.name, v.rule.Window)
+		}
+	}
+	for _, p := range f.BlockedNames.Patterns {
+		if _, err := regexp.Compile(p); err != nil {
+			return fmt.Errorf("invalid payments.fraud.blocked_names pattern %q: %w", p, err)
+		}
+	}
+	for _, p := range f.TrustedProxies {
+		p = strings.TrimSpace(p)
+		_, perr := netip.ParsePrefix(p)
+		if _, aerr := netip.ParseAddr(p); aerr != nil && perr != nil {
+			return fmt.Errorf("invalid payments.fraud.trusted_proxies entry %q", p)
+		}
+	}
+	return nil
+}
+
+func (w WebhooksConfig) validate() error {
+	if w.MaxAttempts < 0 {
+		return fmt.Errorf("invalid webhooks.max_attempts: %d", w.MaxAttempts)
+	}
+	if w.BatchSize < 0 {
+		return fmt.Errorf("invalid webhooks.batch_size: %d", w.BatchSize)
+	}
+	for _, f := range []struct{ name, value string }{
+		{"initial_backoff", w.InitialBackoff},
+		{"max_backoff", w.MaxBackoff},
+		{"timeout", w.Timeout},
+		{"poll_interval", w.PollInterval},
+	} {
+		v := strings.TrimSpace(f.value)
+		if v == "" {
+			continue
+		}
+		if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+			return fmt.Errorf("invalid webhooks.%s: %q", f.name, v)
+		}
+	}
+	return nil
+}
+
+func (d DunningConfig) validate() error {
+	for i, days := range d.ReminderDays {
+		if days <= 0 || (i > 0 && days <= d.ReminderDays[i-1]) {
+			return fmt.Errorf("invalid dunning.reminder_days: %v (must be positive and increasing)", d.ReminderDays)
+		}
+	}
+	if d.BatchSize < 0 {
+		return fmt.Errorf("invalid dunning.batch_size: %d", d.BatchSize)
+	}
+	if v := strings.TrimSpace(d.Interval); v != "" {
+		if iv, err := time.ParseDuration(v); err != nil || iv <= 0 {
+			return fmt.Errorf("invalid dunning.interval: %q", v)
+		}
+	}
+	return nil
+}
+
+func (sc SubscriptionsConfig) validate() error {
+	if sc.BatchSize < 0 {
+		return fmt.Errorf("invalid subscriptions.batch_size: %d", sc.BatchSize)
+	}
+	if sc.AutoPayMaxAttempts < 0 {
+		return fmt.Errorf("invalid subscriptions.autopay_max_attempts: %d", sc.AutoPayMaxAttempts)
+	}
+	for name, v := range map[string]string{
+		"subscriptions.interval":               sc.Interval,
+		"subscriptions.autopay_retry_interval": sc.AutoPayRetryInterval,
+	} {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+		if iv, err := time.ParseDuration(v); err != nil || iv <= 0 {
+			return fmt.Errorf("invalid %s: %q", name, v)
+		}
+	}
+	return nil
+}
+
+func (r ReconciliationConfig) validate() error {
+	seen := map[string]string{}
+	for _, col := range []struct{ name, value string }{
+		{"reference", r.Columns.Reference},
+		{"amount", r.Columns.Amount},
+		{"currency", r.Columns.Currency},
+		{"date", r.Columns.Date},
+	} {
+		v := strings.ToLower(strings.TrimSpace(col.value))
+		if v == "" {
+			continue
+		}
+		if other, ok := seen[v]; ok {
+			return fmt.Errorf("reconciliation.columns.%s and .%s both name column %q", other, col.name, col.value)
+		}
+		seen[v] = col.name
 	}
 	return nil
 }
@@ -76,5 +76,242 @@ security:
 	require.Equal(t, 8080, cfg.Server.Port)
 	require.Equal(t, "postgres://interpolated/grpcbuf", cfg.Database.URL)
 	require.Equal(t, "fromenv", cfg.Security.JWTSecret)
-	require.ElementsMatch(t, []string{"/RegisterUser", "/LoginUser"}, cfg.Security.AuthSkipSuffixes)
+	require.ElementsMatch(t, []string{"/RegisterUser", "/LoginUser", "/RefreshToken"}, cfg.Security.AuthSkipSuffixes)
+	require.Equal(t, "15m", cfg.Security.AccessTokenTTL)
+	require.Equal(t, "720h", cfg.Security.RefreshTokenTTL)
+	require.Equal(t, "1h", cfg.Security.RefreshTokenPruneInterval)
+	require.Equal(t, "24h", cfg.Payments.IdempotencyTTL)
+	require.Equal(t, "1h", cfg.Payments.IdempotencyPruneInterval)
+	require.Equal(t, "fake", cfg.Payments.Processor.Provider)
+	require.Equal(t, "168h", cfg.Payments.DisputeEvidenceWindow)
+}
+
+func TestValidateRejectsBadIdempotencyTTL(t *testing.T) {
+	cfg := &Config{Server: ServerConfig{Port: 8080}, Payments: PaymentsConfig{IdempotencyTTL: "soon"}}
+	require.Error(t, cfg.Validate())
+
+	cfg.Payments.IdempotencyTTL = "1h"
+	require.NoError(t, cfg.Validate())
+
+	cfg.Payments.IdempotencyPruneInterval = "-1m"
+	require.ErrorContains(t, cfg.Validate(), "payments.idempotency_prune_interval")
+}
+
+func TestValidateDisputeEvidenceWindow(t *testing.T) {
+	cfg := &Config{Server: ServerConfig{Port: 8080}, Payments: PaymentsConfig{DisputeEvidenceWindow: "72h"}}
+	require.NoError(t, cfg.Validate())
+
+	cfg.Payments.DisputeEvidenceWindow = "0s"
+	require.ErrorContains(t, cfg.Validate(), "payments.dispute_evidence_window")
+}
+
+func TestValidateTokenTTLs(t *testing.T) {
+	cfg := &Config{Server: ServerConfig{Port: 8080}, Security: SecurityConfig{AccessTokenTTL: "5m", RefreshTokenTTL: "24h"}}
+	require.NoError(t, cfg.Validate())
+
+	cfg.Security.RefreshTokenTTL = "forever"
+	require.ErrorContains(t, cfg.Validate(), "security.refresh_token_ttl")
+	cfg.Security.RefreshTokenTTL = ""
+	cfg.Security.AccessTokenTTL = "-1m"
+	require.ErrorContains(t, cfg.Validate(), "security.access_token_ttl")
+	cfg.Security.AccessTokenTTL = ""
+	cfg.Security.RefreshTokenPruneInterval = "0s"
+	require.ErrorContains(t, cfg.Validate(), "security.refresh_token_prune_interval")
+}
+
+func TestValidateDenylist(t *testing.T) {
+	cfg := &Config{Server: ServerConfig{Port: 8080}, Security: SecurityConfig{Denylist: DenylistConfig{Backend: "memory", PruneInterval: "1m"}}}
+	require.NoError(t, cfg.Validate())
+
+	cfg.Security.Denylist.Backend = "redis"
+	require.ErrorContains(t, cfg.Validate(), "security.denylist.backend")
+	cfg.Security.Denylist = DenylistConfig{PruneInterval: "often"}
+	require.ErrorContains(t, cfg.Validate(), "security.denylist.prune_interval")
+}
+
+func TestValidateAuthorization(t *testing.T) {
+	newCfg := func() *Config {
+		return &Config{Server: ServerConfig{Port: 8080}, Security: SecurityConfig{
+			AuthSkipSuffixes: []string{"/LoginUser"},
+			Authorization: AuthorizationConfig{
+				Roles:      map[string][]string{"finance": {"ledger.read"}},
+				Procedures: map[string]string{"/rpc.payment.v1.PaymentService/GetLedgerBalances": "ledger.read"},
+			},
+		}}
+	}
+	require.NoError(t, newCfg().Validate())
+
+	cfg := newCfg()
+	cfg.Security.Authorization.Procedures["GetLedgerBalances"] = "ledger.read"
+	require.ErrorContains(t, cfg.Validate(), "invalid procedure")
+
+	cfg = newCfg()
+	cfg.Security.Authorization.Procedures["/rpc.payment.v1.PaymentService/*"] = "payments.admin"
+	require.ErrorContains(t, cfg.Validate(), "granted by no role")
+	cfg.Security.Authorization.Roles["admin"] = []string{PermissionAll}
+	require.NoError(t, cfg.Validate())
+
+	cfg = newCfg()
+	cfg.Security.Authorization.Procedures["/rpc.user.v1.UserService/LoginUser"] = "ledger.read"
+	require.ErrorContains(t, cfg.Validate(), "skips authentication")
+}
+
+func TestValidateProcessor(t *testing.T) {
+	tests := []struct {
+		name    string
+		proc    ProcessorConfig
+		wantErr bool
+	}{
+		{name: "defaults", proc: ProcessorConfig{}},
+		{name: "fake with latency", proc: ProcessorConfig{Provider: "fake", FakeLatency: "250ms"}},
+		{name: "unknown provider", proc: ProcessorConfig{Provider: "stripe"}, wantErr: true},
+		{name: "bad latency", proc: ProcessorConfig{Provider: "fake", FakeLatency: "slow"}, wantErr: true},
+		{name: "negative latency", proc: ProcessorConfig{Provider: "fake", FakeLatency: "-1s"}, wantErr: true},
+		{name: "webhook tolerance", proc: ProcessorConfig{WebhookSecret: "s", WebhookTolerance: "2m"}},
+		{name: "bad webhook tolerance", proc: ProcessorConfig{WebhookTolerance: "0s"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Server: ServerConfig{Port: 8080}, Payments: PaymentsConfig{Processor: tt.proc}}
+			err := cfg.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateWebhooks(t *testing.T) {
+	tests := []struct {
+		name    string
+		hooks   WebhooksConfig
+		wantErr bool
+	}{
+		{name: "defaults", hooks: WebhooksConfig{}},
+		{name: "tuned", hooks: WebhooksConfig{MaxAttempts: 3, InitialBackoff: "1s", MaxBackoff: "1m", Timeout: "2s", PollInterval: "500ms", BatchSize: 5}},
+		{name: "negative attempts", hooks: WebhooksConfig{MaxAttempts: -1}, wantErr: true},
+		{name: "bad backoff", hooks: WebhooksConfig{InitialBackoff: "soon"}, wantErr: true},
+		{name: "zero timeout", hooks: WebhooksConfig{Timeout: "0s"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Server: ServerConfig{Port: 8080}, Webhooks: tt.hooks}
+			err := cfg.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateDunning(t *testing.T) {
+	tests := []struct {
+		name    string
+		dunning DunningConfig
+		wantErr bool
+	}{
+		{name: "defaults", dunning: DunningConfig{}},
+		{name: "schedule", dunning: DunningConfig{ReminderDays: []int{3, 7, 14}, Interval: "1m", BatchSize: 10}},
+		{name: "unordered", dunning: DunningConfig{ReminderDays: []int{7, 3}}, wantErr: true},
+		{name: "zero day", dunning: DunningConfig{ReminderDays: []int{0}}, wantErr: true},
+		{name: "bad interval", dunning: DunningConfig{Interval: "hourly"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Server: ServerConfig{Port: 8080}, Dunning: tt.dunning}
+			err := cfg.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateSubscriptions(t *testing.T) {
+	require.NoError(t, (&Config{Server: ServerConfig{Port: 8080}, Subscriptions: SubscriptionsConfig{Interval: "30s", BatchSize: 10}}).Validate())
+	require.Error(t, (&Config{Server: ServerConfig{Port: 8080}, Subscriptions: SubscriptionsConfig{Interval: "-1s"}}).Validate())
+	require.Error(t, (&Config{Server: ServerConfig{Port: 8080}, Subscriptions: SubscriptionsConfig{BatchSize: -1}}).Validate())
+	require.NoError(t, (&Config{Server: ServerConfig{Port: 8080}, Subscriptions: SubscriptionsConfig{AutoPayRetryInterval: "5m", AutoPayMaxAttempts: 3}}).Validate())
+	require.ErrorContains(t, (&Config{Server: ServerConfig{Port: 8080}, Subscriptions: SubscriptionsConfig{AutoPayRetryInterval: "soon"}}).Validate(), "subscriptions.autopay_retry_interval")
+	require.ErrorContains(t, (&Config{Server: ServerConfig{Port: 8080}, Subscriptions: SubscriptionsConfig{AutoPayMaxAttempts: -1}}).Validate(), "subscriptions.autopay_max_attempts")
+}
+
+func TestValidateFraud(t *testing.T) {
+	valid := FraudConfig{
+		MaxAmount:      AmountRule{Limits: map[string]string{"USD": "5000.00", "JPY": "500000"}},
+		TokenVelocity:  VelocityRule{Action: "review", Max: 5, Window: "1h"},
+		IPTokens:       VelocityRule{Max: 3, Window: "24h"},
+		BlockedNames:   PatternRule{Action: "deny", Patterns: []string{`^test\b`}},
+		TrustedProxies: []string{"10.0.0.0/8", "192.0.2.1"},
+		OnError:        "review",
+	}
+	cfg := func(f FraudConfig) *Config {
+		return &Config{Server: ServerConfig{Port: 8080}, Payments: PaymentsConfig{Fraud: f}}
+	}
+	require.NoError(t, cfg(valid).Validate())
+
+	for name, mutate := range map[string]func(*FraudConfig){
+		"unknown action":   func(f *FraudConfig) { f.IPTokens.Action = "block" },
+		"bad limit":        func(f *FraudConfig) { f.MaxAmount.Limits = map[string]string{"USD": "1.005"} },
+		"unknown currency": func(f *FraudConfig) { f.MaxAmount.Limits = map[string]string{"XXX": "10"} },
+		"zero limit":       func(f *FraudConfig) { f.MaxAmount.Limits = map[string]string{"USD": "0"} },
+		"negative max":     func(f *FraudConfig) { f.TokenVelocity.Max = -1 },
+		"missing window":   func(f *FraudConfig) { f.IPTokens.Window = "" },
+		"bad pattern":      func(f *FraudConfig) { f.BlockedNames.Patterns = []string{"("} },
+		"bad proxy":        func(f *FraudConfig) { f.TrustedProxies = []string{"10.0.0.0/33"} },
+		"unknown on_error": func(f *FraudConfig) { f.OnError = "allow" },
+	} {
+		f := valid
+		mutate(&f)
+		require.Error(t, cfg(f).Validate(), name)
+	}
+}
+func TestValidateRefusesFakeProcessorInProduction(t *testing.T) {
+	for _, env := range []string{"prod", "production"} {
+		cfg := &Config{
+			Environment: env,
+			Server:      ServerConfig{Port: 8080},
+			Database:    DatabaseConfig{URL: "postgres://db"},
+			Security:    SecurityConfig{JWTSecret: "s"},
+			Payments:    PaymentsConfig{Vault: VaultConfig{Key: "aW5zZWN1cmUtZGV2LXZhdWx0LWtleS0zMi1ieXRlcyE="}},
+		}
+		require.ErrorContains(t, cfg.Validate(), "payments.processor.allow_fake", env)
+		cfg.Payments.Processor.AllowFake = true
+		require.NoError(t, cfg.Validate(), env)
+	}
+}
+
+func TestValidateVault(t *testing.T) {
+	key := "aW5zZWN1cmUtZGV2LXZhdWx0LWtleS0zMi1ieXRlcyE="
+	cfg := &Config{Server: ServerConfig{Port: 8080}, Payments: PaymentsConfig{Vault: VaultConfig{Key: key}}}
+	require.NoError(t, cfg.Validate())
+
+	cfg.Payments.Vault.Key = "c2hvcnQ="
+	require.Error(t, cfg.Validate())
+
+	prod := &Config{
+		Environment: "production",
+		Server:      ServerConfig{Port: 8080},
+		Database:    DatabaseConfig{URL: "postgres://db"},
+		Security:    SecurityConfig{JWTSecret: "s"},
+		Payments:    PaymentsConfig{Processor: ProcessorConfig{AllowFake: true}},
+	}
+	require.ErrorContains(t, prod.Validate(), "payments.vault.key")
+	prod.Payments.Vault.Key = key
+	require.NoError(t, prod.Validate())
+}
+
+func TestValidateReconciliation(t *testing.T) {
+	cfg := &Config{Server: ServerConfig{Port: 8080}}
+	cfg.Reconciliation.Columns = SettlementColumns{Reference: "Charge ID", Amount: "Gross", Currency: "Currency", Date: "Settled On"}
+	require.NoError(t, cfg.Validate())
+
+	cfg.Reconciliation.Columns.Date = "gross"
+	require.ErrorContains(t, cfg.Validate(), "reconciliation.columns")
 }
@@ -0,0 +1,130 @@
+// Package dunning runs the background job that marks unpaid invoices
+// overdue and sends payment reminders on a schedule of days after the due
+// date. Every replica may run the job: the Store claims invoices with row
+// locks that other replicas skip, and records each reminder with the invoice
+// so it is sent once.
+package dunning
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/grpc-buf/internal/config"
+	"github.com/grpc-buf/internal/fx"
+)
+
+// Defaults used when the corresponding DunningConfig field is unset.
+const (
+	DefaultInterval  = time.Minute
+	DefaultBatchSize = 100
+)
+
+// Store is the invoice store the Job works on. today is a UTC date at
+// midnight.
+type Store interface {
+	// MarkOverdueInvoices flags up to limit unpaid invoices due before today
+	// as overdue and returns how many it flagged.
+	MarkOverdueInvoices(ctx context.Context, today time.Time, limit int) (int, error)
+	// SendInvoiceReminders records and emits the latest reminder in schedule
+	// (days after the due date) that each of up to limit unpaid invoices has
+	// reached but not yet received, and returns how many it sent.
+	SendInvoiceReminders(ctx context.Context, today time.Time, schedule []int, limit int) (int, error)
+}
+
+// Job periodically drives a Store through overdue detection and reminders.
+type Job struct {
+	store     Store
+	now       func() time.Time
+	schedule  []int
+	interval  time.Duration
+	batchSize int
+}
+
+// NewJob returns a Job for store tuned by cfg. An invalid or unset interval
+// falls back to DefaultInterval; config.Validate rejects it at startup.
+func NewJob(store Store, cfg config.DunningConfig) *Job {
+	j := &Job{
+		store:     store,
+		now:       time.Now,
+		schedule:  cfg.ReminderDays,
+		interval:  DefaultInterval,
+		batchSize: DefaultBatchSize,
+	}
+	if d, err := time.ParseDuration(strings.TrimSpace(cfg.Interval)); err == nil && d > 0 {
+		j.interval = d
+	}
+	if cfg.BatchSize > 0 {
+		j.batchSize = cfg.BatchSize
+	}
+	return j
+}
+
+// Run executes the job every interval until ctx is cancelled.
+func (j *Job) Run(ctx context.Context) {
+	slog.Info("Starting dunning job", "interval", j.interval, "reminder_days", j.schedule)
+	t := time.NewTicker(j.interval)
+	defer t.Stop()
+	for {
+		if err := j.RunOnce(ctx); err != nil {
+			slog.Error("dunning run failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// RunOnce marks overdue invoices and sends due reminders, draining full
+// batches back to back.
+func (j *Job) RunOnce(ctx context.Context) error {
+	today := fx.Day(j.now())
+	for {
+		n, err := j.store.MarkOverdueInvoices(ctx, today, j.batchSize)
+		if err != nil {
+			return fmt.Errorf("mark overdue invoices: %w", err)
+		}
+		if n > 0 {
+			slog.Info("Invoices marked overdue", "count", n)
+		}
+		if n < j.batchSize {
+			break
+		}
+	}
+	if len(j.schedule) == 0 {
+		return nil
+	}
+	for {
+		n, err := j.store.SendInvoiceReminders(ctx, today, j.schedule, j.batchSize)
+		if err != nil {
+			return fmt.Errorf("send invoice reminders: %w", err)
+		}
+		if n > 0 {
+			slog.Info("Invoice reminders sent", "count", n)
+		}
+		if n < j.batchSize {
+			return nil
+		}
+	}
+}
+
+// NextReminder returns the 1-based position in schedule of the latest
+// reminder due daysOverdue days after the due date, or 0 when none is due or
+// it was already sent (sent is the position of the last reminder sent).
+// Reminders skipped while the job was down are not sent late.
+func NextReminder(schedule []int, daysOverdue, sent int) int {
+	next := 0
+	for i, d := range schedule {
+		if d <= daysOverdue {
+			next = i + 1
+		}
+	}
+	if next <= sent {
+		return 0
+	}
+	return next
+}
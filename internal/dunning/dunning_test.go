@@ -0,0 +1,68 @@
+package dunning
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grpc-buf/internal/config"
+)
+
+func TestNextReminder(t *testing.T) {
+	schedule := []int{3, 7, 14}
+	tests := []struct {
+		overdue, sent, want int
+	}{
+		{overdue: 1, sent: 0, want: 0},
+		{overdue: 3, sent: 0, want: 1},
+		{overdue: 5, sent: 1, want: 0},
+		{overdue: 7, sent: 1, want: 2},
+		{overdue: 20, sent: 0, want: 3}, // catch up with the latest only
+		{overdue: 20, sent: 3, want: 0},
+	}
+	for _, tt := range tests {
+		if got := NextReminder(schedule, tt.overdue, tt.sent); got != tt.want {
+			t.Errorf("NextReminder(%d days, %d sent) = %d, want %d", tt.overdue, tt.sent, got, tt.want)
+		}
+	}
+}
+
+// fakeStore returns full batches until its backlog is drained.
+type fakeStore struct {
+	overdue, reminders int
+	days               []time.Time
+	schedule           []int
+}
+
+func (f *fakeStore) MarkOverdueInvoices(_ context.Context, today time.Time, limit int) (int, error) {
+	f.days = append(f.days, today)
+	n := min(f.overdue, limit)
+	f.overdue -= n
+	return n, nil
+}
+
+func (f *fakeStore) SendInvoiceReminders(_ context.Context, _ time.Time, schedule []int, limit int) (int, error) {
+	f.schedule = schedule
+	n := min(f.reminders, limit)
+	f.reminders -= n
+	return n, nil
+}
+
+func TestRunOnceDrainsBacklog(t *testing.T) {
+	store := &fakeStore{overdue: 5, reminders: 3}
+	j := NewJob(store, config.DunningConfig{ReminderDays: []int{3, 7}, BatchSize: 2})
+	j.now = func() time.Time { return time.Date(2026, 3, 4, 23, 30, 0, 0, time.FixedZone("UTC-2", -2*3600)) }
+
+	if err := j.RunOnce(context.Background()); err != nil {
+		t.Fatalf("RunOnce: %v", err)
+	}
+	if store.overdue != 0 || store.reminders != 0 {
+		t.Fatalf("backlog left: %d overdue, %d reminders", store.overdue, store.reminders)
+	}
+	if len(store.days) != 3 || !store.days[0].Equal(time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("today = %v, want 2026-03-05 UTC over 3 batches", store.days)
+	}
+	if len(store.schedule) != 2 {
+		t.Fatalf("schedule = %v", store.schedule)
+	}
+}
@@ -0,0 +1,307 @@
+// Package fraud screens payment attempts against the rules in
+// config.FraudConfig before they are charged.
+//
+// Every matching rule adds its reason to the Result. The decision is DENY if
+// any deny rule matched, REVIEW if only review rules matched and ALLOW
+// otherwise.
+package fraud
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grpc-buf/internal/config"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"github.com/grpc-buf/internal/money"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the google.rpc.ErrorInfo domain of denied payments.
+const ErrorDomain = "fraud.grpc-buf"
+
+// ReasonDenied is the google.rpc.ErrorInfo reason of denied payments. The
+// matching rules are listed in the "reasons" metadata entry.
+const ReasonDenied = "PAYMENT_DENIED"
+
+// Reasons recorded for each matching rule.
+const (
+	ReasonAmountLimit   = "AMOUNT_LIMIT"
+	ReasonTokenVelocity = "TOKEN_VELOCITY"
+	ReasonIPTokens      = "IP_TOKENS"
+	ReasonBlockedName   = "BLOCKED_NAME"
+	// ReasonUnchecked is recorded when the checks could not be completed
+	// and config.FraudConfig.OnError is review.
+	ReasonUnchecked = "UNCHECKED"
+)
+
+// Attempt is a payment about to be charged.
+type Attempt struct {
+	CardToken string
+	// ClientIP is the caller's address; empty skips the IP rule.
+	ClientIP string
+	Name     string
+	// Amount is in the minor unit of Currency.
+	Amount   int64
+	Currency string
+}
+
+// History is the record of earlier attempts the velocity rules count.
+type History interface {
+	// TokenAttempts counts the attempts with token since the given time.
+	TokenAttempts(ctx context.Context, token string, since time.Time) (int, error)
+	// IPTokens counts the distinct card tokens other than exclude attempted
+	// from ip since the given time.
+	IPTokens(ctx context.Context, ip, exclude string, since time.Time) (int, error)
+}
+
+// Result is the decision for an attempt and the reasons behind it.
+type Result struct {
+	Decision paymentv1.FraudDecision
+	Reasons  []string
+}
+
+// Err returns codes.PermissionDenied with a google.rpc.ErrorInfo for a denied
+// attempt and nil otherwise.
+func (r Result) Err() error {
+	if r.Decision != paymentv1.FraudDecision_FRAUD_DECISION_DENY {
+		return nil
+	}
+	st, err := status.New(codes.PermissionDenied, "payment denied by fraud checks").WithDetails(&errdetails.ErrorInfo{
+		Reason:   ReasonDenied,
+		Domain:   ErrorDomain,
+		Metadata: map[string]string{"reasons": strings.Join(r.Reasons, ",")},
+	})
+	if err != nil {
+		return status.Error(codes.PermissionDenied, "payment denied by fraud checks")
+	}
+	return st.Err()
+}
+
+// velocity is a parsed config.VelocityRule.
+type velocity struct {
+	deny   bool
+	max    int
+	window time.Duration
+}
+
+// Checker evaluates attempts against a fixed rule set.
+type Checker struct {
+	amountDeny  bool
+	maxAmount   map[string]int64
+	token, ip   velocity
+	blockedDeny bool
+	blocked     []*regexp.Regexp
+	// proxies are the trusted proxies whose forwarding headers ClientIP
+	// reads.
+	proxies []netip.Prefix
+	// reviewOnError charges attempts that cannot be checked for review
+	// instead of refusing them.
+	reviewOnError bool
+	now           func() time.Time
+}
+
+// NewChecker compiles the rules in cfg. The zero config allows everything.
+func NewChecker(cfg config.FraudConfig) (*Checker, error) {
+	c := &Checker{
+		amountDeny:    isDeny(cfg.MaxAmount.Action),
+		maxAmount:     make(map[string]int64, len(cfg.MaxAmount.Limits)),
+		blockedDeny:   isDeny(cfg.BlockedNames.Action),
+		reviewOnError: !isDeny(cfg.OnError),
+		now:           time.Now,
+	}
+	for currency, limit := range cfg.MaxAmount.Limits {
+		v, err := money.ParseMinor(limit, currency)
+		if err != nil || v <= 0 {
+			return nil, fmt.Errorf("invalid %s amount limit %q", currency, limit)
+		}
+		c.maxAmount[currency] = v
+	}
+	var err error
+	if c.token, err = parseVelocity(cfg.TokenVelocity); err != nil {
+		return nil, fmt.Errorf("token velocity: %w", err)
+	}
+	if c.ip, err = parseVelocity(cfg.IPTokens); err != nil {
+		return nil, fmt.Errorf("ip tokens: %w", err)
+	}
+	for _, p := range cfg.TrustedProxies {
+		prefix, err := parseProxy(p)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q: %w", p, err)
+		}
+		c.proxies = append(c.proxies, prefix)
+	}
+	for _, p := range cfg.BlockedNames.Patterns {
+		re, err := regexp.Compile("(?i)" + p)
+		if err != nil {
+			return nil, fmt.Errorf("blocked name pattern %q: %w", p, err)
+		}
+		c.blocked = append(c.blocked, re)
+	}
+	return c, nil
+}
+
+// Check evaluates a against every rule, reading earlier attempts from h. The
+// caller records a so later checks count it. When h fails, Check returns the
+// error, or with OnError review skips the rule and marks the result
+// unchecked.
+func (c *Checker) Check(ctx context.Context, h History, a Attempt) (Result, error) {
+	var denied, review bool
+	var reasons []string
+	match := func(deny bool, reason string) {
+		reasons = append(reasons, reason)
+		if deny {
+			denied = true
+		} else {
+			review = true
+		}
+	}
+
+	if limit, ok := c.maxAmount[a.Currency]; ok && a.Amount > limit {
+		match(c.amountDeny, ReasonAmountLimit)
+	}
+	if c.token.max > 0 {
+		n, err := h.TokenAttempts(ctx, a.CardToken, c.now().Add(-c.token.window))
+		switch {
+		case err == nil:
+			if n >= c.token.max {
+				match(c.token.deny, ReasonTokenVelocity)
+			}
+		case !c.reviewOnError:
+			return Result{}, fmt.Errorf("count token attempts: %w", err)
+		case !slices.Contains(reasons, ReasonUnchecked):
+			match(false, ReasonUnchecked)
+		}
+	}
+	if c.ip.max > 0 && a.ClientIP != "" {
+		n, err := h.IPTokens(ctx, a.ClientIP, a.CardToken, c.now().Add(-c.ip.window))
+		switch {
+		case err == nil:
+			if n+1 > c.ip.max {
+				match(c.ip.deny, ReasonIPTokens)
+			}
+		case !c.reviewOnError:
+			return Result{}, fmt.Errorf("count ip tokens: %w", err)
+		case !slices.Contains(reasons, ReasonUnchecked):
+			match(false, ReasonUnchecked)
+		}
+	}
+	for _, re := range c.blocked {
+		if re.MatchString(a.Name) {
+			match(c.blockedDeny, ReasonBlockedName)
+			break
+		}
+	}
+
+	r := Result{Decision: paymentv1.FraudDecision_FRAUD_DECISION_ALLOW, Reasons: reasons}
+	switch {
+	case denied:
+		r.Decision = paymentv1.FraudDecision_FRAUD_DECISION_DENY
+	case review:
+		r.Decision = paymentv1.FraudDecision_FRAUD_DECISION_REVIEW
+	}
+	return r, nil
+}
+
+// Unrecorded returns r for an attempt that could not be recorded, so later
+// velocity checks will not count it: with OnError review, r is marked
+// unchecked; otherwise ok is false and the attempt must be refused.
+func (c *Checker) Unrecorded(r Result) (_ Result, ok bool) {
+	if !c.reviewOnError {
+		return Result{}, false
+	}
+	if !slices.Contains(r.Reasons, ReasonUnchecked) {
+		r.Reasons = append(slices.Clip(r.Reasons), ReasonUnchecked)
+	}
+	if r.Decision == paymentv1.FraudDecision_FRAUD_DECISION_ALLOW {
+		r.Decision = paymentv1.FraudDecision_FRAUD_DECISION_REVIEW
+	}
+	return r, true
+}
+
+// ClientIP returns the caller's address for the IP rule. It is the peer
+// host unless the peer is a trusted proxy; then it is the nearest
+// X-Forwarded-For entry that is not a trusted proxy, or X-Real-IP when the
+// proxy sent no X-Forwarded-For.
+func (c *Checker) ClientIP(req connect.AnyRequest) string {
+	return c.clientIP(req.Peer().Addr, req.Header())
+}
+
+func (c *Checker) clientIP(addr string, h http.Header) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	if !c.trustedProxy(addr) {
+		return addr
+	}
+	if xff := h.Values("X-Forwarded-For"); len(xff) > 0 {
+		hops := strings.Split(strings.Join(xff, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if !c.trustedProxy(hop) {
+				return hop
+			}
+		}
+		return addr
+	}
+	if xr := strings.TrimSpace(h.Get("X-Real-IP")); xr != "" {
+		return xr
+	}
+	return addr
+}
+
+// trustedProxy reports whether addr is in one of the trusted proxy ranges.
+func (c *Checker) trustedProxy(addr string) bool {
+	ip, err := netip.ParseAddr(addr)
+	if err != nil {
+		return false
+	}
+	ip = ip.Unmap()
+	for _, p := range c.proxies {
+		if p.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseProxy parses a trusted proxy given as an address or a CIDR range.
+func parseProxy(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		p, err := netip.ParsePrefix(s)
+		return p.Masked(), err
+	}
+	ip, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	ip = ip.Unmap()
+	return netip.PrefixFrom(ip, ip.BitLen()), nil
+}
+
+func parseVelocity(r config.VelocityRule) (velocity, error) {
+	v := velocity{deny: isDeny(r.Action), max: r.Max}
+	if r.Max <= 0 {
+		return velocity{}, nil
+	}
+	d, err := time.ParseDuration(strings.TrimSpace(r.Window))
+	if err != nil || d <= 0 {
+		return velocity{}, fmt.Errorf("invalid window %q", r.Window)
+	}
+	v.window = d
+	return v, nil
+}
+
+func isDeny(action string) bool {
+	return !strings.EqualFold(strings.TrimSpace(action), config.FraudActionReview)
+}
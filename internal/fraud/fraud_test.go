@@ -0,0 +1,203 @@
+package fraud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/grpc-buf/internal/config"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeHistory struct {
+	tokenAttempts, ipTokens int
+	since                   []time.Time
+	err                     error
+}
+
+func (h *fakeHistory) TokenAttempts(_ context.Context, _ string, since time.Time) (int, error) {
+	h.since = append(h.since, since)
+	return h.tokenAttempts, h.err
+}
+
+func (h *fakeHistory) IPTokens(_ context.Context, _, _ string, since time.Time) (int, error) {
+	h.since = append(h.since, since)
+	return h.ipTokens, h.err
+}
+
+func TestCheck(t *testing.T) {
+	c, err := NewChecker(config.FraudConfig{
+		MaxAmount:     config.AmountRule{Limits: map[string]string{"USD": "100.00"}},
+		TokenVelocity: config.VelocityRule{Action: "review", Max: 3, Window: "1h"},
+		IPTokens:      config.VelocityRule{Max: 2, Window: "24h"},
+		BlockedNames:  config.PatternRule{Action: "review", Patterns: []string{`^fraud`, `mickey mouse`}},
+	})
+	if err != nil {
+		t.Fatalf("NewChecker: %v", err)
+	}
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+
+	base := Attempt{CardToken: "tok_1", ClientIP: "10.0.0.1", Name: "Jane", Amount: 10000, Currency: "USD"}
+	tests := []struct {
+		name    string
+		attempt func(a *Attempt)
+		history fakeHistory
+		want    paymentv1.FraudDecision
+		reasons []string
+	}{
+		{"clean", nil, fakeHistory{tokenAttempts: 2, ipTokens: 1}, paymentv1.FraudDecision_FRAUD_DECISION_ALLOW, nil},
+		{"amount over limit", func(a *Attempt) { a.Amount = 10001 }, fakeHistory{},
+			paymentv1.FraudDecision_FRAUD_DECISION_DENY, []string{ReasonAmountLimit}},
+		{"other currency unlimited", func(a *Attempt) { a.Amount, a.Currency = 1_000_000, "EUR" }, fakeHistory{},
+			paymentv1.FraudDecision_FRAUD_DECISION_ALLOW, nil},
+		{"token velocity", nil, fakeHistory{tokenAttempts: 3},
+			paymentv1.FraudDecision_FRAUD_DECISION_REVIEW, []string{ReasonTokenVelocity}},
+		{"ip tokens", nil, fakeHistory{ipTokens: 2},
+			paymentv1.FraudDecision_FRAUD_DECISION_DENY, []string{ReasonIPTokens}},
+		{"unknown ip skipped", func(a *Attempt) { a.ClientIP = "" }, fakeHistory{ipTokens: 5},
+			paymentv1.FraudDecision_FRAUD_DECISION_ALLOW, nil},
+		{"blocked name", func(a *Attempt) { a.Name = "Mickey Mouse" }, fakeHistory{},
+			paymentv1.FraudDecision_FRAUD_DECISION_REVIEW, []string{ReasonBlockedName}},
+		{"deny wins over review", func(a *Attempt) { a.Name = "FRAUDSTER" }, fakeHistory{tokenAttempts: 9, ipTokens: 9},
+			paymentv1.FraudDecision_FRAUD_DECISION_DENY, []string{ReasonTokenVelocity, ReasonIPTokens, ReasonBlockedName}},
+	}
+	for _, tt := range tests {
+		a := base
+		if tt.attempt != nil {
+			tt.attempt(&a)
+		}
+		h := tt.history
+		r, err := c.Check(context.Background(), &h, a)
+		if err != nil {
+			t.Fatalf("%s: Check: %v", tt.name, err)
+		}
+		if r.Decision != tt.want || !slices.Equal(r.Reasons, tt.reasons) {
+			t.Fatalf("%s: got %v %v, want %v %v", tt.name, r.Decision, r.Reasons, tt.want, tt.reasons)
+		}
+		for _, since := range h.since {
+			if since != now.Add(-time.Hour) && since != now.Add(-24*time.Hour) {
+				t.Fatalf("%s: unexpected window start %v", tt.name, since)
+			}
+		}
+	}
+}
+
+func TestCheckOnError(t *testing.T) {
+	rules := config.FraudConfig{
+		MaxAmount:     config.AmountRule{Limits: map[string]string{"USD": "100.00"}},
+		TokenVelocity: config.VelocityRule{Max: 3, Window: "1h"},
+		IPTokens:      config.VelocityRule{Max: 2, Window: "24h"},
+	}
+	a := Attempt{CardToken: "tok_1", ClientIP: "203.0.113.7", Amount: 500, Currency: "USD"}
+	failing := func() *fakeHistory { return &fakeHistory{err: errors.New("connection refused")} }
+
+	closed, err := NewChecker(rules)
+	if err != nil {
+		t.Fatalf("NewChecker: %v", err)
+	}
+	if _, err := closed.Check(context.Background(), failing(), a); err == nil {
+		t.Fatal("Check succeeded without its history by default")
+	}
+	if _, ok := closed.Unrecorded(Result{Decision: paymentv1.FraudDecision_FRAUD_DECISION_ALLOW}); ok {
+		t.Fatal("an unrecorded attempt was allowed by default")
+	}
+
+	rules.OnError = config.FraudActionReview
+	open, err := NewChecker(rules)
+	if err != nil {
+		t.Fatalf("NewChecker: %v", err)
+	}
+	r, err := open.Check(context.Background(), failing(), a)
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if r.Decision != paymentv1.FraudDecision_FRAUD_DECISION_REVIEW || !slices.Equal(r.Reasons, []string{ReasonUnchecked}) {
+		t.Fatalf("got %v %v, want REVIEW [%s]", r.Decision, r.Reasons, ReasonUnchecked)
+	}
+	// Rules that do not need the history still deny.
+	a.Amount = 10001
+	if r, _ := open.Check(context.Background(), failing(), a); r.Decision != paymentv1.FraudDecision_FRAUD_DECISION_DENY {
+		t.Fatalf("amount over limit = %v, want DENY", r.Decision)
+	}
+
+	r, ok := open.Unrecorded(Result{Decision: paymentv1.FraudDecision_FRAUD_DECISION_ALLOW})
+	if !ok || r.Decision != paymentv1.FraudDecision_FRAUD_DECISION_REVIEW || !slices.Equal(r.Reasons, []string{ReasonUnchecked}) {
+		t.Fatalf("Unrecorded = %v %v %v", r.Decision, r.Reasons, ok)
+	}
+}
+
+func TestResultErr(t *testing.T) {
+	if err := (Result{Decision: paymentv1.FraudDecision_FRAUD_DECISION_REVIEW}).Err(); err != nil {
+		t.Fatalf("review must not fail: %v", err)
+	}
+	err := Result{Decision: paymentv1.FraudDecision_FRAUD_DECISION_DENY, Reasons: []string{ReasonAmountLimit, ReasonIPTokens}}.Err()
+	st := status.Convert(err)
+	if st.Code() != codes.PermissionDenied {
+		t.Fatalf("code = %v", st.Code())
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			if info.GetReason() != ReasonDenied || info.GetDomain() != ErrorDomain || info.GetMetadata()["reasons"] != "AMOUNT_LIMIT,IP_TOKENS" {
+				t.Fatalf("error info = %v", info)
+			}
+			return
+		}
+	}
+	t.Fatal("missing ErrorInfo")
+}
+
+func TestNewCheckerRejectsBadRules(t *testing.T) {
+	for name, cfg := range map[string]config.FraudConfig{
+		"limit":   {MaxAmount: config.AmountRule{Limits: map[string]string{"USD": "abc"}}},
+		"window":  {TokenVelocity: config.VelocityRule{Max: 1}},
+		"pattern": {BlockedNames: config.PatternRule{Patterns: []string{"["}}},
+	} {
+		if _, err := NewChecker(cfg); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	c, err := NewChecker(config.FraudConfig{TrustedProxies: []string{"10.0.0.0/8", "192.0.2.1"}})
+	if err != nil {
+		t.Fatalf("NewChecker: %v", err)
+	}
+	header := func(kv ...string) http.Header {
+		h := http.Header{}
+		for i := 0; i < len(kv); i += 2 {
+			h.Add(kv[i], kv[i+1])
+		}
+		return h
+	}
+	tests := []struct {
+		name string
+		peer string
+		h    http.Header
+		want string
+	}{
+		{"direct", "203.0.113.5:4000", nil, "203.0.113.5"},
+		{"untrusted peer forwarding", "203.0.113.5:4000", header("X-Forwarded-For", "198.51.100.7", "X-Real-IP", "198.51.100.8"), "203.0.113.5"},
+		{"trusted proxy", "10.1.2.3:4000", header("X-Forwarded-For", "198.51.100.7"), "198.51.100.7"},
+		{"spoofed first hop", "10.1.2.3:4000", header("X-Forwarded-For", "1.1.1.1, 198.51.100.7"), "198.51.100.7"},
+		{"proxy chain", "192.0.2.1:4000", header("X-Forwarded-For", "198.51.100.7, 10.9.9.9"), "198.51.100.7"},
+		{"repeated headers", "10.1.2.3:4000", header("X-Forwarded-For", "1.1.1.1", "X-Forwarded-For", "198.51.100.7"), "198.51.100.7"},
+		{"real ip", "[::ffff:10.1.2.3]:4000", header("X-Real-IP", "198.51.100.8"), "198.51.100.8"},
+		{"only proxies", "10.1.2.3:4000", header("X-Forwarded-For", "10.9.9.9"), "10.1.2.3"},
+	}
+	for _, tt := range tests {
+		if got := c.clientIP(tt.peer, tt.h); got != tt.want {
+			t.Errorf("%s: clientIP = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+	if _, err := NewChecker(config.FraudConfig{TrustedProxies: []string{"10.0.0.0/33"}}); err == nil {
+		t.Fatal("NewChecker accepted an invalid proxy range")
+	}
+}
@@ -0,0 +1,181 @@
+// Package fx holds dated foreign-exchange rates and converts google.type.Money
+// amounts between currencies with them.
+package fx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/grpc-buf/internal/money"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+)
+
+// ErrNoRate reports that no rate for a currency pair exists on or before the
+// requested date.
+var ErrNoRate = errors.New("no fx rate")
+
+// Rate is the value of one unit of Base expressed in Quote on Date.
+type Rate struct {
+	Base  string
+	Quote string
+	// Date is midnight UTC of the day the rate applies to.
+	Date  time.Time
+	Value *big.Rat
+}
+
+// Source looks up stored rates.
+type Source interface {
+	// LatestRate returns the most recent base→quote rate dated on or before
+	// on, or an error wrapping ErrNoRate when there is none.
+	LatestRate(ctx context.Context, base, quote string, on time.Time) (Rate, error)
+}
+
+// Conversion is the result of converting an amount.
+type Conversion struct {
+	Amount *moneypb.Money
+	// Rate is the rate applied; nil when source and target currency match.
+	Rate *Rate
+}
+
+// Converter converts amounts using rates from a Source. When only the
+// inverse pair is stored it is inverted.
+type Converter struct {
+	src Source
+}
+
+// NewConverter returns a Converter backed by src.
+func NewConverter(src Source) *Converter {
+	return &Converter{src: src}
+}
+
+// Convert converts m into target using the latest rate on or before on. The
+// result is rounded half-even to target's minor unit.
+func (c *Converter) Convert(ctx context.Context, m *moneypb.Money, target string, on time.Time) (Conversion, error) {
+	minor, err := money.ToMinor(m)
+	if err != nil {
+		return Conversion{}, err
+	}
+	if _, ok := money.Exponent(target); !ok {
+		return Conversion{}, fmt.Errorf("%w: unsupported currency code %q", money.ErrInvalid, target)
+	}
+	if m.GetCurrencyCode() == target {
+		return Conversion{Amount: m}, nil
+	}
+	rate, err := c.rate(ctx, m.GetCurrencyCode(), target, Day(on))
+	if err != nil {
+		return Conversion{}, err
+	}
+	out, err := ConvertMinor(minor, m.GetCurrencyCode(), target, rate.Value)
+	if err != nil {
+		return Conversion{}, err
+	}
+	return Conversion{Amount: out, Rate: &rate}, nil
+}
+
+func (c *Converter) rate(ctx context.Context, base, quote string, on time.Time) (Rate, error) {
+	r, err := c.src.LatestRate(ctx, base, quote, on)
+	if err == nil {
+		return r, checkPositive(r)
+	}
+	if !errors.Is(err, ErrNoRate) {
+		return Rate{}, err
+	}
+	inv, ierr := c.src.LatestRate(ctx, quote, base, on)
+	if ierr != nil {
+		if errors.Is(ierr, ErrNoRate) {
+			return Rate{}, fmt.Errorf("%w for %s/%s on or before %s", ErrNoRate, base, quote, on.Format(time.DateOnly))
+		}
+		return Rate{}, ierr
+	}
+	if err := checkPositive(inv); err != nil {
+		return Rate{}, err
+	}
+	return Rate{Base: base, Quote: quote, Date: inv.Date, Value: new(big.Rat).Inv(inv.Value)}, nil
+}
+
+// checkPositive rejects a stored rate that is missing, zero or negative,
+// which would otherwise convert to nonsense or panic when inverted.
+func checkPositive(r Rate) error {
+	if r.Value == nil || r.Value.Sign() <= 0 {
+		return fmt.Errorf("fx rate %s/%s on %s is not positive", r.Base, r.Quote, r.Date.Format(time.DateOnly))
+	}
+	return nil
+}
+
+// ConvertMinor converts minor units of from into target at rate, rounding
+// half-even to target's minor unit.
+func ConvertMinor(minor int64, from, target string, rate *big.Rat) (*moneypb.Money, error) {
+	fromExp, ok := money.Exponent(from)
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported currency code %q", money.ErrInvalid, from)
+	}
+	toExp, ok := money.Exponent(target)
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported currency code %q", money.ErrInvalid, target)
+	}
+	v := new(big.Rat).SetInt64(minor)
+	v.Mul(v, rate)
+	v.Mul(v, pow10Rat(toExp-fromExp))
+	out, err := roundHalfEven(v)
+	if err != nil {
+		return nil, err
+	}
+	return money.FromMinor(out, target)
+}
+
+// FormatRate renders r as a plain decimal with up to 12 fractional digits and
+// no trailing zeros.
+func FormatRate(r *big.Rat) string {
+	s := r.FloatString(12)
+	for s[len(s)-1] == '0' {
+		s = s[:len(s)-1]
+	}
+	if s[len(s)-1] == '.' {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+// Day truncates t to midnight UTC of its UTC calendar day.
+func Day(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func pow10Rat(n int) *big.Rat {
+	p := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(n))), nil)
+	if n < 0 {
+		return new(big.Rat).SetFrac(big.NewInt(1), p)
+	}
+	return new(big.Rat).SetInt(p)
+}
+
+func roundHalfEven(v *big.Rat) (int64, error) {
+	num, den := v.Num(), v.Denom()
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	// Compare 2|r| with den to decide rounding direction.
+	twice := new(big.Int).Abs(r)
+	twice.Lsh(twice, 1)
+	switch cmp := twice.Cmp(den); {
+	case cmp > 0, cmp == 0 && q.Bit(0) == 1:
+		if num.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	if !q.IsInt64() {
+		return 0, money.ErrOverflow
+	}
+	return q.Int64(), nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,158 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-buf/internal/money"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+)
+
+// mapSource serves rates keyed by "BASE/QUOTE", sorted oldest first.
+type mapSource map[string][]Rate
+
+func (m mapSource) LatestRate(_ context.Context, base, quote string, on time.Time) (Rate, error) {
+	var best *Rate
+	for i, r := range m[base+"/"+quote] {
+		if !r.Date.After(on) {
+			best = &m[base+"/"+quote][i]
+		}
+	}
+	if best == nil {
+		return Rate{}, ErrNoRate
+	}
+	return *best, nil
+}
+
+func day(s string) time.Time {
+	d, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func rat(s string) *big.Rat {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		panic(s)
+	}
+	return r
+}
+
+func TestConvert(t *testing.T) {
+	src := mapSource{
+		"EUR/USD": {
+			{Base: "EUR", Quote: "USD", Date: day("2026-01-01"), Value: rat("1.10")},
+			{Base: "EUR", Quote: "USD", Date: day("2026-01-05"), Value: rat("1.20")},
+		},
+		"USD/JPY": {{Base: "USD", Quote: "JPY", Date: day("2026-01-01"), Value: rat("150.5")}},
+	}
+	conv := NewConverter(src)
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		in        *moneypb.Money
+		target    string
+		on        string
+		wantMinor int64
+		wantDate  string
+	}{
+		{name: "same currency", in: &moneypb.Money{CurrencyCode: "USD", Units: 3}, target: "USD", on: "2026-01-03", wantMinor: 300},
+		{name: "direct rate", in: &moneypb.Money{CurrencyCode: "EUR", Units: 10}, target: "USD", on: "2026-01-03", wantMinor: 1100, wantDate: "2026-01-01"},
+		{name: "latest rate", in: &moneypb.Money{CurrencyCode: "EUR", Units: 10}, target: "USD", on: "2026-02-01", wantMinor: 1200, wantDate: "2026-01-05"},
+		{name: "exponent change", in: &moneypb.Money{CurrencyCode: "USD", Units: 1, Nanos: 10_000_000}, target: "JPY", on: "2026-01-02", wantMinor: 152, wantDate: "2026-01-01"},
+		{name: "inverse rate", in: &moneypb.Money{CurrencyCode: "USD", Units: 12}, target: "EUR", on: "2026-01-06", wantMinor: 1000, wantDate: "2026-01-05"},
+		{name: "half even", in: &moneypb.Money{CurrencyCode: "JPY", Units: 301}, target: "USD", on: "2026-01-02", wantMinor: 200, wantDate: "2026-01-01"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := conv.Convert(ctx, tt.in, tt.target, day(tt.on))
+			if err != nil {
+				t.Fatalf("Convert: %v", err)
+			}
+			minor, err := money.ToMinor(got.Amount)
+			if err != nil || minor != tt.wantMinor || got.Amount.GetCurrencyCode() != tt.target {
+				t.Fatalf("Convert = %v (%d minor), want %d %s", got.Amount, minor, tt.wantMinor, tt.target)
+			}
+			if tt.wantDate == "" {
+				if got.Rate != nil {
+					t.Fatalf("expected no rate, got %+v", got.Rate)
+				}
+				return
+			}
+			if got.Rate == nil || got.Rate.Date.Format(time.DateOnly) != tt.wantDate {
+				t.Fatalf("rate = %+v, want date %s", got.Rate, tt.wantDate)
+			}
+		})
+	}
+
+	if _, err := conv.Convert(ctx, &moneypb.Money{CurrencyCode: "EUR", Units: 1}, "USD", day("2025-12-31")); !errors.Is(err, ErrNoRate) {
+		t.Fatalf("Convert before first rate = %v, want ErrNoRate", err)
+	}
+	if _, err := conv.Convert(ctx, &moneypb.Money{CurrencyCode: "GBP", Units: 1}, "USD", day("2026-01-03")); !errors.Is(err, ErrNoRate) {
+		t.Fatalf("Convert unknown pair = %v, want ErrNoRate", err)
+	}
+}
+
+func TestConvertRejectsNonPositiveRate(t *testing.T) {
+	conv := NewConverter(mapSource{
+		"EUR/USD": {{Base: "EUR", Quote: "USD", Date: day("2026-01-01"), Value: new(big.Rat)}},
+		"GBP/USD": {{Base: "GBP", Quote: "USD", Date: day("2026-01-01"), Value: rat("-1.25")}},
+	})
+	ctx := context.Background()
+	for _, tt := range []struct{ from, to string }{{"EUR", "USD"}, {"USD", "EUR"}, {"GBP", "USD"}} {
+		_, err := conv.Convert(ctx, &moneypb.Money{CurrencyCode: tt.from, Units: 1}, tt.to, day("2026-01-02"))
+		if err == nil || errors.Is(err, ErrNoRate) {
+			t.Fatalf("Convert %s to %s = %v, want a non-positive rate error", tt.from, tt.to, err)
+		}
+	}
+}
+
+func TestFormatRate(t *testing.T) {
+	for in, want := range map[string]string{"1.0850": "1.085", "150": "150", "1/3": "0.333333333333"} {
+		if got := FormatRate(rat(in)); got != want {
+			t.Errorf("FormatRate(%s) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	csvIn := "base,quote,date,rate\nEUR,USD,2026-01-02,1.0850\njpy,usd,2026-01-02,0.0067\n"
+	jsonIn := `[{"date":"2026-01-02","base":"EUR","quote":"USD","rate":"1.0850"},{"date":"2026-01-02","base":"JPY","quote":"USD","rate":0.0067}]`
+	for format, in := range map[string]string{"csv": csvIn, "json": jsonIn} {
+		t.Run(format, func(t *testing.T) {
+			rates, err := Parse(format, strings.NewReader(in))
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if len(rates) != 2 {
+				t.Fatalf("got %d rates, want 2", len(rates))
+			}
+			if r := rates[1]; r.Base != "JPY" || r.Quote != "USD" || r.Value.Cmp(rat("0.0067")) != 0 || r.Date != day("2026-01-02") {
+				t.Fatalf("rate[1] = %+v", r)
+			}
+		})
+	}
+
+	bad := []string{
+		"date,base,quote\n2026-01-02,EUR,USD\n",
+		"date,base,quote,rate\n2026-13-02,EUR,USD,1\n",
+		"date,base,quote,rate\n2026-01-02,EUR,XXX,1\n",
+		"date,base,quote,rate\n2026-01-02,EUR,EUR,1\n",
+		"date,base,quote,rate\n2026-01-02,EUR,USD,-1\n",
+	}
+	for _, in := range bad {
+		if _, err := ParseCSV(strings.NewReader(in)); err == nil {
+			t.Errorf("ParseCSV(%q) succeeded, want error", in)
+		}
+	}
+	if _, err := Parse("xml", strings.NewReader("")); err == nil {
+		t.Errorf("Parse(xml) succeeded, want error")
+	}
+}
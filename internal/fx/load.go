@@ -0,0 +1,114 @@
+package fx
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/grpc-buf/internal/money"
+)
+
+// ParseCSV reads rates from CSV with a header row naming the columns date,
+// base, quote and rate in any order, e.g.
+//
+//	date,base,quote,rate
+//	2026-01-02,EUR,USD,1.0850
+func ParseCSV(r io.Reader) ([]Rate, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+	cols := map[string]int{}
+	for i, h := range header {
+		cols[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, want := range []string{"date", "base", "quote", "rate"} {
+		if _, ok := cols[want]; !ok {
+			return nil, fmt.Errorf("csv header is missing column %q", want)
+		}
+	}
+
+	var rates []Rate
+	for line := 2; ; line++ {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return rates, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read csv line %d: %w", line, err)
+		}
+		rate, err := parseRate(rec[cols["date"]], rec[cols["base"]], rec[cols["quote"]], rec[cols["rate"]])
+		if err != nil {
+			return nil, fmt.Errorf("csv line %d: %w", line, err)
+		}
+		rates = append(rates, rate)
+	}
+}
+
+// ParseJSON reads rates from a JSON array of objects with date, base, quote
+// and rate fields. rate may be a JSON number or a decimal string, e.g.
+//
+//	[{"date": "2026-01-02", "base": "EUR", "quote": "USD", "rate": "1.0850"}]
+func ParseJSON(r io.Reader) ([]Rate, error) {
+	var rows []struct {
+		Date  string          `json:"date"`
+		Base  string          `json:"base"`
+		Quote string          `json:"quote"`
+		Rate  json.RawMessage `json:"rate"`
+	}
+	if err := json.NewDecoder(r).Decode(&rows); err != nil {
+		return nil, fmt.Errorf("decode json rates: %w", err)
+	}
+	rates := make([]Rate, 0, len(rows))
+	for i, row := range rows {
+		value := strings.Trim(string(row.Rate), `"`)
+		rate, err := parseRate(row.Date, row.Base, row.Quote, value)
+		if err != nil {
+			return nil, fmt.Errorf("json rate %d: %w", i, err)
+		}
+		rates = append(rates, rate)
+	}
+	return rates, nil
+}
+
+// Parse reads rates in format "csv" or "json".
+func Parse(format string, r io.Reader) ([]Rate, error) {
+	switch strings.ToLower(format) {
+	case "csv":
+		return ParseCSV(r)
+	case "json":
+		return ParseJSON(r)
+	default:
+		return nil, fmt.Errorf("unknown rates format %q", format)
+	}
+}
+
+func parseRate(date, base, quote, value string) (Rate, error) {
+	d, err := time.Parse(time.DateOnly, strings.TrimSpace(date))
+	if err != nil {
+		return Rate{}, fmt.Errorf("invalid date %q", date)
+	}
+	b, ok := money.NormalizeCurrency(base)
+	if !ok {
+		return Rate{}, fmt.Errorf("unsupported base currency %q", base)
+	}
+	q, ok := money.NormalizeCurrency(quote)
+	if !ok {
+		return Rate{}, fmt.Errorf("unsupported quote currency %q", quote)
+	}
+	if b == q {
+		return Rate{}, fmt.Errorf("base and quote currency are both %s", b)
+	}
+	v, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok || v.Sign() <= 0 {
+		return Rate{}, fmt.Errorf("rate must be a positive decimal, got %q", value)
+	}
+	return Rate{Base: b, Quote: q, Date: d, Value: v}, nil
+}
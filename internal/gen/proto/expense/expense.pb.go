@@ -7,7 +7,9 @@
 package expensev1
 
 import (
+	fx "github.com/grpc-buf/internal/gen/proto/fx"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	date "google.golang.org/genproto/googleapis/type/date"
 	money "google.golang.org/genproto/googleapis/type/money"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -130,7 +132,8 @@ func (x *Expense) GetUpdateTime() *timestamppb.Timestamp {
 // embedded Expense (id, create_time, update_time) are ignored.
 type CreateExpenseRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Must include user_id and amount.
+	// Required. Must include amount. user_id defaults to the caller; only
+	// admins may set it to another user.
 	Expense       *Expense `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -176,7 +179,10 @@ func (x *CreateExpenseRequest) GetExpense() *Expense {
 type GetExpenseRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The expense id to fetch.
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The expense's owner; defaults to the caller. Only admins may name
+	// another user.
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -218,14 +224,24 @@ func (x *GetExpenseRequest) GetId() string {
 	return ""
 }
 
+func (x *GetExpenseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type ListExpensesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional filter: return only expenses for this user.
+	// The user whose expenses to return; defaults to the caller. Only admins
+	// may name another user.
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	// Maximum number of expenses to return. Server may cap this value.
 	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	// Opaque pagination token from a previous response.
-	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// List every user's expenses instead of user_id's. Admins only.
+	AllUsers      bool `protobuf:"varint,4,opt,name=all_users,json=allUsers,proto3" json:"all_users,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -281,6 +297,13 @@ func (x *ListExpensesRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListExpensesRequest) GetAllUsers() bool {
+	if x != nil {
+		return x.AllUsers
+	}
+	return false
+}
+
 type ListExpensesResponse struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
 	Expenses []*Expense             `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
@@ -389,17 +412,166 @@ func (x *UpdateExpenseRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
+type SummarizeExpensesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. ISO 4217 currency to report the total in.
+	TargetCurrency string `protobuf:"bytes,1,opt,name=target_currency,json=targetCurrency,proto3" json:"target_currency,omitempty"`
+	// The user whose expenses to summarize; scoped like
+	// ListExpensesRequest.user_id.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Optional filter: expenses created at or after this time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Optional filter: expenses created before this time.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Optional. Use the latest FX rate on or before this date; defaults to
+	// today (UTC).
+	RateDate *date.Date `protobuf:"bytes,5,opt,name=rate_date,json=rateDate,proto3" json:"rate_date,omitempty"`
+	// Summarize every user's expenses instead of user_id's. Admins only.
+	AllUsers      bool `protobuf:"varint,6,opt,name=all_users,json=allUsers,proto3" json:"all_users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SummarizeExpensesRequest) Reset() {
+	*x = SummarizeExpensesRequest{}
+	mi := &file_expense_expense_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SummarizeExpensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummarizeExpensesRequest) ProtoMessage() {}
+
+func (x *SummarizeExpensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_expense_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummarizeExpensesRequest.ProtoReflect.Descriptor instead.
+func (*SummarizeExpensesRequest) Descriptor() ([]byte, []int) {
+	return file_expense_expense_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SummarizeExpensesRequest) GetTargetCurrency() string {
+	if x != nil {
+		return x.TargetCurrency
+	}
+	return ""
+}
+
+func (x *SummarizeExpensesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SummarizeExpensesRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SummarizeExpensesRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SummarizeExpensesRequest) GetRateDate() *date.Date {
+	if x != nil {
+		return x.RateDate
+	}
+	return nil
+}
+
+func (x *SummarizeExpensesRequest) GetAllUsers() bool {
+	if x != nil {
+		return x.AllUsers
+	}
+	return false
+}
+
+type SummarizeExpensesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sum of all matching expenses in target_currency.
+	Total *money.Money `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	// Per source currency subtotals and the rates used to convert them.
+	ByCurrency    []*fx.ConvertedTotal `protobuf:"bytes,2,rep,name=by_currency,json=byCurrency,proto3" json:"by_currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SummarizeExpensesResponse) Reset() {
+	*x = SummarizeExpensesResponse{}
+	mi := &file_expense_expense_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SummarizeExpensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummarizeExpensesResponse) ProtoMessage() {}
+
+func (x *SummarizeExpensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_expense_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummarizeExpensesResponse.ProtoReflect.Descriptor instead.
+func (*SummarizeExpensesResponse) Descriptor() ([]byte, []int) {
+	return file_expense_expense_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SummarizeExpensesResponse) GetTotal() *money.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *SummarizeExpensesResponse) GetByCurrency() []*fx.ConvertedTotal {
+	if x != nil {
+		return x.ByCurrency
+	}
+	return nil
+}
+
 type DeleteExpenseRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The expense id to delete.
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The expense's owner; defaults to the caller. Only admins may name
+	// another user.
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteExpenseRequest) Reset() {
 	*x = DeleteExpenseRequest{}
-	mi := &file_expense_expense_proto_msgTypes[6]
+	mi := &file_expense_expense_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -411,7 +583,7 @@ func (x *DeleteExpenseRequest) String() string {
 func (*DeleteExpenseRequest) ProtoMessage() {}
 
 func (x *DeleteExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_expense_proto_msgTypes[6]
+	mi := &file_expense_expense_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,7 +596,7 @@ func (x *DeleteExpenseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteExpenseRequest.ProtoReflect.Descriptor instead.
 func (*DeleteExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_expense_expense_proto_rawDescGZIP(), []int{6}
+	return file_expense_expense_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteExpenseRequest) GetId() string {
@@ -434,11 +606,18 @@ func (x *DeleteExpenseRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteExpenseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 var File_expense_expense_proto protoreflect.FileDescriptor
 
 const file_expense_expense_proto_rawDesc = "" +
 	"\n" +
-	"\x15expense/expense.proto\x12\x0erpc.expense.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/type/money.proto\"\x96\x02\n" +
+	"\x15expense/expense.proto\x12\x0erpc.expense.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\vfx/fx.proto\x1a\x16google/type/date.proto\x1a\x17google/type/money.proto\"\x96\x02\n" +
 	"\aExpense\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12*\n" +
@@ -450,29 +629,45 @@ const file_expense_expense_proto_rawDesc = "" +
 	"\vupdate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"updateTime\"I\n" +
 	"\x14CreateExpenseRequest\x121\n" +
-	"\aexpense\x18\x01 \x01(\v2\x17.rpc.expense.v1.ExpenseR\aexpense\"#\n" +
+	"\aexpense\x18\x01 \x01(\v2\x17.rpc.expense.v1.ExpenseR\aexpense\"<\n" +
 	"\x11GetExpenseRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"j\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x87\x01\n" +
 	"\x13ListExpensesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"s\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tall_users\x18\x04 \x01(\bR\ballUsers\"s\n" +
 	"\x14ListExpensesResponse\x123\n" +
 	"\bexpenses\x18\x01 \x03(\v2\x17.rpc.expense.v1.ExpenseR\bexpenses\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x86\x01\n" +
 	"\x14UpdateExpenseRequest\x121\n" +
 	"\aexpense\x18\x01 \x01(\v2\x17.rpc.expense.v1.ExpenseR\aexpense\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\"&\n" +
+	"updateMask\"\x9b\x02\n" +
+	"\x18SummarizeExpensesRequest\x12'\n" +
+	"\x0ftarget_currency\x18\x01 \x01(\tR\x0etargetCurrency\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12.\n" +
+	"\trate_date\x18\x05 \x01(\v2\x11.google.type.DateR\brateDate\x12\x1b\n" +
+	"\tall_users\x18\x06 \x01(\bR\ballUsers\"\x81\x01\n" +
+	"\x19SummarizeExpensesResponse\x12(\n" +
+	"\x05total\x18\x01 \x01(\v2\x12.google.type.MoneyR\x05total\x12:\n" +
+	"\vby_currency\x18\x02 \x03(\v2\x19.rpc.fx.v1.ConvertedTotalR\n" +
+	"byCurrency\"?\n" +
 	"\x14DeleteExpenseRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id2\xaf\x04\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId2\xba\x05\n" +
 	"\x0eExpenseService\x12g\n" +
 	"\rCreateExpense\x12$.rpc.expense.v1.CreateExpenseRequest\x1a\x17.rpc.expense.v1.Expense\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/expenses\x12c\n" +
 	"\n" +
 	"GetExpense\x12!.rpc.expense.v1.GetExpenseRequest\x1a\x17.rpc.expense.v1.Expense\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/expenses/{id}\x12o\n" +
 	"\fListExpenses\x12#.rpc.expense.v1.ListExpensesRequest\x1a$.rpc.expense.v1.ListExpensesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/expenses\x12t\n" +
-	"\rUpdateExpense\x12$.rpc.expense.v1.UpdateExpenseRequest\x1a\x17.rpc.expense.v1.Expense\"$\x82\xd3\xe4\x93\x02\x1e:\x01*2\x19/v1/expenses/{expense.id}\x12h\n" +
+	"\rUpdateExpense\x12$.rpc.expense.v1.UpdateExpenseRequest\x1a\x17.rpc.expense.v1.Expense\"$\x82\xd3\xe4\x93\x02\x1e:\x01*2\x19/v1/expenses/{expense.id}\x12\x88\x01\n" +
+	"\x11SummarizeExpenses\x12(.rpc.expense.v1.SummarizeExpensesRequest\x1a).rpc.expense.v1.SummarizeExpensesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/expenses:summarize\x12h\n" +
 	"\rDeleteExpense\x12$.rpc.expense.v1.DeleteExpenseRequest\x1a\x16.google.protobuf.Empty\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/expenses/{id}B\xb6\x01\n" +
 	"\x12com.rpc.expense.v1B\fExpenseProtoP\x01Z8github.com/grpc-buf/internal/gen/proto/expense;expensev1\xa2\x02\x03REX\xaa\x02\x0eRpc.Expense.V1\xca\x02\x0eRpc\\Expense\\V1\xe2\x02\x1aRpc\\Expense\\V1\\GPBMetadata\xea\x02\x10Rpc::Expense::V1b\x06proto3"
 
@@ -488,43 +683,54 @@ func file_expense_expense_proto_rawDescGZIP() []byte {
 	return file_expense_expense_proto_rawDescData
 }
 
-var file_expense_expense_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_expense_expense_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_expense_expense_proto_goTypes = []any{
-	(*Expense)(nil),               // 0: rpc.expense.v1.Expense
-	(*CreateExpenseRequest)(nil),  // 1: rpc.expense.v1.CreateExpenseRequest
-	(*GetExpenseRequest)(nil),     // 2: rpc.expense.v1.GetExpenseRequest
-	(*ListExpensesRequest)(nil),   // 3: rpc.expense.v1.ListExpensesRequest
-	(*ListExpensesResponse)(nil),  // 4: rpc.expense.v1.ListExpensesResponse
-	(*UpdateExpenseRequest)(nil),  // 5: rpc.expense.v1.UpdateExpenseRequest
-	(*DeleteExpenseRequest)(nil),  // 6: rpc.expense.v1.DeleteExpenseRequest
-	(*money.Money)(nil),           // 7: google.type.Money
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil), // 9: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),         // 10: google.protobuf.Empty
+	(*Expense)(nil),                   // 0: rpc.expense.v1.Expense
+	(*CreateExpenseRequest)(nil),      // 1: rpc.expense.v1.CreateExpenseRequest
+	(*GetExpenseRequest)(nil),         // 2: rpc.expense.v1.GetExpenseRequest
+	(*ListExpensesRequest)(nil),       // 3: rpc.expense.v1.ListExpensesRequest
+	(*ListExpensesResponse)(nil),      // 4: rpc.expense.v1.ListExpensesResponse
+	(*UpdateExpenseRequest)(nil),      // 5: rpc.expense.v1.UpdateExpenseRequest
+	(*SummarizeExpensesRequest)(nil),  // 6: rpc.expense.v1.SummarizeExpensesRequest
+	(*SummarizeExpensesResponse)(nil), // 7: rpc.expense.v1.SummarizeExpensesResponse
+	(*DeleteExpenseRequest)(nil),      // 8: rpc.expense.v1.DeleteExpenseRequest
+	(*money.Money)(nil),               // 9: google.type.Money
+	(*timestamppb.Timestamp)(nil),     // 10: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),     // 11: google.protobuf.FieldMask
+	(*date.Date)(nil),                 // 12: google.type.Date
+	(*fx.ConvertedTotal)(nil),         // 13: rpc.fx.v1.ConvertedTotal
+	(*emptypb.Empty)(nil),             // 14: google.protobuf.Empty
 }
 var file_expense_expense_proto_depIdxs = []int32{
-	7,  // 0: rpc.expense.v1.Expense.amount:type_name -> google.type.Money
-	8,  // 1: rpc.expense.v1.Expense.create_time:type_name -> google.protobuf.Timestamp
-	8,  // 2: rpc.expense.v1.Expense.update_time:type_name -> google.protobuf.Timestamp
+	9,  // 0: rpc.expense.v1.Expense.amount:type_name -> google.type.Money
+	10, // 1: rpc.expense.v1.Expense.create_time:type_name -> google.protobuf.Timestamp
+	10, // 2: rpc.expense.v1.Expense.update_time:type_name -> google.protobuf.Timestamp
 	0,  // 3: rpc.expense.v1.CreateExpenseRequest.expense:type_name -> rpc.expense.v1.Expense
 	0,  // 4: rpc.expense.v1.ListExpensesResponse.expenses:type_name -> rpc.expense.v1.Expense
 	0,  // 5: rpc.expense.v1.UpdateExpenseRequest.expense:type_name -> rpc.expense.v1.Expense
-	9,  // 6: rpc.expense.v1.UpdateExpenseRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 7: rpc.expense.v1.ExpenseService.CreateExpense:input_type -> rpc.expense.v1.CreateExpenseRequest
-	2,  // 8: rpc.expense.v1.ExpenseService.GetExpense:input_type -> rpc.expense.v1.GetExpenseRequest
-	3,  // 9: rpc.expense.v1.ExpenseService.ListExpenses:input_type -> rpc.expense.v1.ListExpensesRequest
-	5,  // 10: rpc.expense.v1.ExpenseService.UpdateExpense:input_type -> rpc.expense.v1.UpdateExpenseRequest
-	6,  // 11: rpc.expense.v1.ExpenseService.DeleteExpense:input_type -> rpc.expense.v1.DeleteExpenseRequest
-	0,  // 12: rpc.expense.v1.ExpenseService.CreateExpense:output_type -> rpc.expense.v1.Expense
-	0,  // 13: rpc.expense.v1.ExpenseService.GetExpense:output_type -> rpc.expense.v1.Expense
-	4,  // 14: rpc.expense.v1.ExpenseService.ListExpenses:output_type -> rpc.expense.v1.ListExpensesResponse
-	0,  // 15: rpc.expense.v1.ExpenseService.UpdateExpense:output_type -> rpc.expense.v1.Expense
-	10, // 16: rpc.expense.v1.ExpenseService.DeleteExpense:output_type -> google.protobuf.Empty
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	11, // 6: rpc.expense.v1.UpdateExpenseRequest.update_mask:type_name -> google.protobuf.FieldMask
+	10, // 7: rpc.expense.v1.SummarizeExpensesRequest.start_time:type_name -> google.protobuf.Timestamp
+	10, // 8: rpc.expense.v1.SummarizeExpensesRequest.end_time:type_name -> google.protobuf.Timestamp
+	12, // 9: rpc.expense.v1.SummarizeExpensesRequest.rate_date:type_name -> google.type.Date
+	9,  // 10: rpc.expense.v1.SummarizeExpensesResponse.total:type_name -> google.type.Money
+	13, // 11: rpc.expense.v1.SummarizeExpensesResponse.by_currency:type_name -> rpc.fx.v1.ConvertedTotal
+	1,  // 12: rpc.expense.v1.ExpenseService.CreateExpense:input_type -> rpc.expense.v1.CreateExpenseRequest
+	2,  // 13: rpc.expense.v1.ExpenseService.GetExpense:input_type -> rpc.expense.v1.GetExpenseRequest
+	3,  // 14: rpc.expense.v1.ExpenseService.ListExpenses:input_type -> rpc.expense.v1.ListExpensesRequest
+	5,  // 15: rpc.expense.v1.ExpenseService.UpdateExpense:input_type -> rpc.expense.v1.UpdateExpenseRequest
+	6,  // 16: rpc.expense.v1.ExpenseService.SummarizeExpenses:input_type -> rpc.expense.v1.SummarizeExpensesRequest
+	8,  // 17: rpc.expense.v1.ExpenseService.DeleteExpense:input_type -> rpc.expense.v1.DeleteExpenseRequest
+	0,  // 18: rpc.expense.v1.ExpenseService.CreateExpense:output_type -> rpc.expense.v1.Expense
+	0,  // 19: rpc.expense.v1.ExpenseService.GetExpense:output_type -> rpc.expense.v1.Expense
+	4,  // 20: rpc.expense.v1.ExpenseService.ListExpenses:output_type -> rpc.expense.v1.ListExpensesResponse
+	0,  // 21: rpc.expense.v1.ExpenseService.UpdateExpense:output_type -> rpc.expense.v1.Expense
+	7,  // 22: rpc.expense.v1.ExpenseService.SummarizeExpenses:output_type -> rpc.expense.v1.SummarizeExpensesResponse
+	14, // 23: rpc.expense.v1.ExpenseService.DeleteExpense:output_type -> google.protobuf.Empty
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_expense_expense_proto_init() }
@@ -538,7 +744,7 @@ func file_expense_expense_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_expense_expense_proto_rawDesc), len(file_expense_expense_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
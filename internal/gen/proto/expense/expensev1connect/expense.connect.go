@@ -46,6 +46,9 @@ const (
 	// ExpenseServiceUpdateExpenseProcedure is the fully-qualified name of the ExpenseService's
 	// UpdateExpense RPC.
 	ExpenseServiceUpdateExpenseProcedure = "/rpc.expense.v1.ExpenseService/UpdateExpense"
+	// ExpenseServiceSummarizeExpensesProcedure is the fully-qualified name of the ExpenseService's
+	// SummarizeExpenses RPC.
+	ExpenseServiceSummarizeExpensesProcedure = "/rpc.expense.v1.ExpenseService/SummarizeExpenses"
 	// ExpenseServiceDeleteExpenseProcedure is the fully-qualified name of the ExpenseService's
 	// DeleteExpense RPC.
 	ExpenseServiceDeleteExpenseProcedure = "/rpc.expense.v1.ExpenseService/DeleteExpense"
@@ -57,6 +60,10 @@ type ExpenseServiceClient interface {
 	GetExpense(context.Context, *connect.Request[expense.GetExpenseRequest]) (*connect.Response[expense.Expense], error)
 	ListExpenses(context.Context, *connect.Request[expense.ListExpensesRequest]) (*connect.Response[expense.ListExpensesResponse], error)
 	UpdateExpense(context.Context, *connect.Request[expense.UpdateExpenseRequest]) (*connect.Response[expense.Expense], error)
+	// SummarizeExpenses totals matching expenses across currencies, converting
+	// each currency's subtotal into target_currency with stored FX rates.
+	// Returns FAILED_PRECONDITION when a needed rate is missing.
+	SummarizeExpenses(context.Context, *connect.Request[expense.SummarizeExpensesRequest]) (*connect.Response[expense.SummarizeExpensesResponse], error)
 	// DeleteExpense removes the expense. Returns Empty on success (AIP-135);
 	// codes.NotFound if no row matched.
 	DeleteExpense(context.Context, *connect.Request[expense.DeleteExpenseRequest]) (*connect.Response[emptypb.Empty], error)
@@ -97,6 +104,12 @@ func NewExpenseServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(expenseServiceMethods.ByName("UpdateExpense")),
 			connect.WithClientOptions(opts...),
 		),
+		summarizeExpenses: connect.NewClient[expense.SummarizeExpensesRequest, expense.SummarizeExpensesResponse](
+			httpClient,
+			baseURL+ExpenseServiceSummarizeExpensesProcedure,
+			connect.WithSchema(expenseServiceMethods.ByName("SummarizeExpenses")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteExpense: connect.NewClient[expense.DeleteExpenseRequest, emptypb.Empty](
 			httpClient,
 			baseURL+ExpenseServiceDeleteExpenseProcedure,
@@ -108,11 +121,12 @@ func NewExpenseServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 
 // expenseServiceClient implements ExpenseServiceClient.
 type expenseServiceClient struct {
-	createExpense *connect.Client[expense.CreateExpenseRequest, expense.Expense]
-	getExpense    *connect.Client[expense.GetExpenseRequest, expense.Expense]
-	listExpenses  *connect.Client[expense.ListExpensesRequest, expense.ListExpensesResponse]
-	updateExpense *connect.Client[expense.UpdateExpenseRequest, expense.Expense]
-	deleteExpense *connect.Client[expense.DeleteExpenseRequest, emptypb.Empty]
+	createExpense     *connect.Client[expense.CreateExpenseRequest, expense.Expense]
+	getExpense        *connect.Client[expense.GetExpenseRequest, expense.Expense]
+	listExpenses      *connect.Client[expense.ListExpensesRequest, expense.ListExpensesResponse]
+	updateExpense     *connect.Client[expense.UpdateExpenseRequest, expense.Expense]
+	summarizeExpenses *connect.Client[expense.SummarizeExpensesRequest, expense.SummarizeExpensesResponse]
+	deleteExpense     *connect.Client[expense.DeleteExpenseRequest, emptypb.Empty]
 }
 
 // CreateExpense calls rpc.expense.v1.ExpenseService.CreateExpense.
@@ -135,6 +149,11 @@ func (c *expenseServiceClient) UpdateExpense(ctx context.Context, req *connect.R
 	return c.updateExpense.CallUnary(ctx, req)
 }
 
+// SummarizeExpenses calls rpc.expense.v1.ExpenseService.SummarizeExpenses.
+func (c *expenseServiceClient) SummarizeExpenses(ctx context.Context, req *connect.Request[expense.SummarizeExpensesRequest]) (*connect.Response[expense.SummarizeExpensesResponse], error) {
+	return c.summarizeExpenses.CallUnary(ctx, req)
+}
+
 // DeleteExpense calls rpc.expense.v1.ExpenseService.DeleteExpense.
 func (c *expenseServiceClient) DeleteExpense(ctx context.Context, req *connect.Request[expense.DeleteExpenseRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.deleteExpense.CallUnary(ctx, req)
@@ -146,6 +165,10 @@ type ExpenseServiceHandler interface {
 	GetExpense(context.Context, *connect.Request[expense.GetExpenseRequest]) (*connect.Response[expense.Expense], error)
 	ListExpenses(context.Context, *connect.Request[expense.ListExpensesRequest]) (*connect.Response[expense.ListExpensesResponse], error)
 	UpdateExpense(context.Context, *connect.Request[expense.UpdateExpenseRequest]) (*connect.Response[expense.Expense], error)
+	// SummarizeExpenses totals matching expenses across currencies, converting
+	// each currency's subtotal into target_currency with stored FX rates.
+	// Returns FAILED_PRECONDITION when a needed rate is missing.
+	SummarizeExpenses(context.Context, *connect.Request[expense.SummarizeExpensesRequest]) (*connect.Response[expense.SummarizeExpensesResponse], error)
 	// DeleteExpense removes the expense. Returns Empty on success (AIP-135);
 	// codes.NotFound if no row matched.
 	DeleteExpense(context.Context, *connect.Request[expense.DeleteExpenseRequest]) (*connect.Response[emptypb.Empty], error)
@@ -182,6 +205,12 @@ func NewExpenseServiceHandler(svc ExpenseServiceHandler, opts ...connect.Handler
 		connect.WithSchema(expenseServiceMethods.ByName("UpdateExpense")),
 		connect.WithHandlerOptions(opts...),
 	)
+	expenseServiceSummarizeExpensesHandler := connect.NewUnaryHandler(
+		ExpenseServiceSummarizeExpensesProcedure,
+		svc.SummarizeExpenses,
+		connect.WithSchema(expenseServiceMethods.ByName("SummarizeExpenses")),
+		connect.WithHandlerOptions(opts...),
+	)
 	expenseServiceDeleteExpenseHandler := connect.NewUnaryHandler(
 		ExpenseServiceDeleteExpenseProcedure,
 		svc.DeleteExpense,
@@ -198,6 +227,8 @@ func NewExpenseServiceHandler(svc ExpenseServiceHandler, opts ...connect.Handler
 			expenseServiceListExpensesHandler.ServeHTTP(w, r)
 		case ExpenseServiceUpdateExpenseProcedure:
 			expenseServiceUpdateExpenseHandler.ServeHTTP(w, r)
+		case ExpenseServiceSummarizeExpensesProcedure:
+			expenseServiceSummarizeExpensesHandler.ServeHTTP(w, r)
 		case ExpenseServiceDeleteExpenseProcedure:
 			expenseServiceDeleteExpenseHandler.ServeHTTP(w, r)
 		default:
@@ -225,6 +256,10 @@ func (UnimplementedExpenseServiceHandler) UpdateExpense(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.expense.v1.ExpenseService.UpdateExpense is not implemented"))
 }
 
+func (UnimplementedExpenseServiceHandler) SummarizeExpenses(context.Context, *connect.Request[expense.SummarizeExpensesRequest]) (*connect.Response[expense.SummarizeExpensesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.expense.v1.ExpenseService.SummarizeExpenses is not implemented"))
+}
+
 func (UnimplementedExpenseServiceHandler) DeleteExpense(context.Context, *connect.Request[expense.DeleteExpenseRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.expense.v1.ExpenseService.DeleteExpense is not implemented"))
 }
@@ -0,0 +1,166 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: fx/fx.proto
+
+package fxv1
+
+import (
+	date "google.golang.org/genproto/googleapis/type/date"
+	money "google.golang.org/genproto/googleapis/type/money"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConvertedTotal is one source currency's contribution to a converted report
+// total.
+type ConvertedTotal struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sum of the matching amounts in their original currency.
+	Original *money.Money `protobuf:"bytes,1,opt,name=original,proto3" json:"original,omitempty"`
+	// original converted into the report's target currency, rounded half-even
+	// to the target currency's minor unit.
+	Converted *money.Money `protobuf:"bytes,2,opt,name=converted,proto3" json:"converted,omitempty"`
+	// Decimal exchange rate applied: one unit of the original currency in the
+	// target currency. "1" when no conversion was needed.
+	Rate string `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	// Date of the rate applied: the latest stored rate on or before the
+	// requested rate date. Unset when no conversion was needed.
+	RateDate      *date.Date `protobuf:"bytes,4,opt,name=rate_date,json=rateDate,proto3" json:"rate_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertedTotal) Reset() {
+	*x = ConvertedTotal{}
+	mi := &file_fx_fx_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertedTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertedTotal) ProtoMessage() {}
+
+func (x *ConvertedTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_fx_fx_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertedTotal.ProtoReflect.Descriptor instead.
+func (*ConvertedTotal) Descriptor() ([]byte, []int) {
+	return file_fx_fx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConvertedTotal) GetOriginal() *money.Money {
+	if x != nil {
+		return x.Original
+	}
+	return nil
+}
+
+func (x *ConvertedTotal) GetConverted() *money.Money {
+	if x != nil {
+		return x.Converted
+	}
+	return nil
+}
+
+func (x *ConvertedTotal) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *ConvertedTotal) GetRateDate() *date.Date {
+	if x != nil {
+		return x.RateDate
+	}
+	return nil
+}
+
+var File_fx_fx_proto protoreflect.FileDescriptor
+
+const file_fx_fx_proto_rawDesc = "" +
+	"\n" +
+	"\vfx/fx.proto\x12\trpc.fx.v1\x1a\x16google/type/date.proto\x1a\x17google/type/money.proto\"\xb6\x01\n" +
+	"\x0eConvertedTotal\x12.\n" +
+	"\boriginal\x18\x01 \x01(\v2\x12.google.type.MoneyR\boriginal\x120\n" +
+	"\tconverted\x18\x02 \x01(\v2\x12.google.type.MoneyR\tconverted\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\x12.\n" +
+	"\trate_date\x18\x04 \x01(\v2\x11.google.type.DateR\brateDateB\x8e\x01\n" +
+	"\rcom.rpc.fx.v1B\aFxProtoP\x01Z.github.com/grpc-buf/internal/gen/proto/fx;fxv1\xa2\x02\x03RFX\xaa\x02\tRpc.Fx.V1\xca\x02\tRpc\\Fx\\V1\xe2\x02\x15Rpc\\Fx\\V1\\GPBMetadata\xea\x02\vRpc::Fx::V1b\x06proto3"
+
+var (
+	file_fx_fx_proto_rawDescOnce sync.Once
+	file_fx_fx_proto_rawDescData []byte
+)
+
+func file_fx_fx_proto_rawDescGZIP() []byte {
+	file_fx_fx_proto_rawDescOnce.Do(func() {
+		file_fx_fx_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_fx_fx_proto_rawDesc), len(file_fx_fx_proto_rawDesc)))
+	})
+	return file_fx_fx_proto_rawDescData
+}
+
+var file_fx_fx_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_fx_fx_proto_goTypes = []any{
+	(*ConvertedTotal)(nil), // 0: rpc.fx.v1.ConvertedTotal
+	(*money.Money)(nil),    // 1: google.type.Money
+	(*date.Date)(nil),      // 2: google.type.Date
+}
+var file_fx_fx_proto_depIdxs = []int32{
+	1, // 0: rpc.fx.v1.ConvertedTotal.original:type_name -> google.type.Money
+	1, // 1: rpc.fx.v1.ConvertedTotal.converted:type_name -> google.type.Money
+	2, // 2: rpc.fx.v1.ConvertedTotal.rate_date:type_name -> google.type.Date
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_fx_fx_proto_init() }
+func file_fx_fx_proto_init() {
+	if File_fx_fx_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fx_fx_proto_rawDesc), len(file_fx_fx_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fx_fx_proto_goTypes,
+		DependencyIndexes: file_fx_fx_proto_depIdxs,
+		MessageInfos:      file_fx_fx_proto_msgTypes,
+	}.Build()
+	File_fx_fx_proto = out.File
+	file_fx_fx_proto_goTypes = nil
+	file_fx_fx_proto_depIdxs = nil
+}
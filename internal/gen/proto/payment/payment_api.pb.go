@@ -7,8 +7,11 @@
 package paymentv1
 
 import (
+	fx "github.com/grpc-buf/internal/gen/proto/fx"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	date "google.golang.org/genproto/googleapis/type/date"
 	money "google.golang.org/genproto/googleapis/type/money"
+	postaladdress "google.golang.org/genproto/googleapis/type/postaladdress"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -24,12 +27,371 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// InvoiceState is the lifecycle state of an invoice.
+type InvoiceState int32
+
+const (
+	InvoiceState_INVOICE_STATE_UNSPECIFIED InvoiceState = 0
+	// Awaiting payment.
+	InvoiceState_INVOICE_STATE_OPEN InvoiceState = 1
+	// Settled via PayInvoice or MarkInvoicePaid.
+	InvoiceState_INVOICE_STATE_PAID InvoiceState = 2
+	// Voided via CancelInvoice; can no longer be paid.
+	InvoiceState_INVOICE_STATE_CANCELLED InvoiceState = 3
+	// Unpaid past its due date; still payable. Set by the dunning job.
+	InvoiceState_INVOICE_STATE_OVERDUE InvoiceState = 4
+)
+
+// Enum value maps for InvoiceState.
+var (
+	InvoiceState_name = map[int32]string{
+		0: "INVOICE_STATE_UNSPECIFIED",
+		1: "INVOICE_STATE_OPEN",
+		2: "INVOICE_STATE_PAID",
+		3: "INVOICE_STATE_CANCELLED",
+		4: "INVOICE_STATE_OVERDUE",
+	}
+	InvoiceState_value = map[string]int32{
+		"INVOICE_STATE_UNSPECIFIED": 0,
+		"INVOICE_STATE_OPEN":        1,
+		"INVOICE_STATE_PAID":        2,
+		"INVOICE_STATE_CANCELLED":   3,
+		"INVOICE_STATE_OVERDUE":     4,
+	}
+)
+
+func (x InvoiceState) Enum() *InvoiceState {
+	p := new(InvoiceState)
+	*p = x
+	return p
+}
+
+func (x InvoiceState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvoiceState) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_payment_api_proto_enumTypes[0].Descriptor()
+}
+
+func (InvoiceState) Type() protoreflect.EnumType {
+	return &file_payment_payment_api_proto_enumTypes[0]
+}
+
+func (x InvoiceState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvoiceState.Descriptor instead.
+func (InvoiceState) EnumDescriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{0}
+}
+
+// FraudDecision is the outcome of the fraud checks MakePayment runs before
+// charging.
+type FraudDecision int32
+
+const (
+	FraudDecision_FRAUD_DECISION_UNSPECIFIED FraudDecision = 0
+	// No rule matched.
+	FraudDecision_FRAUD_DECISION_ALLOW FraudDecision = 1
+	// Only review rules matched; the payment was charged and should be
+	// reviewed.
+	FraudDecision_FRAUD_DECISION_REVIEW FraudDecision = 2
+	// A deny rule matched; the payment was rejected with PERMISSION_DENIED.
+	FraudDecision_FRAUD_DECISION_DENY FraudDecision = 3
+)
+
+// Enum value maps for FraudDecision.
+var (
+	FraudDecision_name = map[int32]string{
+		0: "FRAUD_DECISION_UNSPECIFIED",
+		1: "FRAUD_DECISION_ALLOW",
+		2: "FRAUD_DECISION_REVIEW",
+		3: "FRAUD_DECISION_DENY",
+	}
+	FraudDecision_value = map[string]int32{
+		"FRAUD_DECISION_UNSPECIFIED": 0,
+		"FRAUD_DECISION_ALLOW":       1,
+		"FRAUD_DECISION_REVIEW":      2,
+		"FRAUD_DECISION_DENY":        3,
+	}
+)
+
+func (x FraudDecision) Enum() *FraudDecision {
+	p := new(FraudDecision)
+	*p = x
+	return p
+}
+
+func (x FraudDecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FraudDecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_payment_api_proto_enumTypes[1].Descriptor()
+}
+
+func (FraudDecision) Type() protoreflect.EnumType {
+	return &file_payment_payment_api_proto_enumTypes[1]
+}
+
+func (x FraudDecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FraudDecision.Descriptor instead.
+func (FraudDecision) EnumDescriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{1}
+}
+
+// ReconciliationStatus is the outcome of matching one item.
+type ReconciliationStatus int32
+
+const (
+	ReconciliationStatus_RECONCILIATION_STATUS_UNSPECIFIED ReconciliationStatus = 0
+	// The row and the payment agree.
+	ReconciliationStatus_RECONCILIATION_STATUS_MATCHED ReconciliationStatus = 1
+	// The processor settled a row no payment matches.
+	ReconciliationStatus_RECONCILIATION_STATUS_MISSING_OURS ReconciliationStatus = 2
+	// A settled payment has no settlement row.
+	ReconciliationStatus_RECONCILIATION_STATUS_MISSING_PROVIDER ReconciliationStatus = 3
+	// The row matches a payment by reference but not by amount or currency.
+	ReconciliationStatus_RECONCILIATION_STATUS_AMOUNT_MISMATCH ReconciliationStatus = 4
+)
+
+// Enum value maps for ReconciliationStatus.
+var (
+	ReconciliationStatus_name = map[int32]string{
+		0: "RECONCILIATION_STATUS_UNSPECIFIED",
+		1: "RECONCILIATION_STATUS_MATCHED",
+		2: "RECONCILIATION_STATUS_MISSING_OURS",
+		3: "RECONCILIATION_STATUS_MISSING_PROVIDER",
+		4: "RECONCILIATION_STATUS_AMOUNT_MISMATCH",
+	}
+	ReconciliationStatus_value = map[string]int32{
+		"RECONCILIATION_STATUS_UNSPECIFIED":      0,
+		"RECONCILIATION_STATUS_MATCHED":          1,
+		"RECONCILIATION_STATUS_MISSING_OURS":     2,
+		"RECONCILIATION_STATUS_MISSING_PROVIDER": 3,
+		"RECONCILIATION_STATUS_AMOUNT_MISMATCH":  4,
+	}
+)
+
+func (x ReconciliationStatus) Enum() *ReconciliationStatus {
+	p := new(ReconciliationStatus)
+	*p = x
+	return p
+}
+
+func (x ReconciliationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconciliationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_payment_api_proto_enumTypes[2].Descriptor()
+}
+
+func (ReconciliationStatus) Type() protoreflect.EnumType {
+	return &file_payment_payment_api_proto_enumTypes[2]
+}
+
+func (x ReconciliationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconciliationStatus.Descriptor instead.
+func (ReconciliationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{2}
+}
+
+// ReportFormat selects the encoding of a downloadable report.
+type ReportFormat int32
+
+const (
+	ReportFormat_REPORT_FORMAT_UNSPECIFIED ReportFormat = 0
+	ReportFormat_REPORT_FORMAT_CSV         ReportFormat = 1
+	ReportFormat_REPORT_FORMAT_JSON        ReportFormat = 2
+)
+
+// Enum value maps for ReportFormat.
+var (
+	ReportFormat_name = map[int32]string{
+		0: "REPORT_FORMAT_UNSPECIFIED",
+		1: "REPORT_FORMAT_CSV",
+		2: "REPORT_FORMAT_JSON",
+	}
+	ReportFormat_value = map[string]int32{
+		"REPORT_FORMAT_UNSPECIFIED": 0,
+		"REPORT_FORMAT_CSV":         1,
+		"REPORT_FORMAT_JSON":        2,
+	}
+)
+
+func (x ReportFormat) Enum() *ReportFormat {
+	p := new(ReportFormat)
+	*p = x
+	return p
+}
+
+func (x ReportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_payment_api_proto_enumTypes[3].Descriptor()
+}
+
+func (ReportFormat) Type() protoreflect.EnumType {
+	return &file_payment_payment_api_proto_enumTypes[3]
+}
+
+func (x ReportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportFormat.Descriptor instead.
+func (ReportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{3}
+}
+
+// RefundReason records why a refund was made.
+type RefundReason int32
+
+const (
+	RefundReason_REFUND_REASON_UNSPECIFIED           RefundReason = 0
+	RefundReason_REFUND_REASON_REQUESTED_BY_CUSTOMER RefundReason = 1
+	RefundReason_REFUND_REASON_DUPLICATE             RefundReason = 2
+	RefundReason_REFUND_REASON_FRAUDULENT            RefundReason = 3
+)
+
+// Enum value maps for RefundReason.
+var (
+	RefundReason_name = map[int32]string{
+		0: "REFUND_REASON_UNSPECIFIED",
+		1: "REFUND_REASON_REQUESTED_BY_CUSTOMER",
+		2: "REFUND_REASON_DUPLICATE",
+		3: "REFUND_REASON_FRAUDULENT",
+	}
+	RefundReason_value = map[string]int32{
+		"REFUND_REASON_UNSPECIFIED":           0,
+		"REFUND_REASON_REQUESTED_BY_CUSTOMER": 1,
+		"REFUND_REASON_DUPLICATE":             2,
+		"REFUND_REASON_FRAUDULENT":            3,
+	}
+)
+
+func (x RefundReason) Enum() *RefundReason {
+	p := new(RefundReason)
+	*p = x
+	return p
+}
+
+func (x RefundReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefundReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_payment_api_proto_enumTypes[4].Descriptor()
+}
+
+func (RefundReason) Type() protoreflect.EnumType {
+	return &file_payment_payment_api_proto_enumTypes[4]
+}
+
+func (x RefundReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundReason.Descriptor instead.
+func (RefundReason) EnumDescriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{4}
+}
+
+// DisputeStatus is the state of a dispute. Legal transitions:
+//
+//	OPEN -> WON | LOST
+type DisputeStatus int32
+
+const (
+	DisputeStatus_DISPUTE_STATUS_UNSPECIFIED DisputeStatus = 0
+	// Evidence may be added until the deadline.
+	DisputeStatus_DISPUTE_STATUS_OPEN DisputeStatus = 1
+	// Resolved in the merchant's favour; the payment is unchanged.
+	DisputeStatus_DISPUTE_STATUS_WON DisputeStatus = 2
+	// Resolved in the cardholder's favour; the disputed amount is deducted
+	// from the payment's net amount.
+	DisputeStatus_DISPUTE_STATUS_LOST DisputeStatus = 3
+)
+
+// Enum value maps for DisputeStatus.
+var (
+	DisputeStatus_name = map[int32]string{
+		0: "DISPUTE_STATUS_UNSPECIFIED",
+		1: "DISPUTE_STATUS_OPEN",
+		2: "DISPUTE_STATUS_WON",
+		3: "DISPUTE_STATUS_LOST",
+	}
+	DisputeStatus_value = map[string]int32{
+		"DISPUTE_STATUS_UNSPECIFIED": 0,
+		"DISPUTE_STATUS_OPEN":        1,
+		"DISPUTE_STATUS_WON":         2,
+		"DISPUTE_STATUS_LOST":        3,
+	}
+)
+
+func (x DisputeStatus) Enum() *DisputeStatus {
+	p := new(DisputeStatus)
+	*p = x
+	return p
+}
+
+func (x DisputeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DisputeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_payment_api_proto_enumTypes[5].Descriptor()
+}
+
+func (DisputeStatus) Type() protoreflect.EnumType {
+	return &file_payment_payment_api_proto_enumTypes[5]
+}
+
+func (x DisputeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DisputeStatus.Descriptor instead.
+func (DisputeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{5}
+}
+
+// PaymentStatus is the lifecycle state of a payment. Legal transitions:
+//
+//	AUTHORIZED -> CAPTURED | VOIDED
+//	CAPTURED -> PARTIALLY_REFUNDED | REFUNDED
+//	PARTIALLY_REFUNDED -> PARTIALLY_REFUNDED | REFUNDED
 type PaymentStatus int32
 
 const (
 	PaymentStatus_PAYMENT_STATUS_UNSPECIFIED PaymentStatus = 0
-	PaymentStatus_PAYMENT_STATUS_PAID        PaymentStatus = 1
-	PaymentStatus_PAYMENT_STATUS_FAILED      PaymentStatus = 2
+	// Legacy terminal state written before the lifecycle existed; equivalent
+	// to CAPTURED. New payments never use it.
+	//
+	// Deprecated: Marked as deprecated in payment/payment_api.proto.
+	PaymentStatus_PAYMENT_STATUS_PAID   PaymentStatus = 1
+	PaymentStatus_PAYMENT_STATUS_FAILED PaymentStatus = 2
+	// Funds are held on the card but not yet settled.
+	PaymentStatus_PAYMENT_STATUS_AUTHORIZED PaymentStatus = 3
+	// Funds have been settled.
+	PaymentStatus_PAYMENT_STATUS_CAPTURED PaymentStatus = 4
+	// Authorization was released before capture.
+	PaymentStatus_PAYMENT_STATUS_VOIDED PaymentStatus = 5
+	// The full captured amount has been returned.
+	PaymentStatus_PAYMENT_STATUS_REFUNDED PaymentStatus = 6
+	// Part of the captured amount has been returned.
+	PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED PaymentStatus = 7
 )
 
 // Enum value maps for PaymentStatus.
@@ -38,11 +400,21 @@ var (
 		0: "PAYMENT_STATUS_UNSPECIFIED",
 		1: "PAYMENT_STATUS_PAID",
 		2: "PAYMENT_STATUS_FAILED",
+		3: "PAYMENT_STATUS_AUTHORIZED",
+		4: "PAYMENT_STATUS_CAPTURED",
+		5: "PAYMENT_STATUS_VOIDED",
+		6: "PAYMENT_STATUS_REFUNDED",
+		7: "PAYMENT_STATUS_PARTIALLY_REFUNDED",
 	}
 	PaymentStatus_value = map[string]int32{
-		"PAYMENT_STATUS_UNSPECIFIED": 0,
-		"PAYMENT_STATUS_PAID":        1,
-		"PAYMENT_STATUS_FAILED":      2,
+		"PAYMENT_STATUS_UNSPECIFIED":        0,
+		"PAYMENT_STATUS_PAID":               1,
+		"PAYMENT_STATUS_FAILED":             2,
+		"PAYMENT_STATUS_AUTHORIZED":         3,
+		"PAYMENT_STATUS_CAPTURED":           4,
+		"PAYMENT_STATUS_VOIDED":             5,
+		"PAYMENT_STATUS_REFUNDED":           6,
+		"PAYMENT_STATUS_PARTIALLY_REFUNDED": 7,
 	}
 )
 
@@ -57,11 +429,11 @@ func (x PaymentStatus) String() string {
 }
 
 func (PaymentStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_payment_payment_api_proto_enumTypes[0].Descriptor()
+	return file_payment_payment_api_proto_enumTypes[6].Descriptor()
 }
 
 func (PaymentStatus) Type() protoreflect.EnumType {
-	return &file_payment_payment_api_proto_enumTypes[0]
+	return &file_payment_payment_api_proto_enumTypes[6]
 }
 
 func (x PaymentStatus) Number() protoreflect.EnumNumber {
@@ -70,7 +442,7 @@ func (x PaymentStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PaymentStatus.Descriptor instead.
 func (PaymentStatus) EnumDescriptor() ([]byte, []int) {
-	return file_payment_payment_api_proto_rawDescGZIP(), []int{0}
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{6}
 }
 
 // CardType represents different types of payment cards.
@@ -113,11 +485,11 @@ func (x CardType) String() string {
 }
 
 func (CardType) Descriptor() protoreflect.EnumDescriptor {
-	return file_payment_payment_api_proto_enumTypes[1].Descriptor()
+	return file_payment_payment_api_proto_enumTypes[7].Descriptor()
 }
 
 func (CardType) Type() protoreflect.EnumType {
-	return &file_payment_payment_api_proto_enumTypes[1]
+	return &file_payment_payment_api_proto_enumTypes[7]
 }
 
 func (x CardType) Number() protoreflect.EnumNumber {
@@ -126,7 +498,7 @@ func (x CardType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CardType.Descriptor instead.
 func (CardType) EnumDescriptor() ([]byte, []int) {
-	return file_payment_payment_api_proto_rawDescGZIP(), []int{1}
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{7}
 }
 
 // Invoice resource.
@@ -136,14 +508,40 @@ type Invoice struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// Human-readable invoice name.
 	InvoiceName string `protobuf:"bytes,2,opt,name=invoice_name,json=invoiceName,proto3" json:"invoice_name,omitempty"`
-	// Amount and currency (AIP-140).
+	// Output only. Total amount due (AIP-140): subtotal minus discount_amount
+	// plus tax, computed from line_items.
 	Amount *money.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	// Whether the invoice has been paid.
 	Paid bool `protobuf:"varint,4,opt,name=paid,proto3" json:"paid,omitempty"`
 	// Output only. Creation timestamp (AIP-142).
 	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// Output only. Last-modified timestamp (AIP-142).
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Output only. Lifecycle state derived from paid and cancellation.
+	State InvoiceState `protobuf:"varint,7,opt,name=state,proto3,enum=rpc.payment.v1.InvoiceState" json:"state,omitempty"`
+	// Required. The items billed, in order. All unit prices must share one
+	// currency, which becomes the invoice currency.
+	LineItems []*InvoiceLineItem `protobuf:"bytes,8,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	// Optional invoice-level discount, spread over the lines before tax.
+	Discount *InvoiceDiscount `protobuf:"bytes,9,opt,name=discount,proto3" json:"discount,omitempty"`
+	// Output only. Sum of the line subtotals.
+	Subtotal *money.Money `protobuf:"bytes,10,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	// Output only. Discount taken off the subtotal.
+	DiscountAmount *money.Money `protobuf:"bytes,11,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	// Output only. Sum of the line taxes.
+	Tax *money.Money `protobuf:"bytes,12,opt,name=tax,proto3" json:"tax,omitempty"`
+	// Optional. Day (UTC) by which the invoice should be paid. An unpaid
+	// invoice becomes OVERDUE the day after and receives dunning reminders.
+	DueDate *date.Date `protobuf:"bytes,13,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// Output only. Number of dunning reminders sent for this invoice.
+	RemindersSent int32 `protobuf:"varint,14,opt,name=reminders_sent,json=remindersSent,proto3" json:"reminders_sent,omitempty"`
+	// Output only. The subscription that issued this invoice, if any.
+	SubscriptionId string `protobuf:"bytes,15,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// Output only. Sum of the payments allocated to this invoice, or the whole
+	// amount once it is marked paid out of band.
+	AmountPaid *money.Money `protobuf:"bytes,16,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"`
+	// Output only. amount minus amount_paid; zero once paid or cancelled.
+	AmountDue     *money.Money `protobuf:"bytes,17,opt,name=amount_due,json=amountDue,proto3" json:"amount_due,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -220,28 +618,110 @@ func (x *Invoice) GetUpdateTime() *timestamppb.Timestamp {
 	return nil
 }
 
-type MarkInvoicePaidRequest struct {
+func (x *Invoice) GetState() InvoiceState {
+	if x != nil {
+		return x.State
+	}
+	return InvoiceState_INVOICE_STATE_UNSPECIFIED
+}
+
+func (x *Invoice) GetLineItems() []*InvoiceLineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+func (x *Invoice) GetDiscount() *InvoiceDiscount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *Invoice) GetSubtotal() *money.Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *Invoice) GetDiscountAmount() *money.Money {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return nil
+}
+
+func (x *Invoice) GetTax() *money.Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *Invoice) GetDueDate() *date.Date {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Invoice) GetRemindersSent() int32 {
+	if x != nil {
+		return x.RemindersSent
+	}
+	return 0
+}
+
+func (x *Invoice) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *Invoice) GetAmountPaid() *money.Money {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return nil
+}
+
+func (x *Invoice) GetAmountDue() *money.Money {
+	if x != nil {
+		return x.AmountDue
+	}
+	return nil
+}
+
+// InvoiceReminder is the payload of the "invoice.reminder" webhook event.
+type InvoiceReminder struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The invoice id to mark paid.
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The unpaid invoice.
+	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	// 1-based position of this reminder in the dunning schedule.
+	Reminder int32 `protobuf:"varint,2,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	// Whole days between the due date and the day the reminder was sent.
+	DaysOverdue   int32 `protobuf:"varint,3,opt,name=days_overdue,json=daysOverdue,proto3" json:"days_overdue,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarkInvoicePaidRequest) Reset() {
-	*x = MarkInvoicePaidRequest{}
+func (x *InvoiceReminder) Reset() {
+	*x = InvoiceReminder{}
 	mi := &file_payment_payment_api_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkInvoicePaidRequest) String() string {
+func (x *InvoiceReminder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkInvoicePaidRequest) ProtoMessage() {}
+func (*InvoiceReminder) ProtoMessage() {}
 
-func (x *MarkInvoicePaidRequest) ProtoReflect() protoreflect.Message {
+func (x *InvoiceReminder) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_payment_api_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -253,42 +733,70 @@ func (x *MarkInvoicePaidRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkInvoicePaidRequest.ProtoReflect.Descriptor instead.
-func (*MarkInvoicePaidRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InvoiceReminder.ProtoReflect.Descriptor instead.
+func (*InvoiceReminder) Descriptor() ([]byte, []int) {
 	return file_payment_payment_api_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *MarkInvoicePaidRequest) GetId() string {
+func (x *InvoiceReminder) GetInvoice() *Invoice {
 	if x != nil {
-		return x.Id
+		return x.Invoice
 	}
-	return ""
+	return nil
 }
 
-type PayInvoiceRequest struct {
+func (x *InvoiceReminder) GetReminder() int32 {
+	if x != nil {
+		return x.Reminder
+	}
+	return 0
+}
+
+func (x *InvoiceReminder) GetDaysOverdue() int32 {
+	if x != nil {
+		return x.DaysOverdue
+	}
+	return 0
+}
+
+// InvoiceLineItem is one billed item of an invoice.
+type InvoiceLineItem struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The invoice id to pay.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Required. Opaque token referencing a stored/tokenized card.
-	CardToken     string `protobuf:"bytes,2,opt,name=card_token,json=cardToken,proto3" json:"card_token,omitempty"`
+	// Required. What is billed.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// Required. Positive number of units.
+	Quantity int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Required. Non-negative price of one unit.
+	UnitPrice *money.Money `protobuf:"bytes,3,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	// Tax rate in basis points (2000 is 20%), between 0 and 10000.
+	TaxRateBps int32 `protobuf:"varint,4,opt,name=tax_rate_bps,json=taxRateBps,proto3" json:"tax_rate_bps,omitempty"`
+	// Output only. quantity times unit_price.
+	Subtotal *money.Money `protobuf:"bytes,5,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	// Output only. This line's share of the invoice discount.
+	Discount *money.Money `protobuf:"bytes,6,opt,name=discount,proto3" json:"discount,omitempty"`
+	// Output only. Tax on the discounted subtotal, rounded to the currency's
+	// minor unit with halves away from zero.
+	Tax *money.Money `protobuf:"bytes,7,opt,name=tax,proto3" json:"tax,omitempty"`
+	// Output only. subtotal minus discount plus tax.
+	Total         *money.Money `protobuf:"bytes,8,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PayInvoiceRequest) Reset() {
-	*x = PayInvoiceRequest{}
+func (x *InvoiceLineItem) Reset() {
+	*x = InvoiceLineItem{}
 	mi := &file_payment_payment_api_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PayInvoiceRequest) String() string {
+func (x *InvoiceLineItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PayInvoiceRequest) ProtoMessage() {}
+func (*InvoiceLineItem) ProtoMessage() {}
 
-func (x *PayInvoiceRequest) ProtoReflect() protoreflect.Message {
+func (x *InvoiceLineItem) ProtoReflect() protoreflect.Message {
 	mi := &file_payment_payment_api_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -300,23 +808,716 @@ func (x *PayInvoiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PayInvoiceRequest.ProtoReflect.Descriptor instead.
-func (*PayInvoiceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InvoiceLineItem.ProtoReflect.Descriptor instead.
+func (*InvoiceLineItem) Descriptor() ([]byte, []int) {
 	return file_payment_payment_api_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *PayInvoiceRequest) GetId() string {
+func (x *InvoiceLineItem) GetDescription() string {
 	if x != nil {
-		return x.Id
+		return x.Description
 	}
 	return ""
 }
 
-func (x *PayInvoiceRequest) GetCardToken() string {
+func (x *InvoiceLineItem) GetQuantity() int64 {
 	if x != nil {
-		return x.CardToken
+		return x.Quantity
 	}
-	return ""
+	return 0
+}
+
+func (x *InvoiceLineItem) GetUnitPrice() *money.Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *InvoiceLineItem) GetTaxRateBps() int32 {
+	if x != nil {
+		return x.TaxRateBps
+	}
+	return 0
+}
+
+func (x *InvoiceLineItem) GetSubtotal() *money.Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *InvoiceLineItem) GetDiscount() *money.Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *InvoiceLineItem) GetTax() *money.Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *InvoiceLineItem) GetTotal() *money.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+// InvoiceDiscount is an invoice-level discount.
+type InvoiceDiscount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*InvoiceDiscount_RateBps
+	//	*InvoiceDiscount_AmountOff
+	Value         isInvoiceDiscount_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoiceDiscount) Reset() {
+	*x = InvoiceDiscount{}
+	mi := &file_payment_payment_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceDiscount) ProtoMessage() {}
+
+func (x *InvoiceDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceDiscount.ProtoReflect.Descriptor instead.
+func (*InvoiceDiscount) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InvoiceDiscount) GetValue() isInvoiceDiscount_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *InvoiceDiscount) GetRateBps() int32 {
+	if x != nil {
+		if x, ok := x.Value.(*InvoiceDiscount_RateBps); ok {
+			return x.RateBps
+		}
+	}
+	return 0
+}
+
+func (x *InvoiceDiscount) GetAmountOff() *money.Money {
+	if x != nil {
+		if x, ok := x.Value.(*InvoiceDiscount_AmountOff); ok {
+			return x.AmountOff
+		}
+	}
+	return nil
+}
+
+type isInvoiceDiscount_Value interface {
+	isInvoiceDiscount_Value()
+}
+
+type InvoiceDiscount_RateBps struct {
+	// Rate in basis points (1000 is 10%), between 1 and 10000.
+	RateBps int32 `protobuf:"varint,1,opt,name=rate_bps,json=rateBps,proto3,oneof"`
+}
+
+type InvoiceDiscount_AmountOff struct {
+	// Fixed amount in the invoice currency, at most the subtotal.
+	AmountOff *money.Money `protobuf:"bytes,2,opt,name=amount_off,json=amountOff,proto3,oneof"`
+}
+
+func (*InvoiceDiscount_RateBps) isInvoiceDiscount_Value() {}
+
+func (*InvoiceDiscount_AmountOff) isInvoiceDiscount_Value() {}
+
+type CreateInvoiceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Must include invoice_name and at least one line item; amount
+	// and the other totals are computed by the server.
+	Invoice       *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvoiceRequest) Reset() {
+	*x = CreateInvoiceRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvoiceRequest) ProtoMessage() {}
+
+func (x *CreateInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateInvoiceRequest) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+type GetInvoiceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The invoice id to fetch.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceRequest) Reset() {
+	*x = GetInvoiceRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceRequest) ProtoMessage() {}
+
+func (x *GetInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetInvoiceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListInvoicesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional filter: return only invoices in this state. Unspecified returns
+	// all invoices.
+	State InvoiceState `protobuf:"varint,1,opt,name=state,proto3,enum=rpc.payment.v1.InvoiceState" json:"state,omitempty"`
+	// Maximum number of invoices to return. Server may cap this value.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque pagination token from a previous response.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesRequest) Reset() {
+	*x = ListInvoicesRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesRequest) ProtoMessage() {}
+
+func (x *ListInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListInvoicesRequest) GetState() InvoiceState {
+	if x != nil {
+		return x.State
+	}
+	return InvoiceState_INVOICE_STATE_UNSPECIFIED
+}
+
+func (x *ListInvoicesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInvoicesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListInvoicesResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Invoices []*Invoice             `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	// Token to retrieve the next page, or empty if there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesResponse) Reset() {
+	*x = ListInvoicesResponse{}
+	mi := &file_payment_payment_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesResponse) ProtoMessage() {}
+
+func (x *ListInvoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvoicesResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListInvoicesResponse) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+func (x *ListInvoicesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CancelInvoiceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The invoice id to cancel.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelInvoiceRequest) Reset() {
+	*x = CancelInvoiceRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvoiceRequest) ProtoMessage() {}
+
+func (x *CancelInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*CancelInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelInvoiceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type MarkInvoicePaidRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The invoice id to mark paid.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkInvoicePaidRequest) Reset() {
+	*x = MarkInvoicePaidRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkInvoicePaidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkInvoicePaidRequest) ProtoMessage() {}
+
+func (x *MarkInvoicePaidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkInvoicePaidRequest.ProtoReflect.Descriptor instead.
+func (*MarkInvoicePaidRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MarkInvoicePaidRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PayInvoiceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The invoice id to pay.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. Opaque token referencing a stored/tokenized card.
+	CardToken string `protobuf:"bytes,2,opt,name=card_token,json=cardToken,proto3" json:"card_token,omitempty"`
+	// Optional. Client-chosen key that makes retries safe; see
+	// PaymentRequest.idempotency_key.
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Optional. Positive amount to pay, in the invoice currency and at most
+	// the amount due. Defaults to the amount due.
+	Amount *money.Money `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Required. Type of the card behind card_token; CARD_TYPE_UNSPECIFIED is
+	// rejected.
+	Card          CardType `protobuf:"varint,5,opt,name=card,proto3,enum=rpc.payment.v1.CardType" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayInvoiceRequest) Reset() {
+	*x = PayInvoiceRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayInvoiceRequest) ProtoMessage() {}
+
+func (x *PayInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*PayInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PayInvoiceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PayInvoiceRequest) GetCardToken() string {
+	if x != nil {
+		return x.CardToken
+	}
+	return ""
+}
+
+func (x *PayInvoiceRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *PayInvoiceRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PayInvoiceRequest) GetCard() CardType {
+	if x != nil {
+		return x.Card
+	}
+	return CardType_CARD_TYPE_UNSPECIFIED
+}
+
+// InvoiceAllocation is the part of a payment applied to one invoice.
+type InvoiceAllocation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The invoice id to pay.
+	InvoiceId string `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	// Optional. Positive amount to apply, at most the invoice's amount due.
+	// Defaults to the amount due.
+	Amount        *money.Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoiceAllocation) Reset() {
+	*x = InvoiceAllocation{}
+	mi := &file_payment_payment_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceAllocation) ProtoMessage() {}
+
+func (x *InvoiceAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceAllocation.ProtoReflect.Descriptor instead.
+func (*InvoiceAllocation) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InvoiceAllocation) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *InvoiceAllocation) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type PayInvoicesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Opaque token referencing a stored/tokenized card.
+	CardToken string `protobuf:"bytes,1,opt,name=card_token,json=cardToken,proto3" json:"card_token,omitempty"`
+	// Required. One allocation per invoice; the payment amount is their sum.
+	Allocations []*InvoiceAllocation `protobuf:"bytes,2,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	// Optional. Client-chosen key that makes retries safe; see
+	// PaymentRequest.idempotency_key.
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Required. Type of the card behind card_token; CARD_TYPE_UNSPECIFIED is
+	// rejected.
+	Card          CardType `protobuf:"varint,4,opt,name=card,proto3,enum=rpc.payment.v1.CardType" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayInvoicesRequest) Reset() {
+	*x = PayInvoicesRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayInvoicesRequest) ProtoMessage() {}
+
+func (x *PayInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*PayInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PayInvoicesRequest) GetCardToken() string {
+	if x != nil {
+		return x.CardToken
+	}
+	return ""
+}
+
+func (x *PayInvoicesRequest) GetAllocations() []*InvoiceAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *PayInvoicesRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *PayInvoicesRequest) GetCard() CardType {
+	if x != nil {
+		return x.Card
+	}
+	return CardType_CARD_TYPE_UNSPECIFIED
+}
+
+type PayInvoicesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The captured payment.
+	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	// The invoices paid, in request order.
+	Invoices      []*Invoice `protobuf:"bytes,2,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayInvoicesResponse) Reset() {
+	*x = PayInvoicesResponse{}
+	mi := &file_payment_payment_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayInvoicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayInvoicesResponse) ProtoMessage() {}
+
+func (x *PayInvoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*PayInvoicesResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PayInvoicesResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *PayInvoicesResponse) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
 }
 
 // PaymentRequest contains payment information for processing a payment.
@@ -324,37 +1525,1836 @@ func (x *PayInvoiceRequest) GetCardToken() string {
 // PCI-compliant vault or tokenization service.
 type PaymentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. Opaque token referencing a tokenized card.
-	CardToken string `protobuf:"bytes,1,opt,name=card_token,json=cardToken,proto3" json:"card_token,omitempty"`
-	// Required. Card type (informational).
-	Card CardType `protobuf:"varint,2,opt,name=card,proto3,enum=rpc.payment.v1.CardType" json:"card,omitempty"`
-	// Cardholder name.
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// Cardholder address lines.
-	AddressLines []string `protobuf:"bytes,4,rep,name=address_lines,json=addressLines,proto3" json:"address_lines,omitempty"`
-	// Required. Amount to charge (AIP-140).
-	Amount *money.Money `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	// Timestamp for when the payment was created.
-	PaymentCreated *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=payment_created,json=paymentCreated,proto3" json:"payment_created,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Required. Opaque token referencing a tokenized card.
+	CardToken string `protobuf:"bytes,1,opt,name=card_token,json=cardToken,proto3" json:"card_token,omitempty"`
+	// Required. Card type; CARD_TYPE_UNSPECIFIED is rejected.
+	Card CardType `protobuf:"varint,2,opt,name=card,proto3,enum=rpc.payment.v1.CardType" json:"card,omitempty"`
+	// Cardholder name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Deprecated: use billing_address. Cardholder address lines, used as
+	// billing_address.address_lines when billing_address is unset.
+	//
+	// Deprecated: Marked as deprecated in payment/payment_api.proto.
+	AddressLines []string `protobuf:"bytes,4,rep,name=address_lines,json=addressLines,proto3" json:"address_lines,omitempty"`
+	// Required. Amount to charge (AIP-140).
+	Amount *money.Money `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Timestamp for when the payment was created.
+	PaymentCreated *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=payment_created,json=paymentCreated,proto3" json:"payment_created,omitempty"`
+	// Optional. Client-chosen key that makes retries safe. May also be sent as
+	// the Idempotency-Key header. A retry with the same key and payload returns
+	// the original response without charging again; reusing the key with a
+	// different payload returns ALREADY_EXISTS. Keys expire after the
+	// server-configured TTL.
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// When true the payment is captured immediately after authorization.
+	Capture bool `protobuf:"varint,8,opt,name=capture,proto3" json:"capture,omitempty"`
+	// Required unless address_lines is set. Cardholder billing address; must
+	// contain at least one address line.
+	BillingAddress *postaladdress.PostalAddress `protobuf:"bytes,9,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PaymentRequest) Reset() {
+	*x = PaymentRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRequest) ProtoMessage() {}
+
+func (x *PaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentRequest.ProtoReflect.Descriptor instead.
+func (*PaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PaymentRequest) GetCardToken() string {
+	if x != nil {
+		return x.CardToken
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetCard() CardType {
+	if x != nil {
+		return x.Card
+	}
+	return CardType_CARD_TYPE_UNSPECIFIED
+}
+
+func (x *PaymentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in payment/payment_api.proto.
+func (x *PaymentRequest) GetAddressLines() []string {
+	if x != nil {
+		return x.AddressLines
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetPaymentCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaymentCreated
+	}
+	return nil
+}
+
+func (x *PaymentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetCapture() bool {
+	if x != nil {
+		return x.Capture
+	}
+	return false
+}
+
+func (x *PaymentRequest) GetBillingAddress() *postaladdress.PostalAddress {
+	if x != nil {
+		return x.BillingAddress
+	}
+	return nil
+}
+
+// PaymentResponse returns the result of a payment request.
+// On failure the server returns a non-OK gRPC status instead of an in-band
+// error message.
+type PaymentResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status PaymentStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=rpc.payment.v1.PaymentStatus" json:"status,omitempty"`
+	// Server-generated payment identifier, used by the lifecycle RPCs.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Outcome of the fraud checks; REVIEW payments are charged but flagged.
+	FraudDecision FraudDecision `protobuf:"varint,3,opt,name=fraud_decision,json=fraudDecision,proto3,enum=rpc.payment.v1.FraudDecision" json:"fraud_decision,omitempty"`
+	// Rules that matched, e.g. "TOKEN_VELOCITY".
+	FraudReasons  []string `protobuf:"bytes,4,rep,name=fraud_reasons,json=fraudReasons,proto3" json:"fraud_reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentResponse) Reset() {
+	*x = PaymentResponse{}
+	mi := &file_payment_payment_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentResponse) ProtoMessage() {}
+
+func (x *PaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentResponse.ProtoReflect.Descriptor instead.
+func (*PaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PaymentResponse) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *PaymentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PaymentResponse) GetFraudDecision() FraudDecision {
+	if x != nil {
+		return x.FraudDecision
+	}
+	return FraudDecision_FRAUD_DECISION_UNSPECIFIED
+}
+
+func (x *PaymentResponse) GetFraudReasons() []string {
+	if x != nil {
+		return x.FraudReasons
+	}
+	return nil
+}
+
+// Payment is a single card payment and its lifecycle state.
+type Payment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Server-generated identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. Current lifecycle state.
+	Status PaymentStatus `protobuf:"varint,2,opt,name=status,proto3,enum=rpc.payment.v1.PaymentStatus" json:"status,omitempty"`
+	// Output only. Authorized amount (AIP-140).
+	Amount *money.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Output only. Total refunded so far, in the payment currency.
+	RefundedAmount *money.Money `protobuf:"bytes,4,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	// Output only. Creation timestamp (AIP-142).
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last-modified timestamp (AIP-142).
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Output only. Last four characters of the card token. The full token is
+	// never returned.
+	CardTokenLast4 string `protobuf:"bytes,7,opt,name=card_token_last4,json=cardTokenLast4,proto3" json:"card_token_last4,omitempty"`
+	// Output only. Card type recorded with the payment.
+	CardType CardType `protobuf:"varint,8,opt,name=card_type,json=cardType,proto3,enum=rpc.payment.v1.CardType" json:"card_type,omitempty"`
+	// Output only. Invoice paid by this payment, if it was made through
+	// PayInvoice or paid a single invoice through PayInvoices.
+	InvoiceId string `protobuf:"bytes,9,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	// Output only. Billing address recorded with the payment, if any.
+	BillingAddress *postaladdress.PostalAddress `protobuf:"bytes,10,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address,omitempty"`
+	// Output only. Outcome of the fraud checks run before a MakePayment
+	// charge; unspecified for invoice payments.
+	FraudDecision FraudDecision `protobuf:"varint,11,opt,name=fraud_decision,json=fraudDecision,proto3,enum=rpc.payment.v1.FraudDecision" json:"fraud_decision,omitempty"`
+	// Output only. Fraud rules that matched.
+	FraudReasons []string `protobuf:"bytes,12,rep,name=fraud_reasons,json=fraudReasons,proto3" json:"fraud_reasons,omitempty"`
+	// Output only. Total lost to disputes, in the payment currency.
+	DisputedAmount *money.Money `protobuf:"bytes,13,opt,name=disputed_amount,json=disputedAmount,proto3" json:"disputed_amount,omitempty"`
+	// Output only. Amount the merchant keeps: the captured amount less refunds
+	// and lost disputes. Zero until the payment is captured.
+	NetAmount     *money.Money `protobuf:"bytes,14,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_payment_payment_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Payment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payment) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Payment) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Payment) GetRefundedAmount() *money.Money {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return nil
+}
+
+func (x *Payment) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Payment) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Payment) GetCardTokenLast4() string {
+	if x != nil {
+		return x.CardTokenLast4
+	}
+	return ""
+}
+
+func (x *Payment) GetCardType() CardType {
+	if x != nil {
+		return x.CardType
+	}
+	return CardType_CARD_TYPE_UNSPECIFIED
+}
+
+func (x *Payment) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *Payment) GetBillingAddress() *postaladdress.PostalAddress {
+	if x != nil {
+		return x.BillingAddress
+	}
+	return nil
+}
+
+func (x *Payment) GetFraudDecision() FraudDecision {
+	if x != nil {
+		return x.FraudDecision
+	}
+	return FraudDecision_FRAUD_DECISION_UNSPECIFIED
+}
+
+func (x *Payment) GetFraudReasons() []string {
+	if x != nil {
+		return x.FraudReasons
+	}
+	return nil
+}
+
+func (x *Payment) GetDisputedAmount() *money.Money {
+	if x != nil {
+		return x.DisputedAmount
+	}
+	return nil
+}
+
+func (x *Payment) GetNetAmount() *money.Money {
+	if x != nil {
+		return x.NetAmount
+	}
+	return nil
+}
+
+type GetPaymentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The payment id to fetch.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentRequest) Reset() {
+	*x = GetPaymentRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentRequest) ProtoMessage() {}
+
+func (x *GetPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetPaymentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListPaymentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional filter: return only payments in this status. Unspecified
+	// returns payments in any status.
+	Status PaymentStatus `protobuf:"varint,1,opt,name=status,proto3,enum=rpc.payment.v1.PaymentStatus" json:"status,omitempty"`
+	// Optional filter: ISO-4217 currency code, e.g. "USD".
+	CurrencyCode string `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Optional filter: return payments created at or after this time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Optional filter: return payments created before this time.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Maximum number of payments to return. Server may cap this value.
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque pagination token from a previous response.
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentsRequest) Reset() {
+	*x = ListPaymentsRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentsRequest) ProtoMessage() {}
+
+func (x *ListPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListPaymentsRequest) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *ListPaymentsRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *ListPaymentsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListPaymentsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListPaymentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPaymentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPaymentsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Payments []*Payment             `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	// Token to retrieve the next page, or empty if there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentsResponse) Reset() {
+	*x = ListPaymentsResponse{}
+	mi := &file_payment_payment_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentsResponse) ProtoMessage() {}
+
+func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListPaymentsResponse) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *ListPaymentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SummarizePaymentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. ISO 4217 currency to report the total in.
+	TargetCurrency string `protobuf:"bytes,1,opt,name=target_currency,json=targetCurrency,proto3" json:"target_currency,omitempty"`
+	// Optional filter: payments created at or after this time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Optional filter: payments created before this time.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Optional. Use the latest FX rate on or before this date; defaults to
+	// today (UTC).
+	RateDate      *date.Date `protobuf:"bytes,4,opt,name=rate_date,json=rateDate,proto3" json:"rate_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SummarizePaymentsRequest) Reset() {
+	*x = SummarizePaymentsRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SummarizePaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummarizePaymentsRequest) ProtoMessage() {}
+
+func (x *SummarizePaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummarizePaymentsRequest.ProtoReflect.Descriptor instead.
+func (*SummarizePaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SummarizePaymentsRequest) GetTargetCurrency() string {
+	if x != nil {
+		return x.TargetCurrency
+	}
+	return ""
+}
+
+func (x *SummarizePaymentsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SummarizePaymentsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SummarizePaymentsRequest) GetRateDate() *date.Date {
+	if x != nil {
+		return x.RateDate
+	}
+	return nil
+}
+
+type SummarizePaymentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Net settled amount of all matching payments in target_currency.
+	Total *money.Money `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	// Per source currency subtotals and the rates used to convert them.
+	ByCurrency    []*fx.ConvertedTotal `protobuf:"bytes,2,rep,name=by_currency,json=byCurrency,proto3" json:"by_currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SummarizePaymentsResponse) Reset() {
+	*x = SummarizePaymentsResponse{}
+	mi := &file_payment_payment_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SummarizePaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummarizePaymentsResponse) ProtoMessage() {}
+
+func (x *SummarizePaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummarizePaymentsResponse.ProtoReflect.Descriptor instead.
+func (*SummarizePaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SummarizePaymentsResponse) GetTotal() *money.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *SummarizePaymentsResponse) GetByCurrency() []*fx.ConvertedTotal {
+	if x != nil {
+		return x.ByCurrency
+	}
+	return nil
+}
+
+type GetLedgerBalancesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Only entries recorded at or before this time count. Defaults
+	// to now.
+	AsOfTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=as_of_time,json=asOfTime,proto3" json:"as_of_time,omitempty"`
+	// Optional filter: return only this account (e.g. "cash").
+	Account       string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLedgerBalancesRequest) Reset() {
+	*x = GetLedgerBalancesRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLedgerBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerBalancesRequest) ProtoMessage() {}
+
+func (x *GetLedgerBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetLedgerBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetLedgerBalancesRequest) GetAsOfTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOfTime
+	}
+	return nil
+}
+
+func (x *GetLedgerBalancesRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type GetLedgerBalancesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The time the balances were computed for.
+	AsOfTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=as_of_time,json=asOfTime,proto3" json:"as_of_time,omitempty"`
+	// One balance per account and currency, ordered by account then currency.
+	Balances      []*LedgerBalance `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLedgerBalancesResponse) Reset() {
+	*x = GetLedgerBalancesResponse{}
+	mi := &file_payment_payment_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLedgerBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLedgerBalancesResponse) ProtoMessage() {}
+
+func (x *GetLedgerBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetLedgerBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetLedgerBalancesResponse) GetAsOfTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOfTime
+	}
+	return nil
+}
+
+func (x *GetLedgerBalancesResponse) GetBalances() []*LedgerBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+// LedgerBalance is an account's position in one currency. Accounts are
+// debit-normal: balance is debits minus credits, so credit-heavy accounts
+// such as revenue are negative.
+type LedgerBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Debits        *money.Money           `protobuf:"bytes,2,opt,name=debits,proto3" json:"debits,omitempty"`
+	Credits       *money.Money           `protobuf:"bytes,3,opt,name=credits,proto3" json:"credits,omitempty"`
+	Balance       *money.Money           `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerBalance) Reset() {
+	*x = LedgerBalance{}
+	mi := &file_payment_payment_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerBalance) ProtoMessage() {}
+
+func (x *LedgerBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerBalance.ProtoReflect.Descriptor instead.
+func (*LedgerBalance) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LedgerBalance) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *LedgerBalance) GetDebits() *money.Money {
+	if x != nil {
+		return x.Debits
+	}
+	return nil
+}
+
+func (x *LedgerBalance) GetCredits() *money.Money {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *LedgerBalance) GetBalance() *money.Money {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+type ReconcileSettlementRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The settlement CSV, with a header row.
+	SettlementCsv []byte `protobuf:"bytes,1,opt,name=settlement_csv,json=settlementCsv,proto3" json:"settlement_csv,omitempty"`
+	// Optional. First day (UTC) of payments to reconcile. Defaults to the
+	// earliest settlement date in the file.
+	StartDate *date.Date `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Optional. Last day (UTC) of payments to reconcile, inclusive. Defaults to
+	// the latest settlement date in the file.
+	EndDate *date.Date `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Encoding of the downloadable report. Defaults to CSV.
+	ReportFormat  ReportFormat `protobuf:"varint,4,opt,name=report_format,json=reportFormat,proto3,enum=rpc.payment.v1.ReportFormat" json:"report_format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileSettlementRequest) Reset() {
+	*x = ReconcileSettlementRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileSettlementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileSettlementRequest) ProtoMessage() {}
+
+func (x *ReconcileSettlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileSettlementRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileSettlementRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReconcileSettlementRequest) GetSettlementCsv() []byte {
+	if x != nil {
+		return x.SettlementCsv
+	}
+	return nil
+}
+
+func (x *ReconcileSettlementRequest) GetStartDate() *date.Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ReconcileSettlementRequest) GetEndDate() *date.Date {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ReconcileSettlementRequest) GetReportFormat() ReportFormat {
+	if x != nil {
+		return x.ReportFormat
+	}
+	return ReportFormat_REPORT_FORMAT_UNSPECIFIED
+}
+
+type ReconcileSettlementResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One item per settlement row and per unmatched payment.
+	Items           []*ReconciliationItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Matched         int32                 `protobuf:"varint,2,opt,name=matched,proto3" json:"matched,omitempty"`
+	MissingOurs     int32                 `protobuf:"varint,3,opt,name=missing_ours,json=missingOurs,proto3" json:"missing_ours,omitempty"`
+	MissingProvider int32                 `protobuf:"varint,4,opt,name=missing_provider,json=missingProvider,proto3" json:"missing_provider,omitempty"`
+	AmountMismatch  int32                 `protobuf:"varint,5,opt,name=amount_mismatch,json=amountMismatch,proto3" json:"amount_mismatch,omitempty"`
+	// The items encoded in report_format, for download.
+	Report []byte `protobuf:"bytes,6,opt,name=report,proto3" json:"report,omitempty"`
+	// MIME type of report: text/csv or application/json.
+	ReportContentType string `protobuf:"bytes,7,opt,name=report_content_type,json=reportContentType,proto3" json:"report_content_type,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReconcileSettlementResponse) Reset() {
+	*x = ReconcileSettlementResponse{}
+	mi := &file_payment_payment_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileSettlementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileSettlementResponse) ProtoMessage() {}
+
+func (x *ReconcileSettlementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileSettlementResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileSettlementResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReconcileSettlementResponse) GetItems() []*ReconciliationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReconcileSettlementResponse) GetMatched() int32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *ReconcileSettlementResponse) GetMissingOurs() int32 {
+	if x != nil {
+		return x.MissingOurs
+	}
+	return 0
+}
+
+func (x *ReconcileSettlementResponse) GetMissingProvider() int32 {
+	if x != nil {
+		return x.MissingProvider
+	}
+	return 0
+}
+
+func (x *ReconcileSettlementResponse) GetAmountMismatch() int32 {
+	if x != nil {
+		return x.AmountMismatch
+	}
+	return 0
+}
+
+func (x *ReconcileSettlementResponse) GetReport() []byte {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *ReconcileSettlementResponse) GetReportContentType() string {
+	if x != nil {
+		return x.ReportContentType
+	}
+	return ""
+}
+
+// ReconciliationItem is a settlement row, an unmatched payment, or both.
+type ReconciliationItem struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status ReconciliationStatus   `protobuf:"varint,1,opt,name=status,proto3,enum=rpc.payment.v1.ReconciliationStatus" json:"status,omitempty"`
+	// Settlement file line; 0 for MISSING_PROVIDER.
+	Line int32 `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	// Processor reference from the settlement row, or the payment's when
+	// there is no row.
+	Reference string `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	// Empty for MISSING_OURS.
+	PaymentId string `protobuf:"bytes,4,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	// Unset for MISSING_PROVIDER.
+	ProviderAmount *money.Money `protobuf:"bytes,5,opt,name=provider_amount,json=providerAmount,proto3" json:"provider_amount,omitempty"`
+	ProviderDate   *date.Date   `protobuf:"bytes,6,opt,name=provider_date,json=providerDate,proto3" json:"provider_date,omitempty"`
+	// Unset for MISSING_OURS.
+	OurAmount     *money.Money `protobuf:"bytes,7,opt,name=our_amount,json=ourAmount,proto3" json:"our_amount,omitempty"`
+	OurDate       *date.Date   `protobuf:"bytes,8,opt,name=our_date,json=ourDate,proto3" json:"our_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconciliationItem) Reset() {
+	*x = ReconciliationItem{}
+	mi := &file_payment_payment_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationItem) ProtoMessage() {}
+
+func (x *ReconciliationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationItem.ProtoReflect.Descriptor instead.
+func (*ReconciliationItem) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReconciliationItem) GetStatus() ReconciliationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReconciliationStatus_RECONCILIATION_STATUS_UNSPECIFIED
+}
+
+func (x *ReconciliationItem) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ReconciliationItem) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetProviderAmount() *money.Money {
+	if x != nil {
+		return x.ProviderAmount
+	}
+	return nil
+}
+
+func (x *ReconciliationItem) GetProviderDate() *date.Date {
+	if x != nil {
+		return x.ProviderDate
+	}
+	return nil
+}
+
+func (x *ReconciliationItem) GetOurAmount() *money.Money {
+	if x != nil {
+		return x.OurAmount
+	}
+	return nil
+}
+
+func (x *ReconciliationItem) GetOurDate() *date.Date {
+	if x != nil {
+		return x.OurDate
+	}
+	return nil
+}
+
+type CapturePaymentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The payment id to capture.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapturePaymentRequest) Reset() {
+	*x = CapturePaymentRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapturePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturePaymentRequest) ProtoMessage() {}
+
+func (x *CapturePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturePaymentRequest.ProtoReflect.Descriptor instead.
+func (*CapturePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CapturePaymentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type VoidPaymentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The payment id to void.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidPaymentRequest) Reset() {
+	*x = VoidPaymentRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidPaymentRequest) ProtoMessage() {}
+
+func (x *VoidPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidPaymentRequest.ProtoReflect.Descriptor instead.
+func (*VoidPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *VoidPaymentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RefundPaymentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The payment id to refund.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional. Amount to refund; must use the payment currency and not exceed
+	// the amount still refundable. Unset refunds the full remaining amount.
+	Amount        *money.Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundPaymentRequest) Reset() {
+	*x = RefundPaymentRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentRequest) ProtoMessage() {}
+
+func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RefundPaymentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// Refund is money returned from a captured payment.
+type Refund struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Server-generated identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. The refunded payment.
+	PaymentId string `protobuf:"bytes,2,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	// Output only. Refunded amount, in the payment currency.
+	Amount *money.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Output only. Why the refund was made.
+	Reason RefundReason `protobuf:"varint,4,opt,name=reason,proto3,enum=rpc.payment.v1.RefundReason" json:"reason,omitempty"`
+	// Output only. Free-form note recorded with the refund.
+	Note string `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	// Output only. Creation timestamp (AIP-142).
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Refund) Reset() {
+	*x = Refund{}
+	mi := &file_payment_payment_api_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Refund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Refund) ProtoMessage() {}
+
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Refund) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Refund) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *Refund) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Refund) GetReason() RefundReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RefundReason_REFUND_REASON_UNSPECIFIED
+}
+
+func (x *Refund) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Refund) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type CreateRefundRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The payment to refund.
+	PaymentId string `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	// Optional. Amount to refund; must use the payment currency. Unset refunds
+	// the full remaining amount.
+	Amount *money.Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Optional. Why the refund is made.
+	Reason RefundReason `protobuf:"varint,3,opt,name=reason,proto3,enum=rpc.payment.v1.RefundReason" json:"reason,omitempty"`
+	// Optional. Free-form note, at most 1000 characters.
+	Note string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	// Optional. Client-supplied key making the call safe to retry; the
+	// Idempotency-Key header takes the same role.
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateRefundRequest) Reset() {
+	*x = CreateRefundRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRefundRequest) ProtoMessage() {}
+
+func (x *CreateRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRefundRequest.ProtoReflect.Descriptor instead.
+func (*CreateRefundRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateRefundRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *CreateRefundRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CreateRefundRequest) GetReason() RefundReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RefundReason_REFUND_REASON_UNSPECIFIED
+}
+
+func (x *CreateRefundRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CreateRefundRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type GetRefundRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The refund id to fetch.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRefundRequest) Reset() {
+	*x = GetRefundRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefundRequest) ProtoMessage() {}
+
+func (x *GetRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefundRequest.ProtoReflect.Descriptor instead.
+func (*GetRefundRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetRefundRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListRefundsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional filter: return only refunds of this payment.
+	PaymentId string `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	// Maximum number of refunds to return. Server may cap this value.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque pagination token from a previous response.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundsRequest) Reset() {
+	*x = ListRefundsRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundsRequest) ProtoMessage() {}
+
+func (x *ListRefundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundsRequest.ProtoReflect.Descriptor instead.
+func (*ListRefundsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListRefundsRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *ListRefundsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRefundsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListRefundsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Refunds []*Refund              `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	// Token to retrieve the next page, or empty if there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundsResponse) Reset() {
+	*x = ListRefundsResponse{}
+	mi := &file_payment_payment_api_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundsResponse) ProtoMessage() {}
+
+func (x *ListRefundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundsResponse.ProtoReflect.Descriptor instead.
+func (*ListRefundsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListRefundsResponse) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+func (x *ListRefundsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Dispute is a cardholder's challenge of a settled payment.
+type Dispute struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Server-generated identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output only. The disputed payment.
+	PaymentId string `protobuf:"bytes,2,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	// Output only. Disputed amount, in the payment currency.
+	Amount *money.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Output only. Reason given for the dispute.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Output only. Current state.
+	Status DisputeStatus `protobuf:"varint,5,opt,name=status,proto3,enum=rpc.payment.v1.DisputeStatus" json:"status,omitempty"`
+	// Output only. Evidence notes, oldest first.
+	Evidence []*DisputeEvidence `protobuf:"bytes,6,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	// Output only. Evidence must be added before this time.
+	EvidenceDueTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=evidence_due_time,json=evidenceDueTime,proto3" json:"evidence_due_time,omitempty"`
+	// Output only. Creation timestamp (AIP-142).
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last-modified timestamp (AIP-142).
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Output only. When the dispute was resolved; unset while OPEN.
+	ResolveTime   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=resolve_time,json=resolveTime,proto3" json:"resolve_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dispute) Reset() {
+	*x = Dispute{}
+	mi := &file_payment_payment_api_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dispute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dispute) ProtoMessage() {}
+
+func (x *Dispute) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dispute.ProtoReflect.Descriptor instead.
+func (*Dispute) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Dispute) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Dispute) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *Dispute) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Dispute) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Dispute) GetStatus() DisputeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DisputeStatus_DISPUTE_STATUS_UNSPECIFIED
+}
+
+func (x *Dispute) GetEvidence() []*DisputeEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *Dispute) GetEvidenceDueTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EvidenceDueTime
+	}
+	return nil
+}
+
+func (x *Dispute) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Dispute) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Dispute) GetResolveTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolveTime
+	}
+	return nil
+}
+
+// DisputeEvidence is a note submitted in support of a dispute.
+type DisputeEvidence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. The evidence text.
+	Note string `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	// Output only. When the evidence was added.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisputeEvidence) Reset() {
+	*x = DisputeEvidence{}
+	mi := &file_payment_payment_api_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisputeEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisputeEvidence) ProtoMessage() {}
+
+func (x *DisputeEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisputeEvidence.ProtoReflect.Descriptor instead.
+func (*DisputeEvidence) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DisputeEvidence) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *DisputeEvidence) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type CreateDisputeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The disputed payment; it must be settled.
+	PaymentId string `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	// Optional. Disputed amount in the payment currency; must not exceed the
+	// payment's net amount. Unset disputes the full net amount.
+	Amount *money.Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Optional. Reason given for the dispute, at most 1000 characters.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Optional. Evidence deadline; must be in the future. Unset defaults to
+	// now plus payments.dispute_evidence_window.
+	EvidenceDueTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=evidence_due_time,json=evidenceDueTime,proto3" json:"evidence_due_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *PaymentRequest) Reset() {
-	*x = PaymentRequest{}
-	mi := &file_payment_payment_api_proto_msgTypes[3]
+func (x *CreateDisputeRequest) Reset() {
+	*x = CreateDisputeRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentRequest) String() string {
+func (x *CreateDisputeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentRequest) ProtoMessage() {}
+func (*CreateDisputeRequest) ProtoMessage() {}
 
-func (x *PaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_api_proto_msgTypes[3]
+func (x *CreateDisputeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,78 +3365,113 @@ func (x *PaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentRequest.ProtoReflect.Descriptor instead.
-func (*PaymentRequest) Descriptor() ([]byte, []int) {
-	return file_payment_payment_api_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CreateDisputeRequest.ProtoReflect.Descriptor instead.
+func (*CreateDisputeRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *PaymentRequest) GetCardToken() string {
+func (x *CreateDisputeRequest) GetPaymentId() string {
 	if x != nil {
-		return x.CardToken
+		return x.PaymentId
 	}
 	return ""
 }
 
-func (x *PaymentRequest) GetCard() CardType {
+func (x *CreateDisputeRequest) GetAmount() *money.Money {
 	if x != nil {
-		return x.Card
+		return x.Amount
 	}
-	return CardType_CARD_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *PaymentRequest) GetName() string {
+func (x *CreateDisputeRequest) GetReason() string {
 	if x != nil {
-		return x.Name
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *PaymentRequest) GetAddressLines() []string {
+func (x *CreateDisputeRequest) GetEvidenceDueTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AddressLines
+		return x.EvidenceDueTime
 	}
 	return nil
 }
 
-func (x *PaymentRequest) GetAmount() *money.Money {
+type GetDisputeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The dispute id to fetch.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDisputeRequest) Reset() {
+	*x = GetDisputeRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDisputeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDisputeRequest) ProtoMessage() {}
+
+func (x *GetDisputeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[39]
 	if x != nil {
-		return x.Amount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *PaymentRequest) GetPaymentCreated() *timestamppb.Timestamp {
+// Deprecated: Use GetDisputeRequest.ProtoReflect.Descriptor instead.
+func (*GetDisputeRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetDisputeRequest) GetId() string {
 	if x != nil {
-		return x.PaymentCreated
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// PaymentResponse returns the result of a payment request.
-// On failure the server returns a non-OK gRPC status instead of an in-band
-// error message.
-type PaymentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        PaymentStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=rpc.payment.v1.PaymentStatus" json:"status,omitempty"`
+type ListDisputesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional filter: return only disputes of this payment.
+	PaymentId string `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	// Optional filter: return only disputes in this status.
+	Status DisputeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=rpc.payment.v1.DisputeStatus" json:"status,omitempty"`
+	// Maximum number of disputes to return. Server may cap this value.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque pagination token from a previous response.
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaymentResponse) Reset() {
-	*x = PaymentResponse{}
-	mi := &file_payment_payment_api_proto_msgTypes[4]
+func (x *ListDisputesRequest) Reset() {
+	*x = ListDisputesRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentResponse) String() string {
+func (x *ListDisputesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentResponse) ProtoMessage() {}
+func (*ListDisputesRequest) ProtoMessage() {}
 
-func (x *PaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_payment_api_proto_msgTypes[4]
+func (x *ListDisputesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,23 +3482,205 @@ func (x *PaymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentResponse.ProtoReflect.Descriptor instead.
-func (*PaymentResponse) Descriptor() ([]byte, []int) {
-	return file_payment_payment_api_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListDisputesRequest.ProtoReflect.Descriptor instead.
+func (*ListDisputesRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *PaymentResponse) GetStatus() PaymentStatus {
+func (x *ListDisputesRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *ListDisputesRequest) GetStatus() DisputeStatus {
 	if x != nil {
 		return x.Status
 	}
-	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	return DisputeStatus_DISPUTE_STATUS_UNSPECIFIED
+}
+
+func (x *ListDisputesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDisputesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListDisputesResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Disputes []*Dispute             `protobuf:"bytes,1,rep,name=disputes,proto3" json:"disputes,omitempty"`
+	// Token to retrieve the next page, or empty if there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDisputesResponse) Reset() {
+	*x = ListDisputesResponse{}
+	mi := &file_payment_payment_api_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDisputesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDisputesResponse) ProtoMessage() {}
+
+func (x *ListDisputesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDisputesResponse.ProtoReflect.Descriptor instead.
+func (*ListDisputesResponse) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListDisputesResponse) GetDisputes() []*Dispute {
+	if x != nil {
+		return x.Disputes
+	}
+	return nil
+}
+
+func (x *ListDisputesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type AddDisputeEvidenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The dispute id.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. Evidence text, at most 4000 characters.
+	Note          string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDisputeEvidenceRequest) Reset() {
+	*x = AddDisputeEvidenceRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDisputeEvidenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDisputeEvidenceRequest) ProtoMessage() {}
+
+func (x *AddDisputeEvidenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDisputeEvidenceRequest.ProtoReflect.Descriptor instead.
+func (*AddDisputeEvidenceRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AddDisputeEvidenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddDisputeEvidenceRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ResolveDisputeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The dispute id.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. DISPUTE_STATUS_WON or DISPUTE_STATUS_LOST.
+	Outcome       DisputeStatus `protobuf:"varint,2,opt,name=outcome,proto3,enum=rpc.payment.v1.DisputeStatus" json:"outcome,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveDisputeRequest) Reset() {
+	*x = ResolveDisputeRequest{}
+	mi := &file_payment_payment_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveDisputeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveDisputeRequest) ProtoMessage() {}
+
+func (x *ResolveDisputeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_payment_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveDisputeRequest.ProtoReflect.Descriptor instead.
+func (*ResolveDisputeRequest) Descriptor() ([]byte, []int) {
+	return file_payment_payment_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ResolveDisputeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResolveDisputeRequest) GetOutcome() DisputeStatus {
+	if x != nil {
+		return x.Outcome
+	}
+	return DisputeStatus_DISPUTE_STATUS_UNSPECIFIED
 }
 
 var File_payment_payment_api_proto protoreflect.FileDescriptor
 
 const file_payment_payment_api_proto_rawDesc = "" +
 	"\n" +
-	"\x19payment/payment_api.proto\x12\x0erpc.payment.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/type/money.proto\"\xf6\x01\n" +
+	"\x19payment/payment_api.proto\x12\x0erpc.payment.v1\x1a\vfx/fx.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16google/type/date.proto\x1a\x17google/type/money.proto\x1a google/type/postal_address.proto\"\xa0\x06\n" +
 	"\aInvoice\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\finvoice_name\x18\x02 \x01(\tR\vinvoiceName\x12*\n" +
@@ -472,38 +3689,328 @@ const file_payment_payment_api_proto_rawDesc = "" +
 	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"createTime\x12;\n" +
 	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"updateTime\"(\n" +
+	"updateTime\x122\n" +
+	"\x05state\x18\a \x01(\x0e2\x1c.rpc.payment.v1.InvoiceStateR\x05state\x12>\n" +
+	"\n" +
+	"line_items\x18\b \x03(\v2\x1f.rpc.payment.v1.InvoiceLineItemR\tlineItems\x12;\n" +
+	"\bdiscount\x18\t \x01(\v2\x1f.rpc.payment.v1.InvoiceDiscountR\bdiscount\x12.\n" +
+	"\bsubtotal\x18\n" +
+	" \x01(\v2\x12.google.type.MoneyR\bsubtotal\x12;\n" +
+	"\x0fdiscount_amount\x18\v \x01(\v2\x12.google.type.MoneyR\x0ediscountAmount\x12$\n" +
+	"\x03tax\x18\f \x01(\v2\x12.google.type.MoneyR\x03tax\x12,\n" +
+	"\bdue_date\x18\r \x01(\v2\x11.google.type.DateR\adueDate\x12%\n" +
+	"\x0ereminders_sent\x18\x0e \x01(\x05R\rremindersSent\x12'\n" +
+	"\x0fsubscription_id\x18\x0f \x01(\tR\x0esubscriptionId\x123\n" +
+	"\vamount_paid\x18\x10 \x01(\v2\x12.google.type.MoneyR\n" +
+	"amountPaid\x121\n" +
+	"\n" +
+	"amount_due\x18\x11 \x01(\v2\x12.google.type.MoneyR\tamountDue\"\x83\x01\n" +
+	"\x0fInvoiceReminder\x121\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x17.rpc.payment.v1.InvoiceR\ainvoice\x12\x1a\n" +
+	"\breminder\x18\x02 \x01(\x05R\breminder\x12!\n" +
+	"\fdays_overdue\x18\x03 \x01(\x05R\vdaysOverdue\"\xd4\x02\n" +
+	"\x0fInvoiceLineItem\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x121\n" +
+	"\n" +
+	"unit_price\x18\x03 \x01(\v2\x12.google.type.MoneyR\tunitPrice\x12 \n" +
+	"\ftax_rate_bps\x18\x04 \x01(\x05R\n" +
+	"taxRateBps\x12.\n" +
+	"\bsubtotal\x18\x05 \x01(\v2\x12.google.type.MoneyR\bsubtotal\x12.\n" +
+	"\bdiscount\x18\x06 \x01(\v2\x12.google.type.MoneyR\bdiscount\x12$\n" +
+	"\x03tax\x18\a \x01(\v2\x12.google.type.MoneyR\x03tax\x12(\n" +
+	"\x05total\x18\b \x01(\v2\x12.google.type.MoneyR\x05total\"l\n" +
+	"\x0fInvoiceDiscount\x12\x1b\n" +
+	"\brate_bps\x18\x01 \x01(\x05H\x00R\arateBps\x123\n" +
+	"\n" +
+	"amount_off\x18\x02 \x01(\v2\x12.google.type.MoneyH\x00R\tamountOffB\a\n" +
+	"\x05value\"I\n" +
+	"\x14CreateInvoiceRequest\x121\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x17.rpc.payment.v1.InvoiceR\ainvoice\"#\n" +
+	"\x11GetInvoiceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x85\x01\n" +
+	"\x13ListInvoicesRequest\x122\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x1c.rpc.payment.v1.InvoiceStateR\x05state\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"s\n" +
+	"\x14ListInvoicesResponse\x123\n" +
+	"\binvoices\x18\x01 \x03(\v2\x17.rpc.payment.v1.InvoiceR\binvoices\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"&\n" +
+	"\x14CancelInvoiceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"(\n" +
 	"\x16MarkInvoicePaidRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"B\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc5\x01\n" +
 	"\x11PayInvoiceRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
-	"card_token\x18\x02 \x01(\tR\tcardToken\"\x87\x02\n" +
+	"card_token\x18\x02 \x01(\tR\tcardToken\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\x12*\n" +
+	"\x06amount\x18\x04 \x01(\v2\x12.google.type.MoneyR\x06amount\x12,\n" +
+	"\x04card\x18\x05 \x01(\x0e2\x18.rpc.payment.v1.CardTypeR\x04card\"^\n" +
+	"\x11InvoiceAllocation\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\tR\tinvoiceId\x12*\n" +
+	"\x06amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x06amount\"\xcf\x01\n" +
+	"\x12PayInvoicesRequest\x12\x1d\n" +
+	"\n" +
+	"card_token\x18\x01 \x01(\tR\tcardToken\x12C\n" +
+	"\vallocations\x18\x02 \x03(\v2!.rpc.payment.v1.InvoiceAllocationR\vallocations\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\x12,\n" +
+	"\x04card\x18\x04 \x01(\x0e2\x18.rpc.payment.v1.CardTypeR\x04card\"}\n" +
+	"\x13PayInvoicesResponse\x121\n" +
+	"\apayment\x18\x01 \x01(\v2\x17.rpc.payment.v1.PaymentR\apayment\x123\n" +
+	"\binvoices\x18\x02 \x03(\v2\x17.rpc.payment.v1.InvoiceR\binvoices\"\x93\x03\n" +
 	"\x0ePaymentRequest\x12\x1d\n" +
 	"\n" +
 	"card_token\x18\x01 \x01(\tR\tcardToken\x12,\n" +
 	"\x04card\x18\x02 \x01(\x0e2\x18.rpc.payment.v1.CardTypeR\x04card\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12#\n" +
-	"\raddress_lines\x18\x04 \x03(\tR\faddressLines\x12*\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12'\n" +
+	"\raddress_lines\x18\x04 \x03(\tB\x02\x18\x01R\faddressLines\x12*\n" +
 	"\x06amount\x18\x05 \x01(\v2\x12.google.type.MoneyR\x06amount\x12C\n" +
-	"\x0fpayment_created\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0epaymentCreated\"H\n" +
+	"\x0fpayment_created\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0epaymentCreated\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKey\x12\x18\n" +
+	"\acapture\x18\b \x01(\bR\acapture\x12C\n" +
+	"\x0fbilling_address\x18\t \x01(\v2\x1a.google.type.PostalAddressR\x0ebillingAddress\"\xc3\x01\n" +
 	"\x0fPaymentResponse\x125\n" +
-	"\x06status\x18\x01 \x01(\x0e2\x1d.rpc.payment.v1.PaymentStatusR\x06status*c\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1d.rpc.payment.v1.PaymentStatusR\x06status\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12D\n" +
+	"\x0efraud_decision\x18\x03 \x01(\x0e2\x1d.rpc.payment.v1.FraudDecisionR\rfraudDecision\x12#\n" +
+	"\rfraud_reasons\x18\x04 \x03(\tR\ffraudReasons\"\xd3\x05\n" +
+	"\aPayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1d.rpc.payment.v1.PaymentStatusR\x06status\x12*\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyR\x06amount\x12;\n" +
+	"\x0frefunded_amount\x18\x04 \x01(\v2\x12.google.type.MoneyR\x0erefundedAmount\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12(\n" +
+	"\x10card_token_last4\x18\a \x01(\tR\x0ecardTokenLast4\x125\n" +
+	"\tcard_type\x18\b \x01(\x0e2\x18.rpc.payment.v1.CardTypeR\bcardType\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\t \x01(\tR\tinvoiceId\x12C\n" +
+	"\x0fbilling_address\x18\n" +
+	" \x01(\v2\x1a.google.type.PostalAddressR\x0ebillingAddress\x12D\n" +
+	"\x0efraud_decision\x18\v \x01(\x0e2\x1d.rpc.payment.v1.FraudDecisionR\rfraudDecision\x12#\n" +
+	"\rfraud_reasons\x18\f \x03(\tR\ffraudReasons\x12;\n" +
+	"\x0fdisputed_amount\x18\r \x01(\v2\x12.google.type.MoneyR\x0edisputedAmount\x121\n" +
+	"\n" +
+	"net_amount\x18\x0e \x01(\v2\x12.google.type.MoneyR\tnetAmount\"#\n" +
+	"\x11GetPaymentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9f\x02\n" +
+	"\x13ListPaymentsRequest\x125\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1d.rpc.payment.v1.PaymentStatusR\x06status\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"s\n" +
+	"\x14ListPaymentsResponse\x123\n" +
+	"\bpayments\x18\x01 \x03(\v2\x17.rpc.payment.v1.PaymentR\bpayments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xe5\x01\n" +
+	"\x18SummarizePaymentsRequest\x12'\n" +
+	"\x0ftarget_currency\x18\x01 \x01(\tR\x0etargetCurrency\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12.\n" +
+	"\trate_date\x18\x04 \x01(\v2\x11.google.type.DateR\brateDate\"\x81\x01\n" +
+	"\x19SummarizePaymentsResponse\x12(\n" +
+	"\x05total\x18\x01 \x01(\v2\x12.google.type.MoneyR\x05total\x12:\n" +
+	"\vby_currency\x18\x02 \x03(\v2\x19.rpc.fx.v1.ConvertedTotalR\n" +
+	"byCurrency\"n\n" +
+	"\x18GetLedgerBalancesRequest\x128\n" +
+	"\n" +
+	"as_of_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\basOfTime\x12\x18\n" +
+	"\aaccount\x18\x02 \x01(\tR\aaccount\"\x90\x01\n" +
+	"\x19GetLedgerBalancesResponse\x128\n" +
+	"\n" +
+	"as_of_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\basOfTime\x129\n" +
+	"\bbalances\x18\x02 \x03(\v2\x1d.rpc.payment.v1.LedgerBalanceR\bbalances\"\xb1\x01\n" +
+	"\rLedgerBalance\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\tR\aaccount\x12*\n" +
+	"\x06debits\x18\x02 \x01(\v2\x12.google.type.MoneyR\x06debits\x12,\n" +
+	"\acredits\x18\x03 \x01(\v2\x12.google.type.MoneyR\acredits\x12,\n" +
+	"\abalance\x18\x04 \x01(\v2\x12.google.type.MoneyR\abalance\"\xe6\x01\n" +
+	"\x1aReconcileSettlementRequest\x12%\n" +
+	"\x0esettlement_csv\x18\x01 \x01(\fR\rsettlementCsv\x120\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x11.google.type.DateR\tstartDate\x12,\n" +
+	"\bend_date\x18\x03 \x01(\v2\x11.google.type.DateR\aendDate\x12A\n" +
+	"\rreport_format\x18\x04 \x01(\x0e2\x1c.rpc.payment.v1.ReportFormatR\freportFormat\"\xb0\x02\n" +
+	"\x1bReconcileSettlementResponse\x128\n" +
+	"\x05items\x18\x01 \x03(\v2\".rpc.payment.v1.ReconciliationItemR\x05items\x12\x18\n" +
+	"\amatched\x18\x02 \x01(\x05R\amatched\x12!\n" +
+	"\fmissing_ours\x18\x03 \x01(\x05R\vmissingOurs\x12)\n" +
+	"\x10missing_provider\x18\x04 \x01(\x05R\x0fmissingProvider\x12'\n" +
+	"\x0famount_mismatch\x18\x05 \x01(\x05R\x0eamountMismatch\x12\x16\n" +
+	"\x06report\x18\x06 \x01(\fR\x06report\x12.\n" +
+	"\x13report_content_type\x18\a \x01(\tR\x11reportContentType\"\xf9\x02\n" +
+	"\x12ReconciliationItem\x12<\n" +
+	"\x06status\x18\x01 \x01(\x0e2$.rpc.payment.v1.ReconciliationStatusR\x06status\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\x05R\x04line\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\tR\treference\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x04 \x01(\tR\tpaymentId\x12;\n" +
+	"\x0fprovider_amount\x18\x05 \x01(\v2\x12.google.type.MoneyR\x0eproviderAmount\x126\n" +
+	"\rprovider_date\x18\x06 \x01(\v2\x11.google.type.DateR\fproviderDate\x121\n" +
+	"\n" +
+	"our_amount\x18\a \x01(\v2\x12.google.type.MoneyR\tourAmount\x12,\n" +
+	"\bour_date\x18\b \x01(\v2\x11.google.type.DateR\aourDate\"'\n" +
+	"\x15CapturePaymentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"$\n" +
+	"\x12VoidPaymentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"R\n" +
+	"\x14RefundPaymentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x06amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x06amount\"\xea\x01\n" +
+	"\x06Refund\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x02 \x01(\tR\tpaymentId\x12*\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyR\x06amount\x124\n" +
+	"\x06reason\x18\x04 \x01(\x0e2\x1c.rpc.payment.v1.RefundReasonR\x06reason\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12;\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\xd3\x01\n" +
+	"\x13CreateRefundRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12*\n" +
+	"\x06amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x06amount\x124\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x1c.rpc.payment.v1.RefundReasonR\x06reason\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\"\"\n" +
+	"\x10GetRefundRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"o\n" +
+	"\x12ListRefundsRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"o\n" +
+	"\x13ListRefundsResponse\x120\n" +
+	"\arefunds\x18\x01 \x03(\v2\x16.rpc.payment.v1.RefundR\arefunds\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf1\x03\n" +
+	"\aDispute\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x02 \x01(\tR\tpaymentId\x12*\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyR\x06amount\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x125\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1d.rpc.payment.v1.DisputeStatusR\x06status\x12;\n" +
+	"\bevidence\x18\x06 \x03(\v2\x1f.rpc.payment.v1.DisputeEvidenceR\bevidence\x12F\n" +
+	"\x11evidence_due_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0fevidenceDueTime\x12;\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12=\n" +
+	"\fresolve_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vresolveTime\"b\n" +
+	"\x0fDisputeEvidence\x12\x12\n" +
+	"\x04note\x18\x01 \x01(\tR\x04note\x12;\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\xc1\x01\n" +
+	"\x14CreateDisputeRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12*\n" +
+	"\x06amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x06amount\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12F\n" +
+	"\x11evidence_due_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0fevidenceDueTime\"#\n" +
+	"\x11GetDisputeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa7\x01\n" +
+	"\x13ListDisputesRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x125\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1d.rpc.payment.v1.DisputeStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"s\n" +
+	"\x14ListDisputesResponse\x123\n" +
+	"\bdisputes\x18\x01 \x03(\v2\x17.rpc.payment.v1.DisputeR\bdisputes\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"?\n" +
+	"\x19AddDisputeEvidenceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\"`\n" +
+	"\x15ResolveDisputeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\aoutcome\x18\x02 \x01(\x0e2\x1d.rpc.payment.v1.DisputeStatusR\aoutcome*\x95\x01\n" +
+	"\fInvoiceState\x12\x1d\n" +
+	"\x19INVOICE_STATE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12INVOICE_STATE_OPEN\x10\x01\x12\x16\n" +
+	"\x12INVOICE_STATE_PAID\x10\x02\x12\x1b\n" +
+	"\x17INVOICE_STATE_CANCELLED\x10\x03\x12\x19\n" +
+	"\x15INVOICE_STATE_OVERDUE\x10\x04*}\n" +
+	"\rFraudDecision\x12\x1e\n" +
+	"\x1aFRAUD_DECISION_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14FRAUD_DECISION_ALLOW\x10\x01\x12\x19\n" +
+	"\x15FRAUD_DECISION_REVIEW\x10\x02\x12\x17\n" +
+	"\x13FRAUD_DECISION_DENY\x10\x03*\xdf\x01\n" +
+	"\x14ReconciliationStatus\x12%\n" +
+	"!RECONCILIATION_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dRECONCILIATION_STATUS_MATCHED\x10\x01\x12&\n" +
+	"\"RECONCILIATION_STATUS_MISSING_OURS\x10\x02\x12*\n" +
+	"&RECONCILIATION_STATUS_MISSING_PROVIDER\x10\x03\x12)\n" +
+	"%RECONCILIATION_STATUS_AMOUNT_MISMATCH\x10\x04*\\\n" +
+	"\fReportFormat\x12\x1d\n" +
+	"\x19REPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11REPORT_FORMAT_CSV\x10\x01\x12\x16\n" +
+	"\x12REPORT_FORMAT_JSON\x10\x02*\x91\x01\n" +
+	"\fRefundReason\x12\x1d\n" +
+	"\x19REFUND_REASON_UNSPECIFIED\x10\x00\x12'\n" +
+	"#REFUND_REASON_REQUESTED_BY_CUSTOMER\x10\x01\x12\x1b\n" +
+	"\x17REFUND_REASON_DUPLICATE\x10\x02\x12\x1c\n" +
+	"\x18REFUND_REASON_FRAUDULENT\x10\x03*y\n" +
+	"\rDisputeStatus\x12\x1e\n" +
+	"\x1aDISPUTE_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13DISPUTE_STATUS_OPEN\x10\x01\x12\x16\n" +
+	"\x12DISPUTE_STATUS_WON\x10\x02\x12\x17\n" +
+	"\x13DISPUTE_STATUS_LOST\x10\x03*\x82\x02\n" +
 	"\rPaymentStatus\x12\x1e\n" +
-	"\x1aPAYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
-	"\x13PAYMENT_STATUS_PAID\x10\x01\x12\x19\n" +
-	"\x15PAYMENT_STATUS_FAILED\x10\x02*\x80\x01\n" +
+	"\x1aPAYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x13PAYMENT_STATUS_PAID\x10\x01\x1a\x02\b\x01\x12\x19\n" +
+	"\x15PAYMENT_STATUS_FAILED\x10\x02\x12\x1d\n" +
+	"\x19PAYMENT_STATUS_AUTHORIZED\x10\x03\x12\x1b\n" +
+	"\x17PAYMENT_STATUS_CAPTURED\x10\x04\x12\x19\n" +
+	"\x15PAYMENT_STATUS_VOIDED\x10\x05\x12\x1b\n" +
+	"\x17PAYMENT_STATUS_REFUNDED\x10\x06\x12%\n" +
+	"!PAYMENT_STATUS_PARTIALLY_REFUNDED\x10\a*\x80\x01\n" +
 	"\bCardType\x12\x19\n" +
 	"\x15CARD_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fCARD_TYPE_DEBIT\x10\x01\x12\x14\n" +
 	"\x10CARD_TYPE_CREDIT\x10\x02\x12\x18\n" +
 	"\x14CARD_TYPE_MASTERCARD\x10\x03\x12\x14\n" +
-	"\x10CARD_TYPE_REWARD\x10\x042\xd8\x02\n" +
+	"\x10CARD_TYPE_REWARD\x10\x042\xfb\x15\n" +
 	"\x0ePaymentService\x12k\n" +
-	"\vMakePayment\x12\x1e.rpc.payment.v1.PaymentRequest\x1a\x1f.rpc.payment.v1.PaymentResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/payment:make\x12s\n" +
+	"\vMakePayment\x12\x1e.rpc.payment.v1.PaymentRequest\x1a\x1f.rpc.payment.v1.PaymentResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/payment:make\x12c\n" +
+	"\n" +
+	"GetPayment\x12!.rpc.payment.v1.GetPaymentRequest\x1a\x17.rpc.payment.v1.Payment\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/payments/{id}\x12o\n" +
+	"\fListPayments\x12#.rpc.payment.v1.ListPaymentsRequest\x1a$.rpc.payment.v1.ListPaymentsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/payments\x12\x88\x01\n" +
+	"\x11SummarizePayments\x12(.rpc.payment.v1.SummarizePaymentsRequest\x1a).rpc.payment.v1.SummarizePaymentsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/payments:summarize\x12\x85\x01\n" +
+	"\x11GetLedgerBalances\x12(.rpc.payment.v1.GetLedgerBalancesRequest\x1a).rpc.payment.v1.GetLedgerBalancesResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/ledger/balances\x12\x91\x01\n" +
+	"\x13ReconcileSettlement\x12*.rpc.payment.v1.ReconcileSettlementRequest\x1a+.rpc.payment.v1.ReconcileSettlementResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/payments:reconcile\x12v\n" +
+	"\x0eCapturePayment\x12%.rpc.payment.v1.CapturePaymentRequest\x1a\x17.rpc.payment.v1.Payment\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/payments/{id}:capture\x12m\n" +
+	"\vVoidPayment\x12\".rpc.payment.v1.VoidPaymentRequest\x1a\x17.rpc.payment.v1.Payment\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/payments/{id}:void\x12s\n" +
+	"\rRefundPayment\x12$.rpc.payment.v1.RefundPaymentRequest\x1a\x17.rpc.payment.v1.Payment\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/payments/{id}:refund\x12y\n" +
+	"\fCreateRefund\x12#.rpc.payment.v1.CreateRefundRequest\x1a\x16.rpc.payment.v1.Refund\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/payments/{payment_id}/refunds\x12_\n" +
+	"\tGetRefund\x12 .rpc.payment.v1.GetRefundRequest\x1a\x16.rpc.payment.v1.Refund\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/refunds/{id}\x12k\n" +
+	"\vListRefunds\x12\".rpc.payment.v1.ListRefundsRequest\x1a#.rpc.payment.v1.ListRefundsResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/refunds\x12}\n" +
+	"\rCreateDispute\x12$.rpc.payment.v1.CreateDisputeRequest\x1a\x17.rpc.payment.v1.Dispute\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/payments/{payment_id}/disputes\x12c\n" +
+	"\n" +
+	"GetDispute\x12!.rpc.payment.v1.GetDisputeRequest\x1a\x17.rpc.payment.v1.Dispute\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/disputes/{id}\x12o\n" +
+	"\fListDisputes\x12#.rpc.payment.v1.ListDisputesRequest\x1a$.rpc.payment.v1.ListDisputesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/disputes\x12\x82\x01\n" +
+	"\x12AddDisputeEvidence\x12).rpc.payment.v1.AddDisputeEvidenceRequest\x1a\x17.rpc.payment.v1.Dispute\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/disputes/{id}:addEvidence\x12v\n" +
+	"\x0eResolveDispute\x12%.rpc.payment.v1.ResolveDisputeRequest\x1a\x17.rpc.payment.v1.Dispute\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/disputes/{id}:resolve\x12m\n" +
+	"\rCreateInvoice\x12$.rpc.payment.v1.CreateInvoiceRequest\x1a\x17.rpc.payment.v1.Invoice\"\x1d\x82\xd3\xe4\x93\x02\x17:\ainvoice\"\f/v1/invoices\x12c\n" +
+	"\n" +
+	"GetInvoice\x12!.rpc.payment.v1.GetInvoiceRequest\x1a\x17.rpc.payment.v1.Invoice\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/invoices/{id}\x12o\n" +
+	"\fListInvoices\x12#.rpc.payment.v1.ListInvoicesRequest\x1a$.rpc.payment.v1.ListInvoicesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/invoices\x12s\n" +
+	"\rCancelInvoice\x12$.rpc.payment.v1.CancelInvoiceRequest\x1a\x17.rpc.payment.v1.Invoice\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/invoices/{id}:cancel\x12s\n" +
 	"\x0fMarkInvoicePaid\x12&.rpc.payment.v1.MarkInvoicePaidRequest\x1a\x17.rpc.payment.v1.Invoice\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/invoice:markPaid\x12d\n" +
 	"\n" +
-	"PayInvoice\x12!.rpc.payment.v1.PayInvoiceRequest\x1a\x17.rpc.payment.v1.Invoice\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/invoice:payB\xb9\x01\n" +
+	"PayInvoice\x12!.rpc.payment.v1.PayInvoiceRequest\x1a\x17.rpc.payment.v1.Invoice\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/invoice:pay\x12s\n" +
+	"\vPayInvoices\x12\".rpc.payment.v1.PayInvoicesRequest\x1a#.rpc.payment.v1.PayInvoicesResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/invoices:payB\xb9\x01\n" +
 	"\x12com.rpc.payment.v1B\x0fPaymentApiProtoP\x01Z8github.com/grpc-buf/internal/gen/proto/payment;paymentv1\xa2\x02\x03RPX\xaa\x02\x0eRpc.Payment.V1\xca\x02\x0eRpc\\Payment\\V1\xe2\x02\x1aRpc\\Payment\\V1\\GPBMetadata\xea\x02\x10Rpc::Payment::V1b\x06proto3"
 
 var (
@@ -518,38 +4025,210 @@ func file_payment_payment_api_proto_rawDescGZIP() []byte {
 	return file_payment_payment_api_proto_rawDescData
 }
 
-var file_payment_payment_api_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_payment_payment_api_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_payment_payment_api_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_payment_payment_api_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_payment_payment_api_proto_goTypes = []any{
-	(PaymentStatus)(0),             // 0: rpc.payment.v1.PaymentStatus
-	(CardType)(0),                  // 1: rpc.payment.v1.CardType
-	(*Invoice)(nil),                // 2: rpc.payment.v1.Invoice
-	(*MarkInvoicePaidRequest)(nil), // 3: rpc.payment.v1.MarkInvoicePaidRequest
-	(*PayInvoiceRequest)(nil),      // 4: rpc.payment.v1.PayInvoiceRequest
-	(*PaymentRequest)(nil),         // 5: rpc.payment.v1.PaymentRequest
-	(*PaymentResponse)(nil),        // 6: rpc.payment.v1.PaymentResponse
-	(*money.Money)(nil),            // 7: google.type.Money
-	(*timestamppb.Timestamp)(nil),  // 8: google.protobuf.Timestamp
+	(InvoiceState)(0),                   // 0: rpc.payment.v1.InvoiceState
+	(FraudDecision)(0),                  // 1: rpc.payment.v1.FraudDecision
+	(ReconciliationStatus)(0),           // 2: rpc.payment.v1.ReconciliationStatus
+	(ReportFormat)(0),                   // 3: rpc.payment.v1.ReportFormat
+	(RefundReason)(0),                   // 4: rpc.payment.v1.RefundReason
+	(DisputeStatus)(0),                  // 5: rpc.payment.v1.DisputeStatus
+	(PaymentStatus)(0),                  // 6: rpc.payment.v1.PaymentStatus
+	(CardType)(0),                       // 7: rpc.payment.v1.CardType
+	(*Invoice)(nil),                     // 8: rpc.payment.v1.Invoice
+	(*InvoiceReminder)(nil),             // 9: rpc.payment.v1.InvoiceReminder
+	(*InvoiceLineItem)(nil),             // 10: rpc.payment.v1.InvoiceLineItem
+	(*InvoiceDiscount)(nil),             // 11: rpc.payment.v1.InvoiceDiscount
+	(*CreateInvoiceRequest)(nil),        // 12: rpc.payment.v1.CreateInvoiceRequest
+	(*GetInvoiceRequest)(nil),           // 13: rpc.payment.v1.GetInvoiceRequest
+	(*ListInvoicesRequest)(nil),         // 14: rpc.payment.v1.ListInvoicesRequest
+	(*ListInvoicesResponse)(nil),        // 15: rpc.payment.v1.ListInvoicesResponse
+	(*CancelInvoiceRequest)(nil),        // 16: rpc.payment.v1.CancelInvoiceRequest
+	(*MarkInvoicePaidRequest)(nil),      // 17: rpc.payment.v1.MarkInvoicePaidRequest
+	(*PayInvoiceRequest)(nil),           // 18: rpc.payment.v1.PayInvoiceRequest
+	(*InvoiceAllocation)(nil),           // 19: rpc.payment.v1.InvoiceAllocation
+	(*PayInvoicesRequest)(nil),          // 20: rpc.payment.v1.PayInvoicesRequest
+	(*PayInvoicesResponse)(nil),         // 21: rpc.payment.v1.PayInvoicesResponse
+	(*PaymentRequest)(nil),              // 22: rpc.payment.v1.PaymentRequest
+	(*PaymentResponse)(nil),             // 23: rpc.payment.v1.PaymentResponse
+	(*Payment)(nil),                     // 24: rpc.payment.v1.Payment
+	(*GetPaymentRequest)(nil),           // 25: rpc.payment.v1.GetPaymentRequest
+	(*ListPaymentsRequest)(nil),         // 26: rpc.payment.v1.ListPaymentsRequest
+	(*ListPaymentsResponse)(nil),        // 27: rpc.payment.v1.ListPaymentsResponse
+	(*SummarizePaymentsRequest)(nil),    // 28: rpc.payment.v1.SummarizePaymentsRequest
+	(*SummarizePaymentsResponse)(nil),   // 29: rpc.payment.v1.SummarizePaymentsResponse
+	(*GetLedgerBalancesRequest)(nil),    // 30: rpc.payment.v1.GetLedgerBalancesRequest
+	(*GetLedgerBalancesResponse)(nil),   // 31: rpc.payment.v1.GetLedgerBalancesResponse
+	(*LedgerBalance)(nil),               // 32: rpc.payment.v1.LedgerBalance
+	(*ReconcileSettlementRequest)(nil),  // 33: rpc.payment.v1.ReconcileSettlementRequest
+	(*ReconcileSettlementResponse)(nil), // 34: rpc.payment.v1.ReconcileSettlementResponse
+	(*ReconciliationItem)(nil),          // 35: rpc.payment.v1.ReconciliationItem
+	(*CapturePaymentRequest)(nil),       // 36: rpc.payment.v1.CapturePaymentRequest
+	(*VoidPaymentRequest)(nil),          // 37: rpc.payment.v1.VoidPaymentRequest
+	(*RefundPaymentRequest)(nil),        // 38: rpc.payment.v1.RefundPaymentRequest
+	(*Refund)(nil),                      // 39: rpc.payment.v1.Refund
+	(*CreateRefundRequest)(nil),         // 40: rpc.payment.v1.CreateRefundRequest
+	(*GetRefundRequest)(nil),            // 41: rpc.payment.v1.GetRefundRequest
+	(*ListRefundsRequest)(nil),          // 42: rpc.payment.v1.ListRefundsRequest
+	(*ListRefundsResponse)(nil),         // 43: rpc.payment.v1.ListRefundsResponse
+	(*Dispute)(nil),                     // 44: rpc.payment.v1.Dispute
+	(*DisputeEvidence)(nil),             // 45: rpc.payment.v1.DisputeEvidence
+	(*CreateDisputeRequest)(nil),        // 46: rpc.payment.v1.CreateDisputeRequest
+	(*GetDisputeRequest)(nil),           // 47: rpc.payment.v1.GetDisputeRequest
+	(*ListDisputesRequest)(nil),         // 48: rpc.payment.v1.ListDisputesRequest
+	(*ListDisputesResponse)(nil),        // 49: rpc.payment.v1.ListDisputesResponse
+	(*AddDisputeEvidenceRequest)(nil),   // 50: rpc.payment.v1.AddDisputeEvidenceRequest
+	(*ResolveDisputeRequest)(nil),       // 51: rpc.payment.v1.ResolveDisputeRequest
+	(*money.Money)(nil),                 // 52: google.type.Money
+	(*timestamppb.Timestamp)(nil),       // 53: google.protobuf.Timestamp
+	(*date.Date)(nil),                   // 54: google.type.Date
+	(*postaladdress.PostalAddress)(nil), // 55: google.type.PostalAddress
+	(*fx.ConvertedTotal)(nil),           // 56: rpc.fx.v1.ConvertedTotal
 }
 var file_payment_payment_api_proto_depIdxs = []int32{
-	7,  // 0: rpc.payment.v1.Invoice.amount:type_name -> google.type.Money
-	8,  // 1: rpc.payment.v1.Invoice.create_time:type_name -> google.protobuf.Timestamp
-	8,  // 2: rpc.payment.v1.Invoice.update_time:type_name -> google.protobuf.Timestamp
-	1,  // 3: rpc.payment.v1.PaymentRequest.card:type_name -> rpc.payment.v1.CardType
-	7,  // 4: rpc.payment.v1.PaymentRequest.amount:type_name -> google.type.Money
-	8,  // 5: rpc.payment.v1.PaymentRequest.payment_created:type_name -> google.protobuf.Timestamp
-	0,  // 6: rpc.payment.v1.PaymentResponse.status:type_name -> rpc.payment.v1.PaymentStatus
-	5,  // 7: rpc.payment.v1.PaymentService.MakePayment:input_type -> rpc.payment.v1.PaymentRequest
-	3,  // 8: rpc.payment.v1.PaymentService.MarkInvoicePaid:input_type -> rpc.payment.v1.MarkInvoicePaidRequest
-	4,  // 9: rpc.payment.v1.PaymentService.PayInvoice:input_type -> rpc.payment.v1.PayInvoiceRequest
-	6,  // 10: rpc.payment.v1.PaymentService.MakePayment:output_type -> rpc.payment.v1.PaymentResponse
-	2,  // 11: rpc.payment.v1.PaymentService.MarkInvoicePaid:output_type -> rpc.payment.v1.Invoice
-	2,  // 12: rpc.payment.v1.PaymentService.PayInvoice:output_type -> rpc.payment.v1.Invoice
-	10, // [10:13] is the sub-list for method output_type
-	7,  // [7:10] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	52,  // 0: rpc.payment.v1.Invoice.amount:type_name -> google.type.Money
+	53,  // 1: rpc.payment.v1.Invoice.create_time:type_name -> google.protobuf.Timestamp
+	53,  // 2: rpc.payment.v1.Invoice.update_time:type_name -> google.protobuf.Timestamp
+	0,   // 3: rpc.payment.v1.Invoice.state:type_name -> rpc.payment.v1.InvoiceState
+	10,  // 4: rpc.payment.v1.Invoice.line_items:type_name -> rpc.payment.v1.InvoiceLineItem
+	11,  // 5: rpc.payment.v1.Invoice.discount:type_name -> rpc.payment.v1.InvoiceDiscount
+	52,  // 6: rpc.payment.v1.Invoice.subtotal:type_name -> google.type.Money
+	52,  // 7: rpc.payment.v1.Invoice.discount_amount:type_name -> google.type.Money
+	52,  // 8: rpc.payment.v1.Invoice.tax:type_name -> google.type.Money
+	54,  // 9: rpc.payment.v1.Invoice.due_date:type_name -> google.type.Date
+	52,  // 10: rpc.payment.v1.Invoice.amount_paid:type_name -> google.type.Money
+	52,  // 11: rpc.payment.v1.Invoice.amount_due:type_name -> google.type.Money
+	8,   // 12: rpc.payment.v1.InvoiceReminder.invoice:type_name -> rpc.payment.v1.Invoice
+	52,  // 13: rpc.payment.v1.InvoiceLineItem.unit_price:type_name -> google.type.Money
+	52,  // 14: rpc.payment.v1.InvoiceLineItem.subtotal:type_name -> google.type.Money
+	52,  // 15: rpc.payment.v1.InvoiceLineItem.discount:type_name -> google.type.Money
+	52,  // 16: rpc.payment.v1.InvoiceLineItem.tax:type_name -> google.type.Money
+	52,  // 17: rpc.payment.v1.InvoiceLineItem.total:type_name -> google.type.Money
+	52,  // 18: rpc.payment.v1.InvoiceDiscount.amount_off:type_name -> google.type.Money
+	8,   // 19: rpc.payment.v1.CreateInvoiceRequest.invoice:type_name -> rpc.payment.v1.Invoice
+	0,   // 20: rpc.payment.v1.ListInvoicesRequest.state:type_name -> rpc.payment.v1.InvoiceState
+	8,   // 21: rpc.payment.v1.ListInvoicesResponse.invoices:type_name -> rpc.payment.v1.Invoice
+	52,  // 22: rpc.payment.v1.PayInvoiceRequest.amount:type_name -> google.type.Money
+	7,   // 23: rpc.payment.v1.PayInvoiceRequest.card:type_name -> rpc.payment.v1.CardType
+	52,  // 24: rpc.payment.v1.InvoiceAllocation.amount:type_name -> google.type.Money
+	19,  // 25: rpc.payment.v1.PayInvoicesRequest.allocations:type_name -> rpc.payment.v1.InvoiceAllocation
+	7,   // 26: rpc.payment.v1.PayInvoicesRequest.card:type_name -> rpc.payment.v1.CardType
+	24,  // 27: rpc.payment.v1.PayInvoicesResponse.payment:type_name -> rpc.payment.v1.Payment
+	8,   // 28: rpc.payment.v1.PayInvoicesResponse.invoices:type_name -> rpc.payment.v1.Invoice
+	7,   // 29: rpc.payment.v1.PaymentRequest.card:type_name -> rpc.payment.v1.CardType
+	52,  // 30: rpc.payment.v1.PaymentRequest.amount:type_name -> google.type.Money
+	53,  // 31: rpc.payment.v1.PaymentRequest.payment_created:type_name -> google.protobuf.Timestamp
+	55,  // 32: rpc.payment.v1.PaymentRequest.billing_address:type_name -> google.type.PostalAddress
+	6,   // 33: rpc.payment.v1.PaymentResponse.status:type_name -> rpc.payment.v1.PaymentStatus
+	1,   // 34: rpc.payment.v1.PaymentResponse.fraud_decision:type_name -> rpc.payment.v1.FraudDecision
+	6,   // 35: rpc.payment.v1.Payment.status:type_name -> rpc.payment.v1.PaymentStatus
+	52,  // 36: rpc.payment.v1.Payment.amount:type_name -> google.type.Money
+	52,  // 37: rpc.payment.v1.Payment.refunded_amount:type_name -> google.type.Money
+	53,  // 38: rpc.payment.v1.Payment.create_time:type_name -> google.protobuf.Timestamp
+	53,  // 39: rpc.payment.v1.Payment.update_time:type_name -> google.protobuf.Timestamp
+	7,   // 40: rpc.payment.v1.Payment.card_type:type_name -> rpc.payment.v1.CardType
+	55,  // 41: rpc.payment.v1.Payment.billing_address:type_name -> google.type.PostalAddress
+	1,   // 42: rpc.payment.v1.Payment.fraud_decision:type_name -> rpc.payment.v1.FraudDecision
+	52,  // 43: rpc.payment.v1.Payment.disputed_amount:type_name -> google.type.Money
+	52,  // 44: rpc.payment.v1.Payment.net_amount:type_name -> google.type.Money
+	6,   // 45: rpc.payment.v1.ListPaymentsRequest.status:type_name -> rpc.payment.v1.PaymentStatus
+	53,  // 46: rpc.payment.v1.ListPaymentsRequest.start_time:type_name -> google.protobuf.Timestamp
+	53,  // 47: rpc.payment.v1.ListPaymentsRequest.end_time:type_name -> google.protobuf.Timestamp
+	24,  // 48: rpc.payment.v1.ListPaymentsResponse.payments:type_name -> rpc.payment.v1.Payment
+	53,  // 49: rpc.payment.v1.SummarizePaymentsRequest.start_time:type_name -> google.protobuf.Timestamp
+	53,  // 50: rpc.payment.v1.SummarizePaymentsRequest.end_time:type_name -> google.protobuf.Timestamp
+	54,  // 51: rpc.payment.v1.SummarizePaymentsRequest.rate_date:type_name -> google.type.Date
+	52,  // 52: rpc.payment.v1.SummarizePaymentsResponse.total:type_name -> google.type.Money
+	56,  // 53: rpc.payment.v1.SummarizePaymentsResponse.by_currency:type_name -> rpc.fx.v1.ConvertedTotal
+	53,  // 54: rpc.payment.v1.GetLedgerBalancesRequest.as_of_time:type_name -> google.protobuf.Timestamp
+	53,  // 55: rpc.payment.v1.GetLedgerBalancesResponse.as_of_time:type_name -> google.protobuf.Timestamp
+	32,  // 56: rpc.payment.v1.GetLedgerBalancesResponse.balances:type_name -> rpc.payment.v1.LedgerBalance
+	52,  // 57: rpc.payment.v1.LedgerBalance.debits:type_name -> google.type.Money
+	52,  // 58: rpc.payment.v1.LedgerBalance.credits:type_name -> google.type.Money
+	52,  // 59: rpc.payment.v1.LedgerBalance.balance:type_name -> google.type.Money
+	54,  // 60: rpc.payment.v1.ReconcileSettlementRequest.start_date:type_name -> google.type.Date
+	54,  // 61: rpc.payment.v1.ReconcileSettlementRequest.end_date:type_name -> google.type.Date
+	3,   // 62: rpc.payment.v1.ReconcileSettlementRequest.report_format:type_name -> rpc.payment.v1.ReportFormat
+	35,  // 63: rpc.payment.v1.ReconcileSettlementResponse.items:type_name -> rpc.payment.v1.ReconciliationItem
+	2,   // 64: rpc.payment.v1.ReconciliationItem.status:type_name -> rpc.payment.v1.ReconciliationStatus
+	52,  // 65: rpc.payment.v1.ReconciliationItem.provider_amount:type_name -> google.type.Money
+	54,  // 66: rpc.payment.v1.ReconciliationItem.provider_date:type_name -> google.type.Date
+	52,  // 67: rpc.payment.v1.ReconciliationItem.our_amount:type_name -> google.type.Money
+	54,  // 68: rpc.payment.v1.ReconciliationItem.our_date:type_name -> google.type.Date
+	52,  // 69: rpc.payment.v1.RefundPaymentRequest.amount:type_name -> google.type.Money
+	52,  // 70: rpc.payment.v1.Refund.amount:type_name -> google.type.Money
+	4,   // 71: rpc.payment.v1.Refund.reason:type_name -> rpc.payment.v1.RefundReason
+	53,  // 72: rpc.payment.v1.Refund.create_time:type_name -> google.protobuf.Timestamp
+	52,  // 73: rpc.payment.v1.CreateRefundRequest.amount:type_name -> google.type.Money
+	4,   // 74: rpc.payment.v1.CreateRefundRequest.reason:type_name -> rpc.payment.v1.RefundReason
+	39,  // 75: rpc.payment.v1.ListRefundsResponse.refunds:type_name -> rpc.payment.v1.Refund
+	52,  // 76: rpc.payment.v1.Dispute.amount:type_name -> google.type.Money
+	5,   // 77: rpc.payment.v1.Dispute.status:type_name -> rpc.payment.v1.DisputeStatus
+	45,  // 78: rpc.payment.v1.Dispute.evidence:type_name -> rpc.payment.v1.DisputeEvidence
+	53,  // 79: rpc.payment.v1.Dispute.evidence_due_time:type_name -> google.protobuf.Timestamp
+	53,  // 80: rpc.payment.v1.Dispute.create_time:type_name -> google.protobuf.Timestamp
+	53,  // 81: rpc.payment.v1.Dispute.update_time:type_name -> google.protobuf.Timestamp
+	53,  // 82: rpc.payment.v1.Dispute.resolve_time:type_name -> google.protobuf.Timestamp
+	53,  // 83: rpc.payment.v1.DisputeEvidence.create_time:type_name -> google.protobuf.Timestamp
+	52,  // 84: rpc.payment.v1.CreateDisputeRequest.amount:type_name -> google.type.Money
+	53,  // 85: rpc.payment.v1.CreateDisputeRequest.evidence_due_time:type_name -> google.protobuf.Timestamp
+	5,   // 86: rpc.payment.v1.ListDisputesRequest.status:type_name -> rpc.payment.v1.DisputeStatus
+	44,  // 87: rpc.payment.v1.ListDisputesResponse.disputes:type_name -> rpc.payment.v1.Dispute
+	5,   // 88: rpc.payment.v1.ResolveDisputeRequest.outcome:type_name -> rpc.payment.v1.DisputeStatus
+	22,  // 89: rpc.payment.v1.PaymentService.MakePayment:input_type -> rpc.payment.v1.PaymentRequest
+	25,  // 90: rpc.payment.v1.PaymentService.GetPayment:input_type -> rpc.payment.v1.GetPaymentRequest
+	26,  // 91: rpc.payment.v1.PaymentService.ListPayments:input_type -> rpc.payment.v1.ListPaymentsRequest
+	28,  // 92: rpc.payment.v1.PaymentService.SummarizePayments:input_type -> rpc.payment.v1.SummarizePaymentsRequest
+	30,  // 93: rpc.payment.v1.PaymentService.GetLedgerBalances:input_type -> rpc.payment.v1.GetLedgerBalancesRequest
+	33,  // 94: rpc.payment.v1.PaymentService.ReconcileSettlement:input_type -> rpc.payment.v1.ReconcileSettlementRequest
+	36,  // 95: rpc.payment.v1.PaymentService.CapturePayment:input_type -> rpc.payment.v1.CapturePaymentRequest
+	37,  // 96: rpc.payment.v1.PaymentService.VoidPayment:input_type -> rpc.payment.v1.VoidPaymentRequest
+	38,  // 97: rpc.payment.v1.PaymentService.RefundPayment:input_type -> rpc.payment.v1.RefundPaymentRequest
+	40,  // 98: rpc.payment.v1.PaymentService.CreateRefund:input_type -> rpc.payment.v1.CreateRefundRequest
+	41,  // 99: rpc.payment.v1.PaymentService.GetRefund:input_type -> rpc.payment.v1.GetRefundRequest
+	42,  // 100: rpc.payment.v1.PaymentService.ListRefunds:input_type -> rpc.payment.v1.ListRefundsRequest
+	46,  // 101: rpc.payment.v1.PaymentService.CreateDispute:input_type -> rpc.payment.v1.CreateDisputeRequest
+	47,  // 102: rpc.payment.v1.PaymentService.GetDispute:input_type -> rpc.payment.v1.GetDisputeRequest
+	48,  // 103: rpc.payment.v1.PaymentService.ListDisputes:input_type -> rpc.payment.v1.ListDisputesRequest
+	50,  // 104: rpc.payment.v1.PaymentService.AddDisputeEvidence:input_type -> rpc.payment.v1.AddDisputeEvidenceRequest
+	51,  // 105: rpc.payment.v1.PaymentService.ResolveDispute:input_type -> rpc.payment.v1.ResolveDisputeRequest
+	12,  // 106: rpc.payment.v1.PaymentService.CreateInvoice:input_type -> rpc.payment.v1.CreateInvoiceRequest
+	13,  // 107: rpc.payment.v1.PaymentService.GetInvoice:input_type -> rpc.payment.v1.GetInvoiceRequest
+	14,  // 108: rpc.payment.v1.PaymentService.ListInvoices:input_type -> rpc.payment.v1.ListInvoicesRequest
+	16,  // 109: rpc.payment.v1.PaymentService.CancelInvoice:input_type -> rpc.payment.v1.CancelInvoiceRequest
+	17,  // 110: rpc.payment.v1.PaymentService.MarkInvoicePaid:input_type -> rpc.payment.v1.MarkInvoicePaidRequest
+	18,  // 111: rpc.payment.v1.PaymentService.PayInvoice:input_type -> rpc.payment.v1.PayInvoiceRequest
+	20,  // 112: rpc.payment.v1.PaymentService.PayInvoices:input_type -> rpc.payment.v1.PayInvoicesRequest
+	23,  // 113: rpc.payment.v1.PaymentService.MakePayment:output_type -> rpc.payment.v1.PaymentResponse
+	24,  // 114: rpc.payment.v1.PaymentService.GetPayment:output_type -> rpc.payment.v1.Payment
+	27,  // 115: rpc.payment.v1.PaymentService.ListPayments:output_type -> rpc.payment.v1.ListPaymentsResponse
+	29,  // 116: rpc.payment.v1.PaymentService.SummarizePayments:output_type -> rpc.payment.v1.SummarizePaymentsResponse
+	31,  // 117: rpc.payment.v1.PaymentService.GetLedgerBalances:output_type -> rpc.payment.v1.GetLedgerBalancesResponse
+	34,  // 118: rpc.payment.v1.PaymentService.ReconcileSettlement:output_type -> rpc.payment.v1.ReconcileSettlementResponse
+	24,  // 119: rpc.payment.v1.PaymentService.CapturePayment:output_type -> rpc.payment.v1.Payment
+	24,  // 120: rpc.payment.v1.PaymentService.VoidPayment:output_type -> rpc.payment.v1.Payment
+	24,  // 121: rpc.payment.v1.PaymentService.RefundPayment:output_type -> rpc.payment.v1.Payment
+	39,  // 122: rpc.payment.v1.PaymentService.CreateRefund:output_type -> rpc.payment.v1.Refund
+	39,  // 123: rpc.payment.v1.PaymentService.GetRefund:output_type -> rpc.payment.v1.Refund
+	43,  // 124: rpc.payment.v1.PaymentService.ListRefunds:output_type -> rpc.payment.v1.ListRefundsResponse
+	44,  // 125: rpc.payment.v1.PaymentService.CreateDispute:output_type -> rpc.payment.v1.Dispute
+	44,  // 126: rpc.payment.v1.PaymentService.GetDispute:output_type -> rpc.payment.v1.Dispute
+	49,  // 127: rpc.payment.v1.PaymentService.ListDisputes:output_type -> rpc.payment.v1.ListDisputesResponse
+	44,  // 128: rpc.payment.v1.PaymentService.AddDisputeEvidence:output_type -> rpc.payment.v1.Dispute
+	44,  // 129: rpc.payment.v1.PaymentService.ResolveDispute:output_type -> rpc.payment.v1.Dispute
+	8,   // 130: rpc.payment.v1.PaymentService.CreateInvoice:output_type -> rpc.payment.v1.Invoice
+	8,   // 131: rpc.payment.v1.PaymentService.GetInvoice:output_type -> rpc.payment.v1.Invoice
+	15,  // 132: rpc.payment.v1.PaymentService.ListInvoices:output_type -> rpc.payment.v1.ListInvoicesResponse
+	8,   // 133: rpc.payment.v1.PaymentService.CancelInvoice:output_type -> rpc.payment.v1.Invoice
+	8,   // 134: rpc.payment.v1.PaymentService.MarkInvoicePaid:output_type -> rpc.payment.v1.Invoice
+	8,   // 135: rpc.payment.v1.PaymentService.PayInvoice:output_type -> rpc.payment.v1.Invoice
+	21,  // 136: rpc.payment.v1.PaymentService.PayInvoices:output_type -> rpc.payment.v1.PayInvoicesResponse
+	113, // [113:137] is the sub-list for method output_type
+	89,  // [89:113] is the sub-list for method input_type
+	89,  // [89:89] is the sub-list for extension type_name
+	89,  // [89:89] is the sub-list for extension extendee
+	0,   // [0:89] is the sub-list for field type_name
 }
 
 func init() { file_payment_payment_api_proto_init() }
@@ -557,13 +4236,17 @@ func file_payment_payment_api_proto_init() {
 	if File_payment_payment_api_proto != nil {
 		return
 	}
+	file_payment_payment_api_proto_msgTypes[3].OneofWrappers = []any{
+		(*InvoiceDiscount_RateBps)(nil),
+		(*InvoiceDiscount_AmountOff)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_payment_api_proto_rawDesc), len(file_payment_payment_api_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   5,
+			NumEnums:      8,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -36,19 +36,160 @@ const (
 	// PaymentServiceMakePaymentProcedure is the fully-qualified name of the PaymentService's
 	// MakePayment RPC.
 	PaymentServiceMakePaymentProcedure = "/rpc.payment.v1.PaymentService/MakePayment"
+	// PaymentServiceGetPaymentProcedure is the fully-qualified name of the PaymentService's GetPayment
+	// RPC.
+	PaymentServiceGetPaymentProcedure = "/rpc.payment.v1.PaymentService/GetPayment"
+	// PaymentServiceListPaymentsProcedure is the fully-qualified name of the PaymentService's
+	// ListPayments RPC.
+	PaymentServiceListPaymentsProcedure = "/rpc.payment.v1.PaymentService/ListPayments"
+	// PaymentServiceSummarizePaymentsProcedure is the fully-qualified name of the PaymentService's
+	// SummarizePayments RPC.
+	PaymentServiceSummarizePaymentsProcedure = "/rpc.payment.v1.PaymentService/SummarizePayments"
+	// PaymentServiceGetLedgerBalancesProcedure is the fully-qualified name of the PaymentService's
+	// GetLedgerBalances RPC.
+	PaymentServiceGetLedgerBalancesProcedure = "/rpc.payment.v1.PaymentService/GetLedgerBalances"
+	// PaymentServiceReconcileSettlementProcedure is the fully-qualified name of the PaymentService's
+	// ReconcileSettlement RPC.
+	PaymentServiceReconcileSettlementProcedure = "/rpc.payment.v1.PaymentService/ReconcileSettlement"
+	// PaymentServiceCapturePaymentProcedure is the fully-qualified name of the PaymentService's
+	// CapturePayment RPC.
+	PaymentServiceCapturePaymentProcedure = "/rpc.payment.v1.PaymentService/CapturePayment"
+	// PaymentServiceVoidPaymentProcedure is the fully-qualified name of the PaymentService's
+	// VoidPayment RPC.
+	PaymentServiceVoidPaymentProcedure = "/rpc.payment.v1.PaymentService/VoidPayment"
+	// PaymentServiceRefundPaymentProcedure is the fully-qualified name of the PaymentService's
+	// RefundPayment RPC.
+	PaymentServiceRefundPaymentProcedure = "/rpc.payment.v1.PaymentService/RefundPayment"
+	// PaymentServiceCreateRefundProcedure is the fully-qualified name of the PaymentService's
+	// CreateRefund RPC.
+	PaymentServiceCreateRefundProcedure = "/rpc.payment.v1.PaymentService/CreateRefund"
+	// PaymentServiceGetRefundProcedure is the fully-qualified name of the PaymentService's GetRefund
+	// RPC.
+	PaymentServiceGetRefundProcedure = "/rpc.payment.v1.PaymentService/GetRefund"
+	// PaymentServiceListRefundsProcedure is the fully-qualified name of the PaymentService's
+	// ListRefunds RPC.
+	PaymentServiceListRefundsProcedure = "/rpc.payment.v1.PaymentService/ListRefunds"
+	// PaymentServiceCreateDisputeProcedure is the fully-qualified name of the PaymentService's
+	// CreateDispute RPC.
+	PaymentServiceCreateDisputeProcedure = "/rpc.payment.v1.PaymentService/CreateDispute"
+	// PaymentServiceGetDisputeProcedure is the fully-qualified name of the PaymentService's GetDispute
+	// RPC.
+	PaymentServiceGetDisputeProcedure = "/rpc.payment.v1.PaymentService/GetDispute"
+	// PaymentServiceListDisputesProcedure is the fully-qualified name of the PaymentService's
+	// ListDisputes RPC.
+	PaymentServiceListDisputesProcedure = "/rpc.payment.v1.PaymentService/ListDisputes"
+	// PaymentServiceAddDisputeEvidenceProcedure is the fully-qualified name of the PaymentService's
+	// AddDisputeEvidence RPC.
+	PaymentServiceAddDisputeEvidenceProcedure = "/rpc.payment.v1.PaymentService/AddDisputeEvidence"
+	// PaymentServiceResolveDisputeProcedure is the fully-qualified name of the PaymentService's
+	// ResolveDispute RPC.
+	PaymentServiceResolveDisputeProcedure = "/rpc.payment.v1.PaymentService/ResolveDispute"
+	// PaymentServiceCreateInvoiceProcedure is the fully-qualified name of the PaymentService's
+	// CreateInvoice RPC.
+	PaymentServiceCreateInvoiceProcedure = "/rpc.payment.v1.PaymentService/CreateInvoice"
+	// PaymentServiceGetInvoiceProcedure is the fully-qualified name of the PaymentService's GetInvoice
+	// RPC.
+	PaymentServiceGetInvoiceProcedure = "/rpc.payment.v1.PaymentService/GetInvoice"
+	// PaymentServiceListInvoicesProcedure is the fully-qualified name of the PaymentService's
+	// ListInvoices RPC.
+	PaymentServiceListInvoicesProcedure = "/rpc.payment.v1.PaymentService/ListInvoices"
+	// PaymentServiceCancelInvoiceProcedure is the fully-qualified name of the PaymentService's
+	// CancelInvoice RPC.
+	PaymentServiceCancelInvoiceProcedure = "/rpc.payment.v1.PaymentService/CancelInvoice"
 	// PaymentServiceMarkInvoicePaidProcedure is the fully-qualified name of the PaymentService's
 	// MarkInvoicePaid RPC.
 	PaymentServiceMarkInvoicePaidProcedure = "/rpc.payment.v1.PaymentService/MarkInvoicePaid"
 	// PaymentServicePayInvoiceProcedure is the fully-qualified name of the PaymentService's PayInvoice
 	// RPC.
 	PaymentServicePayInvoiceProcedure = "/rpc.payment.v1.PaymentService/PayInvoice"
+	// PaymentServicePayInvoicesProcedure is the fully-qualified name of the PaymentService's
+	// PayInvoices RPC.
+	PaymentServicePayInvoicesProcedure = "/rpc.payment.v1.PaymentService/PayInvoices"
 )
 
 // PaymentServiceClient is a client for the rpc.payment.v1.PaymentService service.
 type PaymentServiceClient interface {
+	// MakePayment authorizes a payment against card_token and returns its id.
+	// The payment starts AUTHORIZED unless capture is set, in which case it is
+	// authorized and captured in one step. Fraud rules run first; a denied
+	// attempt returns PERMISSION_DENIED with a google.rpc.ErrorInfo.
 	MakePayment(context.Context, *connect.Request[payment.PaymentRequest]) (*connect.Response[payment.PaymentResponse], error)
+	// GetPayment returns a single payment (AIP-131). Only the last four
+	// characters of the card token are exposed.
+	GetPayment(context.Context, *connect.Request[payment.GetPaymentRequest]) (*connect.Response[payment.Payment], error)
+	// ListPayments returns payments newest first, optionally filtered by
+	// status, currency and creation time (AIP-132).
+	ListPayments(context.Context, *connect.Request[payment.ListPaymentsRequest]) (*connect.Response[payment.ListPaymentsResponse], error)
+	// SummarizePayments totals the net settled amount (captured minus
+	// refunded) of matching payments across currencies, converting each
+	// currency's subtotal into target_currency with stored FX rates. Returns
+	// FAILED_PRECONDITION when a needed rate is missing.
+	SummarizePayments(context.Context, *connect.Request[payment.SummarizePaymentsRequest]) (*connect.Response[payment.SummarizePaymentsResponse], error)
+	// GetLedgerBalances returns the double-entry ledger balance of each
+	// account and currency as of as_of_time (default: now).
+	GetLedgerBalances(context.Context, *connect.Request[payment.GetLedgerBalancesRequest]) (*connect.Response[payment.GetLedgerBalancesResponse], error)
+	// ReconcileSettlement matches settled payments against the processor's
+	// settlement CSV, read with the configured column mapping. A row matches a
+	// payment by processor reference, or failing that by amount, currency and
+	// date. Returns INVALID_ARGUMENT when the file cannot be parsed.
+	ReconcileSettlement(context.Context, *connect.Request[payment.ReconcileSettlementRequest]) (*connect.Response[payment.ReconcileSettlementResponse], error)
+	// CapturePayment settles an AUTHORIZED payment (AUTHORIZED -> CAPTURED).
+	CapturePayment(context.Context, *connect.Request[payment.CapturePaymentRequest]) (*connect.Response[payment.Payment], error)
+	// VoidPayment releases an AUTHORIZED payment without settling it
+	// (AUTHORIZED -> VOIDED).
+	VoidPayment(context.Context, *connect.Request[payment.VoidPaymentRequest]) (*connect.Response[payment.Payment], error)
+	// RefundPayment returns all or part of a captured payment
+	// (CAPTURED|PARTIALLY_REFUNDED -> PARTIALLY_REFUNDED|REFUNDED).
+	RefundPayment(context.Context, *connect.Request[payment.RefundPaymentRequest]) (*connect.Response[payment.Payment], error)
+	// CreateRefund returns all or part of a captured payment and records it as
+	// a Refund with its reason. Refunds never exceed the captured amount less
+	// earlier refunds and lost disputes; excess returns FAILED_PRECONDITION.
+	// Supports idempotency keys like MakePayment.
+	CreateRefund(context.Context, *connect.Request[payment.CreateRefundRequest]) (*connect.Response[payment.Refund], error)
+	// GetRefund returns a single refund by id.
+	GetRefund(context.Context, *connect.Request[payment.GetRefundRequest]) (*connect.Response[payment.Refund], error)
+	// ListRefunds returns refunds, newest first, optionally for one payment.
+	ListRefunds(context.Context, *connect.Request[payment.ListRefundsRequest]) (*connect.Response[payment.ListRefundsResponse], error)
+	// CreateDispute opens a dispute against a settled payment. A payment has
+	// at most one OPEN dispute.
+	CreateDispute(context.Context, *connect.Request[payment.CreateDisputeRequest]) (*connect.Response[payment.Dispute], error)
+	// GetDispute returns a single dispute by id, with its evidence.
+	GetDispute(context.Context, *connect.Request[payment.GetDisputeRequest]) (*connect.Response[payment.Dispute], error)
+	// ListDisputes returns disputes, newest first, optionally filtered by
+	// payment and status.
+	ListDisputes(context.Context, *connect.Request[payment.ListDisputesRequest]) (*connect.Response[payment.ListDisputesResponse], error)
+	// AddDisputeEvidence attaches an evidence note to an OPEN dispute before
+	// its evidence deadline.
+	AddDisputeEvidence(context.Context, *connect.Request[payment.AddDisputeEvidenceRequest]) (*connect.Response[payment.Dispute], error)
+	// ResolveDispute closes an OPEN dispute as WON or LOST (OPEN -> WON|LOST).
+	// A lost dispute reduces the payment's net amount by the disputed amount.
+	ResolveDispute(context.Context, *connect.Request[payment.ResolveDisputeRequest]) (*connect.Response[payment.Dispute], error)
+	// CreateInvoice creates a new open invoice (AIP-133). Server-managed fields
+	// on the embedded Invoice (id, paid, state, create_time, update_time) are
+	// ignored.
+	CreateInvoice(context.Context, *connect.Request[payment.CreateInvoiceRequest]) (*connect.Response[payment.Invoice], error)
+	// GetInvoice returns a single invoice (AIP-131).
+	GetInvoice(context.Context, *connect.Request[payment.GetInvoiceRequest]) (*connect.Response[payment.Invoice], error)
+	// ListInvoices returns invoices newest first, optionally filtered by state
+	// (AIP-132).
+	ListInvoices(context.Context, *connect.Request[payment.ListInvoicesRequest]) (*connect.Response[payment.ListInvoicesResponse], error)
+	// CancelInvoice voids an open invoice so it can no longer be paid
+	// (AIP-136). Returns FAILED_PRECONDITION if the invoice is paid or already
+	// cancelled.
+	CancelInvoice(context.Context, *connect.Request[payment.CancelInvoiceRequest]) (*connect.Response[payment.Invoice], error)
+	// MarkInvoicePaid flags an invoice as settled without charging a card, e.g.
+	// when it was paid out of band. Returns FAILED_PRECONDITION if the invoice
+	// is already paid.
 	MarkInvoicePaid(context.Context, *connect.Request[payment.MarkInvoicePaidRequest]) (*connect.Response[payment.Invoice], error)
+	// PayInvoice charges amount, or the whole amount due, to card_token and
+	// applies it to the invoice atomically. The invoice is paid once its amount
+	// due reaches zero. Returns FAILED_PRECONDITION if the invoice is already
+	// paid or the amount exceeds the amount due.
 	PayInvoice(context.Context, *connect.Request[payment.PayInvoiceRequest]) (*connect.Response[payment.Invoice], error)
+	// PayInvoices charges one payment to card_token and allocates it across
+	// several invoices of the same currency, with the same rules as
+	// PayInvoice for each allocation.
+	PayInvoices(context.Context, *connect.Request[payment.PayInvoicesRequest]) (*connect.Response[payment.PayInvoicesResponse], error)
 }
 
 // NewPaymentServiceClient constructs a client for the rpc.payment.v1.PaymentService service. By
@@ -68,6 +209,126 @@ func NewPaymentServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(paymentServiceMethods.ByName("MakePayment")),
 			connect.WithClientOptions(opts...),
 		),
+		getPayment: connect.NewClient[payment.GetPaymentRequest, payment.Payment](
+			httpClient,
+			baseURL+PaymentServiceGetPaymentProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("GetPayment")),
+			connect.WithClientOptions(opts...),
+		),
+		listPayments: connect.NewClient[payment.ListPaymentsRequest, payment.ListPaymentsResponse](
+			httpClient,
+			baseURL+PaymentServiceListPaymentsProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("ListPayments")),
+			connect.WithClientOptions(opts...),
+		),
+		summarizePayments: connect.NewClient[payment.SummarizePaymentsRequest, payment.SummarizePaymentsResponse](
+			httpClient,
+			baseURL+PaymentServiceSummarizePaymentsProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("SummarizePayments")),
+			connect.WithClientOptions(opts...),
+		),
+		getLedgerBalances: connect.NewClient[payment.GetLedgerBalancesRequest, payment.GetLedgerBalancesResponse](
+			httpClient,
+			baseURL+PaymentServiceGetLedgerBalancesProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("GetLedgerBalances")),
+			connect.WithClientOptions(opts...),
+		),
+		reconcileSettlement: connect.NewClient[payment.ReconcileSettlementRequest, payment.ReconcileSettlementResponse](
+			httpClient,
+			baseURL+PaymentServiceReconcileSettlementProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("ReconcileSettlement")),
+			connect.WithClientOptions(opts...),
+		),
+		capturePayment: connect.NewClient[payment.CapturePaymentRequest, payment.Payment](
+			httpClient,
+			baseURL+PaymentServiceCapturePaymentProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("CapturePayment")),
+			connect.WithClientOptions(opts...),
+		),
+		voidPayment: connect.NewClient[payment.VoidPaymentRequest, payment.Payment](
+			httpClient,
+			baseURL+PaymentServiceVoidPaymentProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("VoidPayment")),
+			connect.WithClientOptions(opts...),
+		),
+		refundPayment: connect.NewClient[payment.RefundPaymentRequest, payment.Payment](
+			httpClient,
+			baseURL+PaymentServiceRefundPaymentProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("RefundPayment")),
+			connect.WithClientOptions(opts...),
+		),
+		createRefund: connect.NewClient[payment.CreateRefundRequest, payment.Refund](
+			httpClient,
+			baseURL+PaymentServiceCreateRefundProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("CreateRefund")),
+			connect.WithClientOptions(opts...),
+		),
+		getRefund: connect.NewClient[payment.GetRefundRequest, payment.Refund](
+			httpClient,
+			baseURL+PaymentServiceGetRefundProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("GetRefund")),
+			connect.WithClientOptions(opts...),
+		),
+		listRefunds: connect.NewClient[payment.ListRefundsRequest, payment.ListRefundsResponse](
+			httpClient,
+			baseURL+PaymentServiceListRefundsProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("ListRefunds")),
+			connect.WithClientOptions(opts...),
+		),
+		createDispute: connect.NewClient[payment.CreateDisputeRequest, payment.Dispute](
+			httpClient,
+			baseURL+PaymentServiceCreateDisputeProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("CreateDispute")),
+			connect.WithClientOptions(opts...),
+		),
+		getDispute: connect.NewClient[payment.GetDisputeRequest, payment.Dispute](
+			httpClient,
+			baseURL+PaymentServiceGetDisputeProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("GetDispute")),
+			connect.WithClientOptions(opts...),
+		),
+		listDisputes: connect.NewClient[payment.ListDisputesRequest, payment.ListDisputesResponse](
+			httpClient,
+			baseURL+PaymentServiceListDisputesProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("ListDisputes")),
+			connect.WithClientOptions(opts...),
+		),
+		addDisputeEvidence: connect.NewClient[payment.AddDisputeEvidenceRequest, payment.Dispute](
+			httpClient,
+			baseURL+PaymentServiceAddDisputeEvidenceProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("AddDisputeEvidence")),
+			connect.WithClientOptions(opts...),
+		),
+		resolveDispute: connect.NewClient[payment.ResolveDisputeRequest, payment.Dispute](
+			httpClient,
+			baseURL+PaymentServiceResolveDisputeProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("ResolveDispute")),
+			connect.WithClientOptions(opts...),
+		),
+		createInvoice: connect.NewClient[payment.CreateInvoiceRequest, payment.Invoice](
+			httpClient,
+			baseURL+PaymentServiceCreateInvoiceProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("CreateInvoice")),
+			connect.WithClientOptions(opts...),
+		),
+		getInvoice: connect.NewClient[payment.GetInvoiceRequest, payment.Invoice](
+			httpClient,
+			baseURL+PaymentServiceGetInvoiceProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("GetInvoice")),
+			connect.WithClientOptions(opts...),
+		),
+		listInvoices: connect.NewClient[payment.ListInvoicesRequest, payment.ListInvoicesResponse](
+			httpClient,
+			baseURL+PaymentServiceListInvoicesProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("ListInvoices")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelInvoice: connect.NewClient[payment.CancelInvoiceRequest, payment.Invoice](
+			httpClient,
+			baseURL+PaymentServiceCancelInvoiceProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("CancelInvoice")),
+			connect.WithClientOptions(opts...),
+		),
 		markInvoicePaid: connect.NewClient[payment.MarkInvoicePaidRequest, payment.Invoice](
 			httpClient,
 			baseURL+PaymentServiceMarkInvoicePaidProcedure,
@@ -80,14 +341,41 @@ func NewPaymentServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(paymentServiceMethods.ByName("PayInvoice")),
 			connect.WithClientOptions(opts...),
 		),
+		payInvoices: connect.NewClient[payment.PayInvoicesRequest, payment.PayInvoicesResponse](
+			httpClient,
+			baseURL+PaymentServicePayInvoicesProcedure,
+			connect.WithSchema(paymentServiceMethods.ByName("PayInvoices")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // paymentServiceClient implements PaymentServiceClient.
 type paymentServiceClient struct {
-	makePayment     *connect.Client[payment.PaymentRequest, payment.PaymentResponse]
-	markInvoicePaid *connect.Client[payment.MarkInvoicePaidRequest, payment.Invoice]
-	payInvoice      *connect.Client[payment.PayInvoiceRequest, payment.Invoice]
+	makePayment         *connect.Client[payment.PaymentRequest, payment.PaymentResponse]
+	getPayment          *connect.Client[payment.GetPaymentRequest, payment.Payment]
+	listPayments        *connect.Client[payment.ListPaymentsRequest, payment.ListPaymentsResponse]
+	summarizePayments   *connect.Client[payment.SummarizePaymentsRequest, payment.SummarizePaymentsResponse]
+	getLedgerBalances   *connect.Client[payment.GetLedgerBalancesRequest, payment.GetLedgerBalancesResponse]
+	reconcileSettlement *connect.Client[payment.ReconcileSettlementRequest, payment.ReconcileSettlementResponse]
+	capturePayment      *connect.Client[payment.CapturePaymentRequest, payment.Payment]
+	voidPayment         *connect.Client[payment.VoidPaymentRequest, payment.Payment]
+	refundPayment       *connect.Client[payment.RefundPaymentRequest, payment.Payment]
+	createRefund        *connect.Client[payment.CreateRefundRequest, payment.Refund]
+	getRefund           *connect.Client[payment.GetRefundRequest, payment.Refund]
+	listRefunds         *connect.Client[payment.ListRefundsRequest, payment.ListRefundsResponse]
+	createDispute       *connect.Client[payment.CreateDisputeRequest, payment.Dispute]
+	getDispute          *connect.Client[payment.GetDisputeRequest, payment.Dispute]
+	listDisputes        *connect.Client[payment.ListDisputesRequest, payment.ListDisputesResponse]
+	addDisputeEvidence  *connect.Client[payment.AddDisputeEvidenceRequest, payment.Dispute]
+	resolveDispute      *connect.Client[payment.ResolveDisputeRequest, payment.Dispute]
+	createInvoice       *connect.Client[payment.CreateInvoiceRequest, payment.Invoice]
+	getInvoice          *connect.Client[payment.GetInvoiceRequest, payment.Invoice]
+	listInvoices        *connect.Client[payment.ListInvoicesRequest, payment.ListInvoicesResponse]
+	cancelInvoice       *connect.Client[payment.CancelInvoiceRequest, payment.Invoice]
+	markInvoicePaid     *connect.Client[payment.MarkInvoicePaidRequest, payment.Invoice]
+	payInvoice          *connect.Client[payment.PayInvoiceRequest, payment.Invoice]
+	payInvoices         *connect.Client[payment.PayInvoicesRequest, payment.PayInvoicesResponse]
 }
 
 // MakePayment calls rpc.payment.v1.PaymentService.MakePayment.
@@ -95,6 +383,106 @@ func (c *paymentServiceClient) MakePayment(ctx context.Context, req *connect.Req
 	return c.makePayment.CallUnary(ctx, req)
 }
 
+// GetPayment calls rpc.payment.v1.PaymentService.GetPayment.
+func (c *paymentServiceClient) GetPayment(ctx context.Context, req *connect.Request[payment.GetPaymentRequest]) (*connect.Response[payment.Payment], error) {
+	return c.getPayment.CallUnary(ctx, req)
+}
+
+// ListPayments calls rpc.payment.v1.PaymentService.ListPayments.
+func (c *paymentServiceClient) ListPayments(ctx context.Context, req *connect.Request[payment.ListPaymentsRequest]) (*connect.Response[payment.ListPaymentsResponse], error) {
+	return c.listPayments.CallUnary(ctx, req)
+}
+
+// SummarizePayments calls rpc.payment.v1.PaymentService.SummarizePayments.
+func (c *paymentServiceClient) SummarizePayments(ctx context.Context, req *connect.Request[payment.SummarizePaymentsRequest]) (*connect.Response[payment.SummarizePaymentsResponse], error) {
+	return c.summarizePayments.CallUnary(ctx, req)
+}
+
+// GetLedgerBalances calls rpc.payment.v1.PaymentService.GetLedgerBalances.
+func (c *paymentServiceClient) GetLedgerBalances(ctx context.Context, req *connect.Request[payment.GetLedgerBalancesRequest]) (*connect.Response[payment.GetLedgerBalancesResponse], error) {
+	return c.getLedgerBalances.CallUnary(ctx, req)
+}
+
+// ReconcileSettlement calls rpc.payment.v1.PaymentService.ReconcileSettlement.
+func (c *paymentServiceClient) ReconcileSettlement(ctx context.Context, req *connect.Request[payment.ReconcileSettlementRequest]) (*connect.Response[payment.ReconcileSettlementResponse], error) {
+	return c.reconcileSettlement.CallUnary(ctx, req)
+}
+
+// CapturePayment calls rpc.payment.v1.PaymentService.CapturePayment.
+func (c *paymentServiceClient) CapturePayment(ctx context.Context, req *connect.Request[payment.CapturePaymentRequest]) (*connect.Response[payment.Payment], error) {
+	return c.capturePayment.CallUnary(ctx, req)
+}
+
+// VoidPayment calls rpc.payment.v1.PaymentService.VoidPayment.
+func (c *paymentServiceClient) VoidPayment(ctx context.Context, req *connect.Request[payment.VoidPaymentRequest]) (*connect.Response[payment.Payment], error) {
+	return c.voidPayment.CallUnary(ctx, req)
+}
+
+// RefundPayment calls rpc.payment.v1.PaymentService.RefundPayment.
+func (c *paymentServiceClient) RefundPayment(ctx context.Context, req *connect.Request[payment.RefundPaymentRequest]) (*connect.Response[payment.Payment], error) {
+	return c.refundPayment.CallUnary(ctx, req)
+}
+
+// CreateRefund calls rpc.payment.v1.PaymentService.CreateRefund.
+func (c *paymentServiceClient) CreateRefund(ctx context.Context, req *connect.Request[payment.CreateRefundRequest]) (*connect.Response[payment.Refund], error) {
+	return c.createRefund.CallUnary(ctx, req)
+}
+
+// GetRefund calls rpc.payment.v1.PaymentService.GetRefund.
+func (c *paymentServiceClient) GetRefund(ctx context.Context, req *connect.Request[payment.GetRefundRequest]) (*connect.Response[payment.Refund], error) {
+	return c.getRefund.CallUnary(ctx, req)
+}
+
+// ListRefunds calls rpc.payment.v1.PaymentService.ListRefunds.
+func (c *paymentServiceClient) ListRefunds(ctx context.Context, req *connect.Request[payment.ListRefundsRequest]) (*connect.Response[payment.ListRefundsResponse], error) {
+	return c.listRefunds.CallUnary(ctx, req)
+}
+
+// CreateDispute calls rpc.payment.v1.PaymentService.CreateDispute.
+func (c *paymentServiceClient) CreateDispute(ctx context.Context, req *connect.Request[payment.CreateDisputeRequest]) (*connect.Response[payment.Dispute], error) {
+	return c.createDispute.CallUnary(ctx, req)
+}
+
+// GetDispute calls rpc.payment.v1.PaymentService.GetDispute.
+func (c *paymentServiceClient) GetDispute(ctx context.Context, req *connect.Request[payment.GetDisputeRequest]) (*connect.Response[payment.Dispute], error) {
+	return c.getDispute.CallUnary(ctx, req)
+}
+
+// ListDisputes calls rpc.payment.v1.PaymentService.ListDisputes.
+func (c *paymentServiceClient) ListDisputes(ctx context.Context, req *connect.Request[payment.ListDisputesRequest]) (*connect.Response[payment.ListDisputesResponse], error) {
+	return c.listDisputes.CallUnary(ctx, req)
+}
+
+// AddDisputeEvidence calls rpc.payment.v1.PaymentService.AddDisputeEvidence.
+func (c *paymentServiceClient) AddDisputeEvidence(ctx context.Context, req *connect.Request[payment.AddDisputeEvidenceRequest]) (*connect.Response[payment.Dispute], error) {
+	return c.addDisputeEvidence.CallUnary(ctx, req)
+}
+
+// ResolveDispute calls rpc.payment.v1.PaymentService.ResolveDispute.
+func (c *paymentServiceClient) ResolveDispute(ctx context.Context, req *connect.Request[payment.ResolveDisputeRequest]) (*connect.Response[payment.Dispute], error) {
+	return c.resolveDispute.CallUnary(ctx, req)
+}
+
+// CreateInvoice calls rpc.payment.v1.PaymentService.CreateInvoice.
+func (c *paymentServiceClient) CreateInvoice(ctx context.Context, req *connect.Request[payment.CreateInvoiceRequest]) (*connect.Response[payment.Invoice], error) {
+	return c.createInvoice.CallUnary(ctx, req)
+}
+
+// GetInvoice calls rpc.payment.v1.PaymentService.GetInvoice.
+func (c *paymentServiceClient) GetInvoice(ctx context.Context, req *connect.Request[payment.GetInvoiceRequest]) (*connect.Response[payment.Invoice], error) {
+	return c.getInvoice.CallUnary(ctx, req)
+}
+
+// ListInvoices calls rpc.payment.v1.PaymentService.ListInvoices.
+func (c *paymentServiceClient) ListInvoices(ctx context.Context, req *connect.Request[payment.ListInvoicesRequest]) (*connect.Response[payment.ListInvoicesResponse], error) {
+	return c.listInvoices.CallUnary(ctx, req)
+}
+
+// CancelInvoice calls rpc.payment.v1.PaymentService.CancelInvoice.
+func (c *paymentServiceClient) CancelInvoice(ctx context.Context, req *connect.Request[payment.CancelInvoiceRequest]) (*connect.Response[payment.Invoice], error) {
+	return c.cancelInvoice.CallUnary(ctx, req)
+}
+
 // MarkInvoicePaid calls rpc.payment.v1.PaymentService.MarkInvoicePaid.
 func (c *paymentServiceClient) MarkInvoicePaid(ctx context.Context, req *connect.Request[payment.MarkInvoicePaidRequest]) (*connect.Response[payment.Invoice], error) {
 	return c.markInvoicePaid.CallUnary(ctx, req)
@@ -105,11 +493,94 @@ func (c *paymentServiceClient) PayInvoice(ctx context.Context, req *connect.Requ
 	return c.payInvoice.CallUnary(ctx, req)
 }
 
+// PayInvoices calls rpc.payment.v1.PaymentService.PayInvoices.
+func (c *paymentServiceClient) PayInvoices(ctx context.Context, req *connect.Request[payment.PayInvoicesRequest]) (*connect.Response[payment.PayInvoicesResponse], error) {
+	return c.payInvoices.CallUnary(ctx, req)
+}
+
 // PaymentServiceHandler is an implementation of the rpc.payment.v1.PaymentService service.
 type PaymentServiceHandler interface {
+	// MakePayment authorizes a payment against card_token and returns its id.
+	// The payment starts AUTHORIZED unless capture is set, in which case it is
+	// authorized and captured in one step. Fraud rules run first; a denied
+	// attempt returns PERMISSION_DENIED with a google.rpc.ErrorInfo.
 	MakePayment(context.Context, *connect.Request[payment.PaymentRequest]) (*connect.Response[payment.PaymentResponse], error)
+	// GetPayment returns a single payment (AIP-131). Only the last four
+	// characters of the card token are exposed.
+	GetPayment(context.Context, *connect.Request[payment.GetPaymentRequest]) (*connect.Response[payment.Payment], error)
+	// ListPayments returns payments newest first, optionally filtered by
+	// status, currency and creation time (AIP-132).
+	ListPayments(context.Context, *connect.Request[payment.ListPaymentsRequest]) (*connect.Response[payment.ListPaymentsResponse], error)
+	// SummarizePayments totals the net settled amount (captured minus
+	// refunded) of matching payments across currencies, converting each
+	// currency's subtotal into target_currency with stored FX rates. Returns
+	// FAILED_PRECONDITION when a needed rate is missing.
+	SummarizePayments(context.Context, *connect.Request[payment.SummarizePaymentsRequest]) (*connect.Response[payment.SummarizePaymentsResponse], error)
+	// GetLedgerBalances returns the double-entry ledger balance of each
+	// account and currency as of as_of_time (default: now).
+	GetLedgerBalances(context.Context, *connect.Request[payment.GetLedgerBalancesRequest]) (*connect.Response[payment.GetLedgerBalancesResponse], error)
+	// ReconcileSettlement matches settled payments against the processor's
+	// settlement CSV, read with the configured column mapping. A row matches a
+	// payment by processor reference, or failing that by amount, currency and
+	// date. Returns INVALID_ARGUMENT when the file cannot be parsed.
+	ReconcileSettlement(context.Context, *connect.Request[payment.ReconcileSettlementRequest]) (*connect.Response[payment.ReconcileSettlementResponse], error)
+	// CapturePayment settles an AUTHORIZED payment (AUTHORIZED -> CAPTURED).
+	CapturePayment(context.Context, *connect.Request[payment.CapturePaymentRequest]) (*connect.Response[payment.Payment], error)
+	// VoidPayment releases an AUTHORIZED payment without settling it
+	// (AUTHORIZED -> VOIDED).
+	VoidPayment(context.Context, *connect.Request[payment.VoidPaymentRequest]) (*connect.Response[payment.Payment], error)
+	// RefundPayment returns all or part of a captured payment
+	// (CAPTURED|PARTIALLY_REFUNDED -> PARTIALLY_REFUNDED|REFUNDED).
+	RefundPayment(context.Context, *connect.Request[payment.RefundPaymentRequest]) (*connect.Response[payment.Payment], error)
+	// CreateRefund returns all or part of a captured payment and records it as
+	// a Refund with its reason. Refunds never exceed the captured amount less
+	// earlier refunds and lost disputes; excess returns FAILED_PRECONDITION.
+	// Supports idempotency keys like MakePayment.
+	CreateRefund(context.Context, *connect.Request[payment.CreateRefundRequest]) (*connect.Response[payment.Refund], error)
+	// GetRefund returns a single refund by id.
+	GetRefund(context.Context, *connect.Request[payment.GetRefundRequest]) (*connect.Response[payment.Refund], error)
+	// ListRefunds returns refunds, newest first, optionally for one payment.
+	ListRefunds(context.Context, *connect.Request[payment.ListRefundsRequest]) (*connect.Response[payment.ListRefundsResponse], error)
+	// CreateDispute opens a dispute against a settled payment. A payment has
+	// at most one OPEN dispute.
+	CreateDispute(context.Context, *connect.Request[payment.CreateDisputeRequest]) (*connect.Response[payment.Dispute], error)
+	// GetDispute returns a single dispute by id, with its evidence.
+	GetDispute(context.Context, *connect.Request[payment.GetDisputeRequest]) (*connect.Response[payment.Dispute], error)
+	// ListDisputes returns disputes, newest first, optionally filtered by
+	// payment and status.
+	ListDisputes(context.Context, *connect.Request[payment.ListDisputesRequest]) (*connect.Response[payment.ListDisputesResponse], error)
+	// AddDisputeEvidence attaches an evidence note to an OPEN dispute before
+	// its evidence deadline.
+	AddDisputeEvidence(context.Context, *connect.Request[payment.AddDisputeEvidenceRequest]) (*connect.Response[payment.Dispute], error)
+	// ResolveDispute closes an OPEN dispute as WON or LOST (OPEN -> WON|LOST).
+	// A lost dispute reduces the payment's net amount by the disputed amount.
+	ResolveDispute(context.Context, *connect.Request[payment.ResolveDisputeRequest]) (*connect.Response[payment.Dispute], error)
+	// CreateInvoice creates a new open invoice (AIP-133). Server-managed fields
+	// on the embedded Invoice (id, paid, state, create_time, update_time) are
+	// ignored.
+	CreateInvoice(context.Context, *connect.Request[payment.CreateInvoiceRequest]) (*connect.Response[payment.Invoice], error)
+	// GetInvoice returns a single invoice (AIP-131).
+	GetInvoice(context.Context, *connect.Request[payment.GetInvoiceRequest]) (*connect.Response[payment.Invoice], error)
+	// ListInvoices returns invoices newest first, optionally filtered by state
+	// (AIP-132).
+	ListInvoices(context.Context, *connect.Request[payment.ListInvoicesRequest]) (*connect.Response[payment.ListInvoicesResponse], error)
+	// CancelInvoice voids an open invoice so it can no longer be paid
+	// (AIP-136). Returns FAILED_PRECONDITION if the invoice is paid or already
+	// cancelled.
+	CancelInvoice(context.Context, *connect.Request[payment.CancelInvoiceRequest]) (*connect.Response[payment.Invoice], error)
+	// MarkInvoicePaid flags an invoice as settled without charging a card, e.g.
+	// when it was paid out of band. Returns FAILED_PRECONDITION if the invoice
+	// is already paid.
 	MarkInvoicePaid(context.Context, *connect.Request[payment.MarkInvoicePaidRequest]) (*connect.Response[payment.Invoice], error)
+	// PayInvoice charges amount, or the whole amount due, to card_token and
+	// applies it to the invoice atomically. The invoice is paid once its amount
+	// due reaches zero. Returns FAILED_PRECONDITION if the invoice is already
+	// paid or the amount exceeds the amount due.
 	PayInvoice(context.Context, *connect.Request[payment.PayInvoiceRequest]) (*connect.Response[payment.Invoice], error)
+	// PayInvoices charges one payment to card_token and allocates it across
+	// several invoices of the same currency, with the same rules as
+	// PayInvoice for each allocation.
+	PayInvoices(context.Context, *connect.Request[payment.PayInvoicesRequest]) (*connect.Response[payment.PayInvoicesResponse], error)
 }
 
 // NewPaymentServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -125,6 +596,126 @@ func NewPaymentServiceHandler(svc PaymentServiceHandler, opts ...connect.Handler
 		connect.WithSchema(paymentServiceMethods.ByName("MakePayment")),
 		connect.WithHandlerOptions(opts...),
 	)
+	paymentServiceGetPaymentHandler := connect.NewUnaryHandler(
+		PaymentServiceGetPaymentProcedure,
+		svc.GetPayment,
+		connect.WithSchema(paymentServiceMethods.ByName("GetPayment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceListPaymentsHandler := connect.NewUnaryHandler(
+		PaymentServiceListPaymentsProcedure,
+		svc.ListPayments,
+		connect.WithSchema(paymentServiceMethods.ByName("ListPayments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceSummarizePaymentsHandler := connect.NewUnaryHandler(
+		PaymentServiceSummarizePaymentsProcedure,
+		svc.SummarizePayments,
+		connect.WithSchema(paymentServiceMethods.ByName("SummarizePayments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceGetLedgerBalancesHandler := connect.NewUnaryHandler(
+		PaymentServiceGetLedgerBalancesProcedure,
+		svc.GetLedgerBalances,
+		connect.WithSchema(paymentServiceMethods.ByName("GetLedgerBalances")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceReconcileSettlementHandler := connect.NewUnaryHandler(
+		PaymentServiceReconcileSettlementProcedure,
+		svc.ReconcileSettlement,
+		connect.WithSchema(paymentServiceMethods.ByName("ReconcileSettlement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceCapturePaymentHandler := connect.NewUnaryHandler(
+		PaymentServiceCapturePaymentProcedure,
+		svc.CapturePayment,
+		connect.WithSchema(paymentServiceMethods.ByName("CapturePayment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceVoidPaymentHandler := connect.NewUnaryHandler(
+		PaymentServiceVoidPaymentProcedure,
+		svc.VoidPayment,
+		connect.WithSchema(paymentServiceMethods.ByName("VoidPayment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceRefundPaymentHandler := connect.NewUnaryHandler(
+		PaymentServiceRefundPaymentProcedure,
+		svc.RefundPayment,
+		connect.WithSchema(paymentServiceMethods.ByName("RefundPayment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceCreateRefundHandler := connect.NewUnaryHandler(
+		PaymentServiceCreateRefundProcedure,
+		svc.CreateRefund,
+		connect.WithSchema(paymentServiceMethods.ByName("CreateRefund")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceGetRefundHandler := connect.NewUnaryHandler(
+		PaymentServiceGetRefundProcedure,
+		svc.GetRefund,
+		connect.WithSchema(paymentServiceMethods.ByName("GetRefund")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceListRefundsHandler := connect.NewUnaryHandler(
+		PaymentServiceListRefundsProcedure,
+		svc.ListRefunds,
+		connect.WithSchema(paymentServiceMethods.ByName("ListRefunds")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceCreateDisputeHandler := connect.NewUnaryHandler(
+		PaymentServiceCreateDisputeProcedure,
+		svc.CreateDispute,
+		connect.WithSchema(paymentServiceMethods.ByName("CreateDispute")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceGetDisputeHandler := connect.NewUnaryHandler(
+		PaymentServiceGetDisputeProcedure,
+		svc.GetDispute,
+		connect.WithSchema(paymentServiceMethods.ByName("GetDispute")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceListDisputesHandler := connect.NewUnaryHandler(
+		PaymentServiceListDisputesProcedure,
+		svc.ListDisputes,
+		connect.WithSchema(paymentServiceMethods.ByName("ListDisputes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceAddDisputeEvidenceHandler := connect.NewUnaryHandler(
+		PaymentServiceAddDisputeEvidenceProcedure,
+		svc.AddDisputeEvidence,
+		connect.WithSchema(paymentServiceMethods.ByName("AddDisputeEvidence")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceResolveDisputeHandler := connect.NewUnaryHandler(
+		PaymentServiceResolveDisputeProcedure,
+		svc.ResolveDispute,
+		connect.WithSchema(paymentServiceMethods.ByName("ResolveDispute")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceCreateInvoiceHandler := connect.NewUnaryHandler(
+		PaymentServiceCreateInvoiceProcedure,
+		svc.CreateInvoice,
+		connect.WithSchema(paymentServiceMethods.ByName("CreateInvoice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceGetInvoiceHandler := connect.NewUnaryHandler(
+		PaymentServiceGetInvoiceProcedure,
+		svc.GetInvoice,
+		connect.WithSchema(paymentServiceMethods.ByName("GetInvoice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceListInvoicesHandler := connect.NewUnaryHandler(
+		PaymentServiceListInvoicesProcedure,
+		svc.ListInvoices,
+		connect.WithSchema(paymentServiceMethods.ByName("ListInvoices")),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentServiceCancelInvoiceHandler := connect.NewUnaryHandler(
+		PaymentServiceCancelInvoiceProcedure,
+		svc.CancelInvoice,
+		connect.WithSchema(paymentServiceMethods.ByName("CancelInvoice")),
+		connect.WithHandlerOptions(opts...),
+	)
 	paymentServiceMarkInvoicePaidHandler := connect.NewUnaryHandler(
 		PaymentServiceMarkInvoicePaidProcedure,
 		svc.MarkInvoicePaid,
@@ -137,14 +728,62 @@ func NewPaymentServiceHandler(svc PaymentServiceHandler, opts ...connect.Handler
 		connect.WithSchema(paymentServiceMethods.ByName("PayInvoice")),
 		connect.WithHandlerOptions(opts...),
 	)
+	paymentServicePayInvoicesHandler := connect.NewUnaryHandler(
+		PaymentServicePayInvoicesProcedure,
+		svc.PayInvoices,
+		connect.WithSchema(paymentServiceMethods.ByName("PayInvoices")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/rpc.payment.v1.PaymentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PaymentServiceMakePaymentProcedure:
 			paymentServiceMakePaymentHandler.ServeHTTP(w, r)
+		case PaymentServiceGetPaymentProcedure:
+			paymentServiceGetPaymentHandler.ServeHTTP(w, r)
+		case PaymentServiceListPaymentsProcedure:
+			paymentServiceListPaymentsHandler.ServeHTTP(w, r)
+		case PaymentServiceSummarizePaymentsProcedure:
+			paymentServiceSummarizePaymentsHandler.ServeHTTP(w, r)
+		case PaymentServiceGetLedgerBalancesProcedure:
+			paymentServiceGetLedgerBalancesHandler.ServeHTTP(w, r)
+		case PaymentServiceReconcileSettlementProcedure:
+			paymentServiceReconcileSettlementHandler.ServeHTTP(w, r)
+		case PaymentServiceCapturePaymentProcedure:
+			paymentServiceCapturePaymentHandler.ServeHTTP(w, r)
+		case PaymentServiceVoidPaymentProcedure:
+			paymentServiceVoidPaymentHandler.ServeHTTP(w, r)
+		case PaymentServiceRefundPaymentProcedure:
+			paymentServiceRefundPaymentHandler.ServeHTTP(w, r)
+		case PaymentServiceCreateRefundProcedure:
+			paymentServiceCreateRefundHandler.ServeHTTP(w, r)
+		case PaymentServiceGetRefundProcedure:
+			paymentServiceGetRefundHandler.ServeHTTP(w, r)
+		case PaymentServiceListRefundsProcedure:
+			paymentServiceListRefundsHandler.ServeHTTP(w, r)
+		case PaymentServiceCreateDisputeProcedure:
+			paymentServiceCreateDisputeHandler.ServeHTTP(w, r)
+		case PaymentServiceGetDisputeProcedure:
+			paymentServiceGetDisputeHandler.ServeHTTP(w, r)
+		case PaymentServiceListDisputesProcedure:
+			paymentServiceListDisputesHandler.ServeHTTP(w, r)
+		case PaymentServiceAddDisputeEvidenceProcedure:
+			paymentServiceAddDisputeEvidenceHandler.ServeHTTP(w, r)
+		case PaymentServiceResolveDisputeProcedure:
+			paymentServiceResolveDisputeHandler.ServeHTTP(w, r)
+		case PaymentServiceCreateInvoiceProcedure:
+			paymentServiceCreateInvoiceHandler.ServeHTTP(w, r)
+		case PaymentServiceGetInvoiceProcedure:
+			paymentServiceGetInvoiceHandler.ServeHTTP(w, r)
+		case PaymentServiceListInvoicesProcedure:
+			paymentServiceListInvoicesHandler.ServeHTTP(w, r)
+		case PaymentServiceCancelInvoiceProcedure:
+			paymentServiceCancelInvoiceHandler.ServeHTTP(w, r)
 		case PaymentServiceMarkInvoicePaidProcedure:
 			paymentServiceMarkInvoicePaidHandler.ServeHTTP(w, r)
 		case PaymentServicePayInvoiceProcedure:
 			paymentServicePayInvoiceHandler.ServeHTTP(w, r)
+		case PaymentServicePayInvoicesProcedure:
+			paymentServicePayInvoicesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -158,6 +797,86 @@ func (UnimplementedPaymentServiceHandler) MakePayment(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.MakePayment is not implemented"))
 }
 
+func (UnimplementedPaymentServiceHandler) GetPayment(context.Context, *connect.Request[payment.GetPaymentRequest]) (*connect.Response[payment.Payment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.GetPayment is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) ListPayments(context.Context, *connect.Request[payment.ListPaymentsRequest]) (*connect.Response[payment.ListPaymentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.ListPayments is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) SummarizePayments(context.Context, *connect.Request[payment.SummarizePaymentsRequest]) (*connect.Response[payment.SummarizePaymentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.SummarizePayments is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) GetLedgerBalances(context.Context, *connect.Request[payment.GetLedgerBalancesRequest]) (*connect.Response[payment.GetLedgerBalancesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.GetLedgerBalances is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) ReconcileSettlement(context.Context, *connect.Request[payment.ReconcileSettlementRequest]) (*connect.Response[payment.ReconcileSettlementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.ReconcileSettlement is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) CapturePayment(context.Context, *connect.Request[payment.CapturePaymentRequest]) (*connect.Response[payment.Payment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.CapturePayment is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) VoidPayment(context.Context, *connect.Request[payment.VoidPaymentRequest]) (*connect.Response[payment.Payment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.VoidPayment is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) RefundPayment(context.Context, *connect.Request[payment.RefundPaymentRequest]) (*connect.Response[payment.Payment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.RefundPayment is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) CreateRefund(context.Context, *connect.Request[payment.CreateRefundRequest]) (*connect.Response[payment.Refund], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.CreateRefund is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) GetRefund(context.Context, *connect.Request[payment.GetRefundRequest]) (*connect.Response[payment.Refund], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.GetRefund is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) ListRefunds(context.Context, *connect.Request[payment.ListRefundsRequest]) (*connect.Response[payment.ListRefundsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.ListRefunds is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) CreateDispute(context.Context, *connect.Request[payment.CreateDisputeRequest]) (*connect.Response[payment.Dispute], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.CreateDispute is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) GetDispute(context.Context, *connect.Request[payment.GetDisputeRequest]) (*connect.Response[payment.Dispute], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.GetDispute is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) ListDisputes(context.Context, *connect.Request[payment.ListDisputesRequest]) (*connect.Response[payment.ListDisputesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.ListDisputes is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) AddDisputeEvidence(context.Context, *connect.Request[payment.AddDisputeEvidenceRequest]) (*connect.Response[payment.Dispute], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.AddDisputeEvidence is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) ResolveDispute(context.Context, *connect.Request[payment.ResolveDisputeRequest]) (*connect.Response[payment.Dispute], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.ResolveDispute is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) CreateInvoice(context.Context, *connect.Request[payment.CreateInvoiceRequest]) (*connect.Response[payment.Invoice], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.CreateInvoice is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) GetInvoice(context.Context, *connect.Request[payment.GetInvoiceRequest]) (*connect.Response[payment.Invoice], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.GetInvoice is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) ListInvoices(context.Context, *connect.Request[payment.ListInvoicesRequest]) (*connect.Response[payment.ListInvoicesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.ListInvoices is not implemented"))
+}
+
+func (UnimplementedPaymentServiceHandler) CancelInvoice(context.Context, *connect.Request[payment.CancelInvoiceRequest]) (*connect.Response[payment.Invoice], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.CancelInvoice is not implemented"))
+}
+
 func (UnimplementedPaymentServiceHandler) MarkInvoicePaid(context.Context, *connect.Request[payment.MarkInvoicePaidRequest]) (*connect.Response[payment.Invoice], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.MarkInvoicePaid is not implemented"))
 }
@@ -165,3 +884,7 @@ func (UnimplementedPaymentServiceHandler) MarkInvoicePaid(context.Context, *conn
 func (UnimplementedPaymentServiceHandler) PayInvoice(context.Context, *connect.Request[payment.PayInvoiceRequest]) (*connect.Response[payment.Invoice], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.PayInvoice is not implemented"))
 }
+
+func (UnimplementedPaymentServiceHandler) PayInvoices(context.Context, *connect.Request[payment.PayInvoicesRequest]) (*connect.Response[payment.PayInvoicesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.payment.v1.PaymentService.PayInvoices is not implemented"))
+}
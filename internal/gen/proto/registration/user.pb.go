@@ -203,10 +203,17 @@ func (x *LoginRequest) GetPassword() string {
 }
 
 type LoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// Opaque token exchanged for a new access token through RefreshToken. It is
+	// single-use: each refresh returns a replacement.
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// Expiry of access_token.
+	AccessTokenExpireTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=access_token_expire_time,json=accessTokenExpireTime,proto3" json:"access_token_expire_time,omitempty"`
+	// Expiry of refresh_token.
+	RefreshTokenExpireTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=refresh_token_expire_time,json=refreshTokenExpireTime,proto3" json:"refresh_token_expire_time,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
@@ -246,6 +253,244 @@ func (x *LoginResponse) GetAccessToken() string {
 	return ""
 }
 
+func (x *LoginResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetAccessTokenExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AccessTokenExpireTime
+	}
+	return nil
+}
+
+func (x *LoginResponse) GetRefreshTokenExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshTokenExpireTime
+	}
+	return nil
+}
+
+type RefreshTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The refresh token from the last LoginUser or RefreshToken call.
+	RefreshToken  string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_registration_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_registration_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_registration_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The session's refresh token; when set, its token family is
+	// revoked too so the session cannot be refreshed.
+	RefreshToken  string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_registration_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_registration_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_registration_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LogoutRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_registration_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_registration_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_registration_user_proto_rawDescGZIP(), []int{6}
+}
+
+type RevokeUserSessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user whose sessions are revoked.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeUserSessionsRequest) Reset() {
+	*x = RevokeUserSessionsRequest{}
+	mi := &file_registration_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeUserSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeUserSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_registration_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeUserSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_registration_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RevokeUserSessionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RevokeUserSessionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of refresh tokens revoked.
+	RevokedRefreshTokens int32 `protobuf:"varint,1,opt,name=revoked_refresh_tokens,json=revokedRefreshTokens,proto3" json:"revoked_refresh_tokens,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RevokeUserSessionsResponse) Reset() {
+	*x = RevokeUserSessionsResponse{}
+	mi := &file_registration_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeUserSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeUserSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_registration_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeUserSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_registration_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RevokeUserSessionsResponse) GetRevokedRefreshTokens() int32 {
+	if x != nil {
+		return x.RevokedRefreshTokens
+	}
+	return 0
+}
+
 var File_registration_user_proto protoreflect.FileDescriptor
 
 const file_registration_user_proto_rawDesc = "" +
@@ -263,12 +508,28 @@ const file_registration_user_proto_rawDesc = "" +
 	"createTime\"@\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"2\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x83\x02\n" +
 	"\rLoginResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken2\xd7\x01\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12S\n" +
+	"\x18access_token_expire_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x15accessTokenExpireTime\x12U\n" +
+	"\x19refresh_token_expire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x16refreshTokenExpireTime\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"4\n" +
+	"\rLogoutRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\x10\n" +
+	"\x0eLogoutResponse\"4\n" +
+	"\x19RevokeUserSessionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"R\n" +
+	"\x1aRevokeUserSessionsResponse\x124\n" +
+	"\x16revoked_refresh_tokens\x18\x01 \x01(\x05R\x14revokedRefreshTokens2\xbc\x04\n" +
 	"\vUserService\x12i\n" +
 	"\fRegisterUser\x12\x1c.rpc.user.v1.RegisterRequest\x1a\x1d.rpc.user.v1.RegisterResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/user:register\x12]\n" +
-	"\tLoginUser\x12\x19.rpc.user.v1.LoginRequest\x1a\x1a.rpc.user.v1.LoginResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/user:loginB\xa6\x01\n" +
+	"\tLoginUser\x12\x19.rpc.user.v1.LoginRequest\x1a\x1a.rpc.user.v1.LoginResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/user:login\x12i\n" +
+	"\fRefreshToken\x12 .rpc.user.v1.RefreshTokenRequest\x1a\x1a.rpc.user.v1.LoginResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/user:refresh\x12a\n" +
+	"\n" +
+	"LogoutUser\x12\x1a.rpc.user.v1.LogoutRequest\x1a\x1b.rpc.user.v1.LogoutResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/user:logout\x12\x94\x01\n" +
+	"\x12RevokeUserSessions\x12&.rpc.user.v1.RevokeUserSessionsRequest\x1a'.rpc.user.v1.RevokeUserSessionsResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/users/{user_id}:revokeSessionsB\xa6\x01\n" +
 	"\x0fcom.rpc.user.v1B\tUserProtoP\x01Z:github.com/grpc-buf/internal/gen/proto/registration;userv1\xa2\x02\x03RUX\xaa\x02\vRpc.User.V1\xca\x02\vRpc\\User\\V1\xe2\x02\x17Rpc\\User\\V1\\GPBMetadata\xea\x02\rRpc::User::V1b\x06proto3"
 
 var (
@@ -283,25 +544,38 @@ func file_registration_user_proto_rawDescGZIP() []byte {
 	return file_registration_user_proto_rawDescData
 }
 
-var file_registration_user_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_registration_user_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_registration_user_proto_goTypes = []any{
-	(*RegisterRequest)(nil),       // 0: rpc.user.v1.RegisterRequest
-	(*RegisterResponse)(nil),      // 1: rpc.user.v1.RegisterResponse
-	(*LoginRequest)(nil),          // 2: rpc.user.v1.LoginRequest
-	(*LoginResponse)(nil),         // 3: rpc.user.v1.LoginResponse
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*RegisterRequest)(nil),            // 0: rpc.user.v1.RegisterRequest
+	(*RegisterResponse)(nil),           // 1: rpc.user.v1.RegisterResponse
+	(*LoginRequest)(nil),               // 2: rpc.user.v1.LoginRequest
+	(*LoginResponse)(nil),              // 3: rpc.user.v1.LoginResponse
+	(*RefreshTokenRequest)(nil),        // 4: rpc.user.v1.RefreshTokenRequest
+	(*LogoutRequest)(nil),              // 5: rpc.user.v1.LogoutRequest
+	(*LogoutResponse)(nil),             // 6: rpc.user.v1.LogoutResponse
+	(*RevokeUserSessionsRequest)(nil),  // 7: rpc.user.v1.RevokeUserSessionsRequest
+	(*RevokeUserSessionsResponse)(nil), // 8: rpc.user.v1.RevokeUserSessionsResponse
+	(*timestamppb.Timestamp)(nil),      // 9: google.protobuf.Timestamp
 }
 var file_registration_user_proto_depIdxs = []int32{
-	4, // 0: rpc.user.v1.RegisterResponse.create_time:type_name -> google.protobuf.Timestamp
-	0, // 1: rpc.user.v1.UserService.RegisterUser:input_type -> rpc.user.v1.RegisterRequest
-	2, // 2: rpc.user.v1.UserService.LoginUser:input_type -> rpc.user.v1.LoginRequest
-	1, // 3: rpc.user.v1.UserService.RegisterUser:output_type -> rpc.user.v1.RegisterResponse
-	3, // 4: rpc.user.v1.UserService.LoginUser:output_type -> rpc.user.v1.LoginResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	9, // 0: rpc.user.v1.RegisterResponse.create_time:type_name -> google.protobuf.Timestamp
+	9, // 1: rpc.user.v1.LoginResponse.access_token_expire_time:type_name -> google.protobuf.Timestamp
+	9, // 2: rpc.user.v1.LoginResponse.refresh_token_expire_time:type_name -> google.protobuf.Timestamp
+	0, // 3: rpc.user.v1.UserService.RegisterUser:input_type -> rpc.user.v1.RegisterRequest
+	2, // 4: rpc.user.v1.UserService.LoginUser:input_type -> rpc.user.v1.LoginRequest
+	4, // 5: rpc.user.v1.UserService.RefreshToken:input_type -> rpc.user.v1.RefreshTokenRequest
+	5, // 6: rpc.user.v1.UserService.LogoutUser:input_type -> rpc.user.v1.LogoutRequest
+	7, // 7: rpc.user.v1.UserService.RevokeUserSessions:input_type -> rpc.user.v1.RevokeUserSessionsRequest
+	1, // 8: rpc.user.v1.UserService.RegisterUser:output_type -> rpc.user.v1.RegisterResponse
+	3, // 9: rpc.user.v1.UserService.LoginUser:output_type -> rpc.user.v1.LoginResponse
+	3, // 10: rpc.user.v1.UserService.RefreshToken:output_type -> rpc.user.v1.LoginResponse
+	6, // 11: rpc.user.v1.UserService.LogoutUser:output_type -> rpc.user.v1.LogoutResponse
+	8, // 12: rpc.user.v1.UserService.RevokeUserSessions:output_type -> rpc.user.v1.RevokeUserSessionsResponse
+	8, // [8:13] is the sub-list for method output_type
+	3, // [3:8] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_registration_user_proto_init() }
@@ -315,7 +589,7 @@ func file_registration_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_registration_user_proto_rawDesc), len(file_registration_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -38,12 +38,32 @@ const (
 	UserServiceRegisterUserProcedure = "/rpc.user.v1.UserService/RegisterUser"
 	// UserServiceLoginUserProcedure is the fully-qualified name of the UserService's LoginUser RPC.
 	UserServiceLoginUserProcedure = "/rpc.user.v1.UserService/LoginUser"
+	// UserServiceRefreshTokenProcedure is the fully-qualified name of the UserService's RefreshToken
+	// RPC.
+	UserServiceRefreshTokenProcedure = "/rpc.user.v1.UserService/RefreshToken"
+	// UserServiceLogoutUserProcedure is the fully-qualified name of the UserService's LogoutUser RPC.
+	UserServiceLogoutUserProcedure = "/rpc.user.v1.UserService/LogoutUser"
+	// UserServiceRevokeUserSessionsProcedure is the fully-qualified name of the UserService's
+	// RevokeUserSessions RPC.
+	UserServiceRevokeUserSessionsProcedure = "/rpc.user.v1.UserService/RevokeUserSessions"
 )
 
 // UserServiceClient is a client for the rpc.user.v1.UserService service.
 type UserServiceClient interface {
 	RegisterUser(context.Context, *connect.Request[registration.RegisterRequest]) (*connect.Response[registration.RegisterResponse], error)
 	LoginUser(context.Context, *connect.Request[registration.LoginRequest]) (*connect.Response[registration.LoginResponse], error)
+	// RefreshToken exchanges a refresh token for a new access token and a new
+	// refresh token, invalidating the one presented. Presenting a refresh token
+	// that was already exchanged revokes every token descended from the same
+	// login and returns UNAUTHENTICATED.
+	RefreshToken(context.Context, *connect.Request[registration.RefreshTokenRequest]) (*connect.Response[registration.LoginResponse], error)
+	// LogoutUser revokes the caller's access token (by jti) until it expires
+	// and, when given, the session's refresh token family.
+	LogoutUser(context.Context, *connect.Request[registration.LogoutRequest]) (*connect.Response[registration.LogoutResponse], error)
+	// RevokeUserSessions is an administrative call that signs a user out
+	// everywhere: every refresh token is revoked and every access token issued
+	// so far is rejected until it expires.
+	RevokeUserSessions(context.Context, *connect.Request[registration.RevokeUserSessionsRequest]) (*connect.Response[registration.RevokeUserSessionsResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the rpc.user.v1.UserService service. By default, it
@@ -69,13 +89,34 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("LoginUser")),
 			connect.WithClientOptions(opts...),
 		),
+		refreshToken: connect.NewClient[registration.RefreshTokenRequest, registration.LoginResponse](
+			httpClient,
+			baseURL+UserServiceRefreshTokenProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RefreshToken")),
+			connect.WithClientOptions(opts...),
+		),
+		logoutUser: connect.NewClient[registration.LogoutRequest, registration.LogoutResponse](
+			httpClient,
+			baseURL+UserServiceLogoutUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("LogoutUser")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeUserSessions: connect.NewClient[registration.RevokeUserSessionsRequest, registration.RevokeUserSessionsResponse](
+			httpClient,
+			baseURL+UserServiceRevokeUserSessionsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RevokeUserSessions")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	registerUser *connect.Client[registration.RegisterRequest, registration.RegisterResponse]
-	loginUser    *connect.Client[registration.LoginRequest, registration.LoginResponse]
+	registerUser       *connect.Client[registration.RegisterRequest, registration.RegisterResponse]
+	loginUser          *connect.Client[registration.LoginRequest, registration.LoginResponse]
+	refreshToken       *connect.Client[registration.RefreshTokenRequest, registration.LoginResponse]
+	logoutUser         *connect.Client[registration.LogoutRequest, registration.LogoutResponse]
+	revokeUserSessions *connect.Client[registration.RevokeUserSessionsRequest, registration.RevokeUserSessionsResponse]
 }
 
 // RegisterUser calls rpc.user.v1.UserService.RegisterUser.
@@ -88,10 +129,37 @@ func (c *userServiceClient) LoginUser(ctx context.Context, req *connect.Request[
 	return c.loginUser.CallUnary(ctx, req)
 }
 
+// RefreshToken calls rpc.user.v1.UserService.RefreshToken.
+func (c *userServiceClient) RefreshToken(ctx context.Context, req *connect.Request[registration.RefreshTokenRequest]) (*connect.Response[registration.LoginResponse], error) {
+	return c.refreshToken.CallUnary(ctx, req)
+}
+
+// LogoutUser calls rpc.user.v1.UserService.LogoutUser.
+func (c *userServiceClient) LogoutUser(ctx context.Context, req *connect.Request[registration.LogoutRequest]) (*connect.Response[registration.LogoutResponse], error) {
+	return c.logoutUser.CallUnary(ctx, req)
+}
+
+// RevokeUserSessions calls rpc.user.v1.UserService.RevokeUserSessions.
+func (c *userServiceClient) RevokeUserSessions(ctx context.Context, req *connect.Request[registration.RevokeUserSessionsRequest]) (*connect.Response[registration.RevokeUserSessionsResponse], error) {
+	return c.revokeUserSessions.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the rpc.user.v1.UserService service.
 type UserServiceHandler interface {
 	RegisterUser(context.Context, *connect.Request[registration.RegisterRequest]) (*connect.Response[registration.RegisterResponse], error)
 	LoginUser(context.Context, *connect.Request[registration.LoginRequest]) (*connect.Response[registration.LoginResponse], error)
+	// RefreshToken exchanges a refresh token for a new access token and a new
+	// refresh token, invalidating the one presented. Presenting a refresh token
+	// that was already exchanged revokes every token descended from the same
+	// login and returns UNAUTHENTICATED.
+	RefreshToken(context.Context, *connect.Request[registration.RefreshTokenRequest]) (*connect.Response[registration.LoginResponse], error)
+	// LogoutUser revokes the caller's access token (by jti) until it expires
+	// and, when given, the session's refresh token family.
+	LogoutUser(context.Context, *connect.Request[registration.LogoutRequest]) (*connect.Response[registration.LogoutResponse], error)
+	// RevokeUserSessions is an administrative call that signs a user out
+	// everywhere: every refresh token is revoked and every access token issued
+	// so far is rejected until it expires.
+	RevokeUserSessions(context.Context, *connect.Request[registration.RevokeUserSessionsRequest]) (*connect.Response[registration.RevokeUserSessionsResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -113,12 +181,36 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("LoginUser")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceRefreshTokenHandler := connect.NewUnaryHandler(
+		UserServiceRefreshTokenProcedure,
+		svc.RefreshToken,
+		connect.WithSchema(userServiceMethods.ByName("RefreshToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceLogoutUserHandler := connect.NewUnaryHandler(
+		UserServiceLogoutUserProcedure,
+		svc.LogoutUser,
+		connect.WithSchema(userServiceMethods.ByName("LogoutUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRevokeUserSessionsHandler := connect.NewUnaryHandler(
+		UserServiceRevokeUserSessionsProcedure,
+		svc.RevokeUserSessions,
+		connect.WithSchema(userServiceMethods.ByName("RevokeUserSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/rpc.user.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceRegisterUserProcedure:
 			userServiceRegisterUserHandler.ServeHTTP(w, r)
 		case UserServiceLoginUserProcedure:
 			userServiceLoginUserHandler.ServeHTTP(w, r)
+		case UserServiceRefreshTokenProcedure:
+			userServiceRefreshTokenHandler.ServeHTTP(w, r)
+		case UserServiceLogoutUserProcedure:
+			userServiceLogoutUserHandler.ServeHTTP(w, r)
+		case UserServiceRevokeUserSessionsProcedure:
+			userServiceRevokeUserSessionsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -135,3 +227,15 @@ func (UnimplementedUserServiceHandler) RegisterUser(context.Context, *connect.Re
 func (UnimplementedUserServiceHandler) LoginUser(context.Context, *connect.Request[registration.LoginRequest]) (*connect.Response[registration.LoginResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.user.v1.UserService.LoginUser is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) RefreshToken(context.Context, *connect.Request[registration.RefreshTokenRequest]) (*connect.Response[registration.LoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.user.v1.UserService.RefreshToken is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) LogoutUser(context.Context, *connect.Request[registration.LogoutRequest]) (*connect.Response[registration.LogoutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.user.v1.UserService.LogoutUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RevokeUserSessions(context.Context, *connect.Request[registration.RevokeUserSessionsRequest]) (*connect.Response[registration.RevokeUserSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.user.v1.UserService.RevokeUserSessions is not implemented"))
+}
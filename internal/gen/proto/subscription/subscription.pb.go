@@ -0,0 +1,819 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: subscription/subscription.proto
+
+package subscriptionv1
+
+import (
+	payment "github.com/grpc-buf/internal/gen/proto/payment"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	date "google.golang.org/genproto/googleapis/type/date"
+	money "google.golang.org/genproto/googleapis/type/money"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BillingInterval is the length of a subscription period.
+type BillingInterval int32
+
+const (
+	BillingInterval_BILLING_INTERVAL_UNSPECIFIED BillingInterval = 0
+	// Every week on anchor_day, an ISO weekday (1 = Monday ... 7 = Sunday).
+	BillingInterval_BILLING_INTERVAL_WEEKLY BillingInterval = 1
+	// Every month on anchor_day (1-31), or the month's last day if shorter.
+	BillingInterval_BILLING_INTERVAL_MONTHLY BillingInterval = 2
+	// Every year on anchor_day (1-31) of start_date's month, or that month's
+	// last day if shorter.
+	BillingInterval_BILLING_INTERVAL_YEARLY BillingInterval = 3
+)
+
+// Enum value maps for BillingInterval.
+var (
+	BillingInterval_name = map[int32]string{
+		0: "BILLING_INTERVAL_UNSPECIFIED",
+		1: "BILLING_INTERVAL_WEEKLY",
+		2: "BILLING_INTERVAL_MONTHLY",
+		3: "BILLING_INTERVAL_YEARLY",
+	}
+	BillingInterval_value = map[string]int32{
+		"BILLING_INTERVAL_UNSPECIFIED": 0,
+		"BILLING_INTERVAL_WEEKLY":      1,
+		"BILLING_INTERVAL_MONTHLY":     2,
+		"BILLING_INTERVAL_YEARLY":      3,
+	}
+)
+
+func (x BillingInterval) Enum() *BillingInterval {
+	p := new(BillingInterval)
+	*p = x
+	return p
+}
+
+func (x BillingInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BillingInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_subscription_subscription_proto_enumTypes[0].Descriptor()
+}
+
+func (BillingInterval) Type() protoreflect.EnumType {
+	return &file_subscription_subscription_proto_enumTypes[0]
+}
+
+func (x BillingInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BillingInterval.Descriptor instead.
+func (BillingInterval) EnumDescriptor() ([]byte, []int) {
+	return file_subscription_subscription_proto_rawDescGZIP(), []int{0}
+}
+
+// SubscriptionStatus is the lifecycle state of a subscription.
+type SubscriptionStatus int32
+
+const (
+	SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED SubscriptionStatus = 0
+	// Invoiced at every period boundary.
+	SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE SubscriptionStatus = 1
+	// Not invoiced until resumed.
+	SubscriptionStatus_SUBSCRIPTION_STATUS_PAUSED SubscriptionStatus = 2
+	// Ended; never invoiced again.
+	SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELLED SubscriptionStatus = 3
+)
+
+// Enum value maps for SubscriptionStatus.
+var (
+	SubscriptionStatus_name = map[int32]string{
+		0: "SUBSCRIPTION_STATUS_UNSPECIFIED",
+		1: "SUBSCRIPTION_STATUS_ACTIVE",
+		2: "SUBSCRIPTION_STATUS_PAUSED",
+		3: "SUBSCRIPTION_STATUS_CANCELLED",
+	}
+	SubscriptionStatus_value = map[string]int32{
+		"SUBSCRIPTION_STATUS_UNSPECIFIED": 0,
+		"SUBSCRIPTION_STATUS_ACTIVE":      1,
+		"SUBSCRIPTION_STATUS_PAUSED":      2,
+		"SUBSCRIPTION_STATUS_CANCELLED":   3,
+	}
+)
+
+func (x SubscriptionStatus) Enum() *SubscriptionStatus {
+	p := new(SubscriptionStatus)
+	*p = x
+	return p
+}
+
+func (x SubscriptionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubscriptionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_subscription_subscription_proto_enumTypes[1].Descriptor()
+}
+
+func (SubscriptionStatus) Type() protoreflect.EnumType {
+	return &file_subscription_subscription_proto_enumTypes[1]
+}
+
+func (x SubscriptionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubscriptionStatus.Descriptor instead.
+func (SubscriptionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_subscription_subscription_proto_rawDescGZIP(), []int{1}
+}
+
+// Subscription resource.
+type Subscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Server-generated identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required. Used as the name and line description of each invoice.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. Positive price per period (AIP-140).
+	Price *money.Money `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// Tax rate in basis points (2000 is 20%) applied to each invoice.
+	TaxRateBps int32 `protobuf:"varint,4,opt,name=tax_rate_bps,json=taxRateBps,proto3" json:"tax_rate_bps,omitempty"`
+	// Required. Period length.
+	Interval BillingInterval `protobuf:"varint,5,opt,name=interval,proto3,enum=rpc.subscription.v1.BillingInterval" json:"interval,omitempty"`
+	// Day within the period on which invoices are issued; see BillingInterval.
+	// Defaults to start_date's weekday or day of month.
+	AnchorDay int32 `protobuf:"varint,6,opt,name=anchor_day,json=anchorDay,proto3" json:"anchor_day,omitempty"`
+	// Day (UTC) the subscription starts. Defaults to today.
+	StartDate *date.Date `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Optional opaque card token. When set, each invoice is paid with
+	// PayInvoice as soon as it is issued; declined invoices stay open.
+	// Responses only carry the last four characters of the token.
+	CardToken string `protobuf:"bytes,8,opt,name=card_token,json=cardToken,proto3" json:"card_token,omitempty"`
+	// Days between issuing an invoice and its due date.
+	DaysUntilDue int32 `protobuf:"varint,9,opt,name=days_until_due,json=daysUntilDue,proto3" json:"days_until_due,omitempty"`
+	// Output only.
+	Status SubscriptionStatus `protobuf:"varint,10,opt,name=status,proto3,enum=rpc.subscription.v1.SubscriptionStatus" json:"status,omitempty"`
+	// Output only. Day the next invoice will be issued; unset once cancelled.
+	NextBillingDate *date.Date `protobuf:"bytes,11,opt,name=next_billing_date,json=nextBillingDate,proto3" json:"next_billing_date,omitempty"`
+	// Output only. The most recently issued invoice.
+	LatestInvoiceId string `protobuf:"bytes,12,opt,name=latest_invoice_id,json=latestInvoiceId,proto3" json:"latest_invoice_id,omitempty"`
+	// Output only. Creation timestamp (AIP-142).
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Last-modified timestamp (AIP-142).
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Output only. Set once cancelled.
+	CancelTime *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=cancel_time,json=cancelTime,proto3" json:"cancel_time,omitempty"`
+	// Type of the card behind card_token. Required with card_token.
+	Card          payment.CardType `protobuf:"varint,16,opt,name=card,proto3,enum=rpc.payment.v1.CardType" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_subscription_subscription_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_subscription_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_subscription_subscription_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Subscription) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Subscription) GetTaxRateBps() int32 {
+	if x != nil {
+		return x.TaxRateBps
+	}
+	return 0
+}
+
+func (x *Subscription) GetInterval() BillingInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return BillingInterval_BILLING_INTERVAL_UNSPECIFIED
+}
+
+func (x *Subscription) GetAnchorDay() int32 {
+	if x != nil {
+		return x.AnchorDay
+	}
+	return 0
+}
+
+func (x *Subscription) GetStartDate() *date.Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *Subscription) GetCardToken() string {
+	if x != nil {
+		return x.CardToken
+	}
+	return ""
+}
+
+func (x *Subscription) GetDaysUntilDue() int32 {
+	if x != nil {
+		return x.DaysUntilDue
+	}
+	return 0
+}
+
+func (x *Subscription) GetStatus() SubscriptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED
+}
+
+func (x *Subscription) GetNextBillingDate() *date.Date {
+	if x != nil {
+		return x.NextBillingDate
+	}
+	return nil
+}
+
+func (x *Subscription) GetLatestInvoiceId() string {
+	if x != nil {
+		return x.LatestInvoiceId
+	}
+	return ""
+}
+
+func (x *Subscription) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Subscription) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Subscription) GetCancelTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelTime
+	}
+	return nil
+}
+
+func (x *Subscription) GetCard() payment.CardType {
+	if x != nil {
+		return x.Card
+	}
+	return payment.CardType(0)
+}
+
+type CreateSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Must include name, price and interval.
+	Subscription  *Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubscriptionRequest) Reset() {
+	*x = CreateSubscriptionRequest{}
+	mi := &file_subscription_subscription_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_subscription_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_subscription_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateSubscriptionRequest) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type GetSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The subscription id to fetch.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionRequest) Reset() {
+	*x = GetSubscriptionRequest{}
+	mi := &file_subscription_subscription_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_subscription_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_subscription_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListSubscriptionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional filter. Unspecified returns all subscriptions.
+	Status SubscriptionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=rpc.subscription.v1.SubscriptionStatus" json:"status,omitempty"`
+	// Maximum number of subscriptions to return. Server may cap this value.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque pagination token from a previous response.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsRequest) Reset() {
+	*x = ListSubscriptionsRequest{}
+	mi := &file_subscription_subscription_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_subscription_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_subscription_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListSubscriptionsRequest) GetStatus() SubscriptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED
+}
+
+func (x *ListSubscriptionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSubscriptionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*Subscription        `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	// Token to retrieve the next page, or empty if there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsResponse) Reset() {
+	*x = ListSubscriptionsResponse{}
+	mi := &file_subscription_subscription_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_subscription_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_subscription_subscription_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *ListSubscriptionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type PauseSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The subscription id to pause.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseSubscriptionRequest) Reset() {
+	*x = PauseSubscriptionRequest{}
+	mi := &file_subscription_subscription_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSubscriptionRequest) ProtoMessage() {}
+
+func (x *PauseSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_subscription_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*PauseSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_subscription_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PauseSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ResumeSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The subscription id to resume.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSubscriptionRequest) Reset() {
+	*x = ResumeSubscriptionRequest{}
+	mi := &file_subscription_subscription_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSubscriptionRequest) ProtoMessage() {}
+
+func (x *ResumeSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_subscription_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_subscription_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResumeSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The subscription id to cancel.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSubscriptionRequest) Reset() {
+	*x = CancelSubscriptionRequest{}
+	mi := &file_subscription_subscription_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionRequest) ProtoMessage() {}
+
+func (x *CancelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_subscription_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_subscription_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CancelSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_subscription_subscription_proto protoreflect.FileDescriptor
+
+const file_subscription_subscription_proto_rawDesc = "" +
+	"\n" +
+	"\x1fsubscription/subscription.proto\x12\x13rpc.subscription.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16google/type/date.proto\x1a\x17google/type/money.proto\x1a\x19payment/payment_api.proto\"\xe7\x05\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12(\n" +
+	"\x05price\x18\x03 \x01(\v2\x12.google.type.MoneyR\x05price\x12 \n" +
+	"\ftax_rate_bps\x18\x04 \x01(\x05R\n" +
+	"taxRateBps\x12@\n" +
+	"\binterval\x18\x05 \x01(\x0e2$.rpc.subscription.v1.BillingIntervalR\binterval\x12\x1d\n" +
+	"\n" +
+	"anchor_day\x18\x06 \x01(\x05R\tanchorDay\x120\n" +
+	"\n" +
+	"start_date\x18\a \x01(\v2\x11.google.type.DateR\tstartDate\x12\x1d\n" +
+	"\n" +
+	"card_token\x18\b \x01(\tR\tcardToken\x12$\n" +
+	"\x0edays_until_due\x18\t \x01(\x05R\fdaysUntilDue\x12?\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2'.rpc.subscription.v1.SubscriptionStatusR\x06status\x12=\n" +
+	"\x11next_billing_date\x18\v \x01(\v2\x11.google.type.DateR\x0fnextBillingDate\x12*\n" +
+	"\x11latest_invoice_id\x18\f \x01(\tR\x0flatestInvoiceId\x12;\n" +
+	"\vcreate_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12;\n" +
+	"\vcancel_time\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"cancelTime\x12,\n" +
+	"\x04card\x18\x10 \x01(\x0e2\x18.rpc.payment.v1.CardTypeR\x04card\"b\n" +
+	"\x19CreateSubscriptionRequest\x12E\n" +
+	"\fsubscription\x18\x01 \x01(\v2!.rpc.subscription.v1.SubscriptionR\fsubscription\"(\n" +
+	"\x16GetSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x97\x01\n" +
+	"\x18ListSubscriptionsRequest\x12?\n" +
+	"\x06status\x18\x01 \x01(\x0e2'.rpc.subscription.v1.SubscriptionStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x8c\x01\n" +
+	"\x19ListSubscriptionsResponse\x12G\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2!.rpc.subscription.v1.SubscriptionR\rsubscriptions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"*\n" +
+	"\x18PauseSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"+\n" +
+	"\x19ResumeSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"+\n" +
+	"\x19CancelSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id*\x8b\x01\n" +
+	"\x0fBillingInterval\x12 \n" +
+	"\x1cBILLING_INTERVAL_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17BILLING_INTERVAL_WEEKLY\x10\x01\x12\x1c\n" +
+	"\x18BILLING_INTERVAL_MONTHLY\x10\x02\x12\x1b\n" +
+	"\x17BILLING_INTERVAL_YEARLY\x10\x03*\x9c\x01\n" +
+	"\x12SubscriptionStatus\x12#\n" +
+	"\x1fSUBSCRIPTION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aSUBSCRIPTION_STATUS_ACTIVE\x10\x01\x12\x1e\n" +
+	"\x1aSUBSCRIPTION_STATUS_PAUSED\x10\x02\x12!\n" +
+	"\x1dSUBSCRIPTION_STATUS_CANCELLED\x10\x032\xf5\x06\n" +
+	"\x13SubscriptionService\x12\x90\x01\n" +
+	"\x12CreateSubscription\x12..rpc.subscription.v1.CreateSubscriptionRequest\x1a!.rpc.subscription.v1.Subscription\"'\x82\xd3\xe4\x93\x02!:\fsubscription\"\x11/v1/subscriptions\x12\x81\x01\n" +
+	"\x0fGetSubscription\x12+.rpc.subscription.v1.GetSubscriptionRequest\x1a!.rpc.subscription.v1.Subscription\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/subscriptions/{id}\x12\x8d\x01\n" +
+	"\x11ListSubscriptions\x12-.rpc.subscription.v1.ListSubscriptionsRequest\x1a..rpc.subscription.v1.ListSubscriptionsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/subscriptions\x12\x8e\x01\n" +
+	"\x11PauseSubscription\x12-.rpc.subscription.v1.PauseSubscriptionRequest\x1a!.rpc.subscription.v1.Subscription\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/subscriptions/{id}:pause\x12\x91\x01\n" +
+	"\x12ResumeSubscription\x12..rpc.subscription.v1.ResumeSubscriptionRequest\x1a!.rpc.subscription.v1.Subscription\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/subscriptions/{id}:resume\x12\x91\x01\n" +
+	"\x12CancelSubscription\x12..rpc.subscription.v1.CancelSubscriptionRequest\x1a!.rpc.subscription.v1.Subscription\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/subscriptions/{id}:cancelB\xde\x01\n" +
+	"\x17com.rpc.subscription.v1B\x11SubscriptionProtoP\x01ZBgithub.com/grpc-buf/internal/gen/proto/subscription;subscriptionv1\xa2\x02\x03RSX\xaa\x02\x13Rpc.Subscription.V1\xca\x02\x13Rpc\\Subscription\\V1\xe2\x02\x1fRpc\\Subscription\\V1\\GPBMetadata\xea\x02\x15Rpc::Subscription::V1b\x06proto3"
+
+var (
+	file_subscription_subscription_proto_rawDescOnce sync.Once
+	file_subscription_subscription_proto_rawDescData []byte
+)
+
+func file_subscription_subscription_proto_rawDescGZIP() []byte {
+	file_subscription_subscription_proto_rawDescOnce.Do(func() {
+		file_subscription_subscription_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_subscription_subscription_proto_rawDesc), len(file_subscription_subscription_proto_rawDesc)))
+	})
+	return file_subscription_subscription_proto_rawDescData
+}
+
+var file_subscription_subscription_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_subscription_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_subscription_subscription_proto_goTypes = []any{
+	(BillingInterval)(0),              // 0: rpc.subscription.v1.BillingInterval
+	(SubscriptionStatus)(0),           // 1: rpc.subscription.v1.SubscriptionStatus
+	(*Subscription)(nil),              // 2: rpc.subscription.v1.Subscription
+	(*CreateSubscriptionRequest)(nil), // 3: rpc.subscription.v1.CreateSubscriptionRequest
+	(*GetSubscriptionRequest)(nil),    // 4: rpc.subscription.v1.GetSubscriptionRequest
+	(*ListSubscriptionsRequest)(nil),  // 5: rpc.subscription.v1.ListSubscriptionsRequest
+	(*ListSubscriptionsResponse)(nil), // 6: rpc.subscription.v1.ListSubscriptionsResponse
+	(*PauseSubscriptionRequest)(nil),  // 7: rpc.subscription.v1.PauseSubscriptionRequest
+	(*ResumeSubscriptionRequest)(nil), // 8: rpc.subscription.v1.ResumeSubscriptionRequest
+	(*CancelSubscriptionRequest)(nil), // 9: rpc.subscription.v1.CancelSubscriptionRequest
+	(*money.Money)(nil),               // 10: google.type.Money
+	(*date.Date)(nil),                 // 11: google.type.Date
+	(*timestamppb.Timestamp)(nil),     // 12: google.protobuf.Timestamp
+	(payment.CardType)(0),             // 13: rpc.payment.v1.CardType
+}
+var file_subscription_subscription_proto_depIdxs = []int32{
+	10, // 0: rpc.subscription.v1.Subscription.price:type_name -> google.type.Money
+	0,  // 1: rpc.subscription.v1.Subscription.interval:type_name -> rpc.subscription.v1.BillingInterval
+	11, // 2: rpc.subscription.v1.Subscription.start_date:type_name -> google.type.Date
+	1,  // 3: rpc.subscription.v1.Subscription.status:type_name -> rpc.subscription.v1.SubscriptionStatus
+	11, // 4: rpc.subscription.v1.Subscription.next_billing_date:type_name -> google.type.Date
+	12, // 5: rpc.subscription.v1.Subscription.create_time:type_name -> google.protobuf.Timestamp
+	12, // 6: rpc.subscription.v1.Subscription.update_time:type_name -> google.protobuf.Timestamp
+	12, // 7: rpc.subscription.v1.Subscription.cancel_time:type_name -> google.protobuf.Timestamp
+	13, // 8: rpc.subscription.v1.Subscription.card:type_name -> rpc.payment.v1.CardType
+	2,  // 9: rpc.subscription.v1.CreateSubscriptionRequest.subscription:type_name -> rpc.subscription.v1.Subscription
+	1,  // 10: rpc.subscription.v1.ListSubscriptionsRequest.status:type_name -> rpc.subscription.v1.SubscriptionStatus
+	2,  // 11: rpc.subscription.v1.ListSubscriptionsResponse.subscriptions:type_name -> rpc.subscription.v1.Subscription
+	3,  // 12: rpc.subscription.v1.SubscriptionService.CreateSubscription:input_type -> rpc.subscription.v1.CreateSubscriptionRequest
+	4,  // 13: rpc.subscription.v1.SubscriptionService.GetSubscription:input_type -> rpc.subscription.v1.GetSubscriptionRequest
+	5,  // 14: rpc.subscription.v1.SubscriptionService.ListSubscriptions:input_type -> rpc.subscription.v1.ListSubscriptionsRequest
+	7,  // 15: rpc.subscription.v1.SubscriptionService.PauseSubscription:input_type -> rpc.subscription.v1.PauseSubscriptionRequest
+	8,  // 16: rpc.subscription.v1.SubscriptionService.ResumeSubscription:input_type -> rpc.subscription.v1.ResumeSubscriptionRequest
+	9,  // 17: rpc.subscription.v1.SubscriptionService.CancelSubscription:input_type -> rpc.subscription.v1.CancelSubscriptionRequest
+	2,  // 18: rpc.subscription.v1.SubscriptionService.CreateSubscription:output_type -> rpc.subscription.v1.Subscription
+	2,  // 19: rpc.subscription.v1.SubscriptionService.GetSubscription:output_type -> rpc.subscription.v1.Subscription
+	6,  // 20: rpc.subscription.v1.SubscriptionService.ListSubscriptions:output_type -> rpc.subscription.v1.ListSubscriptionsResponse
+	2,  // 21: rpc.subscription.v1.SubscriptionService.PauseSubscription:output_type -> rpc.subscription.v1.Subscription
+	2,  // 22: rpc.subscription.v1.SubscriptionService.ResumeSubscription:output_type -> rpc.subscription.v1.Subscription
+	2,  // 23: rpc.subscription.v1.SubscriptionService.CancelSubscription:output_type -> rpc.subscription.v1.Subscription
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_subscription_subscription_proto_init() }
+func file_subscription_subscription_proto_init() {
+	if File_subscription_subscription_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_subscription_subscription_proto_rawDesc), len(file_subscription_subscription_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_subscription_subscription_proto_goTypes,
+		DependencyIndexes: file_subscription_subscription_proto_depIdxs,
+		EnumInfos:         file_subscription_subscription_proto_enumTypes,
+		MessageInfos:      file_subscription_subscription_proto_msgTypes,
+	}.Build()
+	File_subscription_subscription_proto = out.File
+	file_subscription_subscription_proto_goTypes = nil
+	file_subscription_subscription_proto_depIdxs = nil
+}
@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: subscription/subscription.proto
+
+package subscriptionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	subscription "github.com/grpc-buf/internal/gen/proto/subscription"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SubscriptionServiceName is the fully-qualified name of the SubscriptionService service.
+	SubscriptionServiceName = "rpc.subscription.v1.SubscriptionService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SubscriptionServiceCreateSubscriptionProcedure is the fully-qualified name of the
+	// SubscriptionService's CreateSubscription RPC.
+	SubscriptionServiceCreateSubscriptionProcedure = "/rpc.subscription.v1.SubscriptionService/CreateSubscription"
+	// SubscriptionServiceGetSubscriptionProcedure is the fully-qualified name of the
+	// SubscriptionService's GetSubscription RPC.
+	SubscriptionServiceGetSubscriptionProcedure = "/rpc.subscription.v1.SubscriptionService/GetSubscription"
+	// SubscriptionServiceListSubscriptionsProcedure is the fully-qualified name of the
+	// SubscriptionService's ListSubscriptions RPC.
+	SubscriptionServiceListSubscriptionsProcedure = "/rpc.subscription.v1.SubscriptionService/ListSubscriptions"
+	// SubscriptionServicePauseSubscriptionProcedure is the fully-qualified name of the
+	// SubscriptionService's PauseSubscription RPC.
+	SubscriptionServicePauseSubscriptionProcedure = "/rpc.subscription.v1.SubscriptionService/PauseSubscription"
+	// SubscriptionServiceResumeSubscriptionProcedure is the fully-qualified name of the
+	// SubscriptionService's ResumeSubscription RPC.
+	SubscriptionServiceResumeSubscriptionProcedure = "/rpc.subscription.v1.SubscriptionService/ResumeSubscription"
+	// SubscriptionServiceCancelSubscriptionProcedure is the fully-qualified name of the
+	// SubscriptionService's CancelSubscription RPC.
+	SubscriptionServiceCancelSubscriptionProcedure = "/rpc.subscription.v1.SubscriptionService/CancelSubscription"
+)
+
+// SubscriptionServiceClient is a client for the rpc.subscription.v1.SubscriptionService service.
+type SubscriptionServiceClient interface {
+	// CreateSubscription starts an ACTIVE subscription. The first invoice is
+	// issued on the first anchor day on or after start_date.
+	CreateSubscription(context.Context, *connect.Request[subscription.CreateSubscriptionRequest]) (*connect.Response[subscription.Subscription], error)
+	// GetSubscription fetches a subscription by id.
+	GetSubscription(context.Context, *connect.Request[subscription.GetSubscriptionRequest]) (*connect.Response[subscription.Subscription], error)
+	// ListSubscriptions returns subscriptions newest first, optionally filtered
+	// by status.
+	ListSubscriptions(context.Context, *connect.Request[subscription.ListSubscriptionsRequest]) (*connect.Response[subscription.ListSubscriptionsResponse], error)
+	// PauseSubscription stops invoicing an ACTIVE subscription. Returns
+	// FAILED_PRECONDITION unless the subscription is ACTIVE.
+	PauseSubscription(context.Context, *connect.Request[subscription.PauseSubscriptionRequest]) (*connect.Response[subscription.Subscription], error)
+	// ResumeSubscription reactivates a PAUSED subscription. Periods that
+	// started while it was paused are not billed. Returns FAILED_PRECONDITION
+	// unless the subscription is PAUSED.
+	ResumeSubscription(context.Context, *connect.Request[subscription.ResumeSubscriptionRequest]) (*connect.Response[subscription.Subscription], error)
+	// CancelSubscription ends a subscription for good. Invoices already issued
+	// are kept. Returns FAILED_PRECONDITION if it is already cancelled.
+	CancelSubscription(context.Context, *connect.Request[subscription.CancelSubscriptionRequest]) (*connect.Response[subscription.Subscription], error)
+}
+
+// NewSubscriptionServiceClient constructs a client for the rpc.subscription.v1.SubscriptionService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSubscriptionServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SubscriptionServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	subscriptionServiceMethods := subscription.File_subscription_subscription_proto.Services().ByName("SubscriptionService").Methods()
+	return &subscriptionServiceClient{
+		createSubscription: connect.NewClient[subscription.CreateSubscriptionRequest, subscription.Subscription](
+			httpClient,
+			baseURL+SubscriptionServiceCreateSubscriptionProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("CreateSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		getSubscription: connect.NewClient[subscription.GetSubscriptionRequest, subscription.Subscription](
+			httpClient,
+			baseURL+SubscriptionServiceGetSubscriptionProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("GetSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		listSubscriptions: connect.NewClient[subscription.ListSubscriptionsRequest, subscription.ListSubscriptionsResponse](
+			httpClient,
+			baseURL+SubscriptionServiceListSubscriptionsProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("ListSubscriptions")),
+			connect.WithClientOptions(opts...),
+		),
+		pauseSubscription: connect.NewClient[subscription.PauseSubscriptionRequest, subscription.Subscription](
+			httpClient,
+			baseURL+SubscriptionServicePauseSubscriptionProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("PauseSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeSubscription: connect.NewClient[subscription.ResumeSubscriptionRequest, subscription.Subscription](
+			httpClient,
+			baseURL+SubscriptionServiceResumeSubscriptionProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("ResumeSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelSubscription: connect.NewClient[subscription.CancelSubscriptionRequest, subscription.Subscription](
+			httpClient,
+			baseURL+SubscriptionServiceCancelSubscriptionProcedure,
+			connect.WithSchema(subscriptionServiceMethods.ByName("CancelSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// subscriptionServiceClient implements SubscriptionServiceClient.
+type subscriptionServiceClient struct {
+	createSubscription *connect.Client[subscription.CreateSubscriptionRequest, subscription.Subscription]
+	getSubscription    *connect.Client[subscription.GetSubscriptionRequest, subscription.Subscription]
+	listSubscriptions  *connect.Client[subscription.ListSubscriptionsRequest, subscription.ListSubscriptionsResponse]
+	pauseSubscription  *connect.Client[subscription.PauseSubscriptionRequest, subscription.Subscription]
+	resumeSubscription *connect.Client[subscription.ResumeSubscriptionRequest, subscription.Subscription]
+	cancelSubscription *connect.Client[subscription.CancelSubscriptionRequest, subscription.Subscription]
+}
+
+// CreateSubscription calls rpc.subscription.v1.SubscriptionService.CreateSubscription.
+func (c *subscriptionServiceClient) CreateSubscription(ctx context.Context, req *connect.Request[subscription.CreateSubscriptionRequest]) (*connect.Response[subscription.Subscription], error) {
+	return c.createSubscription.CallUnary(ctx, req)
+}
+
+// GetSubscription calls rpc.subscription.v1.SubscriptionService.GetSubscription.
+func (c *subscriptionServiceClient) GetSubscription(ctx context.Context, req *connect.Request[subscription.GetSubscriptionRequest]) (*connect.Response[subscription.Subscription], error) {
+	return c.getSubscription.CallUnary(ctx, req)
+}
+
+// ListSubscriptions calls rpc.subscription.v1.SubscriptionService.ListSubscriptions.
+func (c *subscriptionServiceClient) ListSubscriptions(ctx context.Context, req *connect.Request[subscription.ListSubscriptionsRequest]) (*connect.Response[subscription.ListSubscriptionsResponse], error) {
+	return c.listSubscriptions.CallUnary(ctx, req)
+}
+
+// PauseSubscription calls rpc.subscription.v1.SubscriptionService.PauseSubscription.
+func (c *subscriptionServiceClient) PauseSubscription(ctx context.Context, req *connect.Request[subscription.PauseSubscriptionRequest]) (*connect.Response[subscription.Subscription], error) {
+	return c.pauseSubscription.CallUnary(ctx, req)
+}
+
+// ResumeSubscription calls rpc.subscription.v1.SubscriptionService.ResumeSubscription.
+func (c *subscriptionServiceClient) ResumeSubscription(ctx context.Context, req *connect.Request[subscription.ResumeSubscriptionRequest]) (*connect.Response[subscription.Subscription], error) {
+	return c.resumeSubscription.CallUnary(ctx, req)
+}
+
+// CancelSubscription calls rpc.subscription.v1.SubscriptionService.CancelSubscription.
+func (c *subscriptionServiceClient) CancelSubscription(ctx context.Context, req *connect.Request[subscription.CancelSubscriptionRequest]) (*connect.Response[subscription.Subscription], error) {
+	return c.cancelSubscription.CallUnary(ctx, req)
+}
+
+// SubscriptionServiceHandler is an implementation of the rpc.subscription.v1.SubscriptionService
+// service.
+type SubscriptionServiceHandler interface {
+	// CreateSubscription starts an ACTIVE subscription. The first invoice is
+	// issued on the first anchor day on or after start_date.
+	CreateSubscription(context.Context, *connect.Request[subscription.CreateSubscriptionRequest]) (*connect.Response[subscription.Subscription], error)
+	// GetSubscription fetches a subscription by id.
+	GetSubscription(context.Context, *connect.Request[subscription.GetSubscriptionRequest]) (*connect.Response[subscription.Subscription], error)
+	// ListSubscriptions returns subscriptions newest first, optionally filtered
+	// by status.
+	ListSubscriptions(context.Context, *connect.Request[subscription.ListSubscriptionsRequest]) (*connect.Response[subscription.ListSubscriptionsResponse], error)
+	// PauseSubscription stops invoicing an ACTIVE subscription. Returns
+	// FAILED_PRECONDITION unless the subscription is ACTIVE.
+	PauseSubscription(context.Context, *connect.Request[subscription.PauseSubscriptionRequest]) (*connect.Response[subscription.Subscription], error)
+	// ResumeSubscription reactivates a PAUSED subscription. Periods that
+	// started while it was paused are not billed. Returns FAILED_PRECONDITION
+	// unless the subscription is PAUSED.
+	ResumeSubscription(context.Context, *connect.Request[subscription.ResumeSubscriptionRequest]) (*connect.Response[subscription.Subscription], error)
+	// CancelSubscription ends a subscription for good. Invoices already issued
+	// are kept. Returns FAILED_PRECONDITION if it is already cancelled.
+	CancelSubscription(context.Context, *connect.Request[subscription.CancelSubscriptionRequest]) (*connect.Response[subscription.Subscription], error)
+}
+
+// NewSubscriptionServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSubscriptionServiceHandler(svc SubscriptionServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	subscriptionServiceMethods := subscription.File_subscription_subscription_proto.Services().ByName("SubscriptionService").Methods()
+	subscriptionServiceCreateSubscriptionHandler := connect.NewUnaryHandler(
+		SubscriptionServiceCreateSubscriptionProcedure,
+		svc.CreateSubscription,
+		connect.WithSchema(subscriptionServiceMethods.ByName("CreateSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceGetSubscriptionHandler := connect.NewUnaryHandler(
+		SubscriptionServiceGetSubscriptionProcedure,
+		svc.GetSubscription,
+		connect.WithSchema(subscriptionServiceMethods.ByName("GetSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceListSubscriptionsHandler := connect.NewUnaryHandler(
+		SubscriptionServiceListSubscriptionsProcedure,
+		svc.ListSubscriptions,
+		connect.WithSchema(subscriptionServiceMethods.ByName("ListSubscriptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServicePauseSubscriptionHandler := connect.NewUnaryHandler(
+		SubscriptionServicePauseSubscriptionProcedure,
+		svc.PauseSubscription,
+		connect.WithSchema(subscriptionServiceMethods.ByName("PauseSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceResumeSubscriptionHandler := connect.NewUnaryHandler(
+		SubscriptionServiceResumeSubscriptionProcedure,
+		svc.ResumeSubscription,
+		connect.WithSchema(subscriptionServiceMethods.ByName("ResumeSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	subscriptionServiceCancelSubscriptionHandler := connect.NewUnaryHandler(
+		SubscriptionServiceCancelSubscriptionProcedure,
+		svc.CancelSubscription,
+		connect.WithSchema(subscriptionServiceMethods.ByName("CancelSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/rpc.subscription.v1.SubscriptionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SubscriptionServiceCreateSubscriptionProcedure:
+			subscriptionServiceCreateSubscriptionHandler.ServeHTTP(w, r)
+		case SubscriptionServiceGetSubscriptionProcedure:
+			subscriptionServiceGetSubscriptionHandler.ServeHTTP(w, r)
+		case SubscriptionServiceListSubscriptionsProcedure:
+			subscriptionServiceListSubscriptionsHandler.ServeHTTP(w, r)
+		case SubscriptionServicePauseSubscriptionProcedure:
+			subscriptionServicePauseSubscriptionHandler.ServeHTTP(w, r)
+		case SubscriptionServiceResumeSubscriptionProcedure:
+			subscriptionServiceResumeSubscriptionHandler.ServeHTTP(w, r)
+		case SubscriptionServiceCancelSubscriptionProcedure:
+			subscriptionServiceCancelSubscriptionHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSubscriptionServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSubscriptionServiceHandler struct{}
+
+func (UnimplementedSubscriptionServiceHandler) CreateSubscription(context.Context, *connect.Request[subscription.CreateSubscriptionRequest]) (*connect.Response[subscription.Subscription], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.subscription.v1.SubscriptionService.CreateSubscription is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) GetSubscription(context.Context, *connect.Request[subscription.GetSubscriptionRequest]) (*connect.Response[subscription.Subscription], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.subscription.v1.SubscriptionService.GetSubscription is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) ListSubscriptions(context.Context, *connect.Request[subscription.ListSubscriptionsRequest]) (*connect.Response[subscription.ListSubscriptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.subscription.v1.SubscriptionService.ListSubscriptions is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) PauseSubscription(context.Context, *connect.Request[subscription.PauseSubscriptionRequest]) (*connect.Response[subscription.Subscription], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.subscription.v1.SubscriptionService.PauseSubscription is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) ResumeSubscription(context.Context, *connect.Request[subscription.ResumeSubscriptionRequest]) (*connect.Response[subscription.Subscription], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.subscription.v1.SubscriptionService.ResumeSubscription is not implemented"))
+}
+
+func (UnimplementedSubscriptionServiceHandler) CancelSubscription(context.Context, *connect.Request[subscription.CancelSubscriptionRequest]) (*connect.Response[subscription.Subscription], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.subscription.v1.SubscriptionService.CancelSubscription is not implemented"))
+}
@@ -0,0 +1,263 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: tokenization/tokenization.proto
+
+package tokenizationv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TokenizeCardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Primary account number, 12-19 digits. Spaces and dashes are
+	// ignored.
+	Pan string `protobuf:"bytes,1,opt,name=pan,proto3" json:"pan,omitempty"`
+	// Required. Expiry month, 1-12.
+	ExpMonth int32 `protobuf:"varint,2,opt,name=exp_month,json=expMonth,proto3" json:"exp_month,omitempty"`
+	// Required. Four-digit expiry year.
+	ExpYear int32 `protobuf:"varint,3,opt,name=exp_year,json=expYear,proto3" json:"exp_year,omitempty"`
+	// Required. Card verification code, 3 or 4 digits. Never stored.
+	Cvc           string `protobuf:"bytes,4,opt,name=cvc,proto3" json:"cvc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenizeCardRequest) Reset() {
+	*x = TokenizeCardRequest{}
+	mi := &file_tokenization_tokenization_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenizeCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenizeCardRequest) ProtoMessage() {}
+
+func (x *TokenizeCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tokenization_tokenization_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenizeCardRequest.ProtoReflect.Descriptor instead.
+func (*TokenizeCardRequest) Descriptor() ([]byte, []int) {
+	return file_tokenization_tokenization_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TokenizeCardRequest) GetPan() string {
+	if x != nil {
+		return x.Pan
+	}
+	return ""
+}
+
+func (x *TokenizeCardRequest) GetExpMonth() int32 {
+	if x != nil {
+		return x.ExpMonth
+	}
+	return 0
+}
+
+func (x *TokenizeCardRequest) GetExpYear() int32 {
+	if x != nil {
+		return x.ExpYear
+	}
+	return 0
+}
+
+func (x *TokenizeCardRequest) GetCvc() string {
+	if x != nil {
+		return x.Cvc
+	}
+	return ""
+}
+
+// CardToken is a card held in the vault. It never carries the card number.
+type CardToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Opaque token, prefixed "tok_", to pass as card_token.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Last four digits of the card number.
+	Last4    string `protobuf:"bytes,2,opt,name=last4,proto3" json:"last4,omitempty"`
+	ExpMonth int32  `protobuf:"varint,3,opt,name=exp_month,json=expMonth,proto3" json:"exp_month,omitempty"`
+	ExpYear  int32  `protobuf:"varint,4,opt,name=exp_year,json=expYear,proto3" json:"exp_year,omitempty"`
+	// End of the expiry month (UTC). The token is rejected from then on.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// Creation timestamp (AIP-142).
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardToken) Reset() {
+	*x = CardToken{}
+	mi := &file_tokenization_tokenization_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardToken) ProtoMessage() {}
+
+func (x *CardToken) ProtoReflect() protoreflect.Message {
+	mi := &file_tokenization_tokenization_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardToken.ProtoReflect.Descriptor instead.
+func (*CardToken) Descriptor() ([]byte, []int) {
+	return file_tokenization_tokenization_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CardToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CardToken) GetLast4() string {
+	if x != nil {
+		return x.Last4
+	}
+	return ""
+}
+
+func (x *CardToken) GetExpMonth() int32 {
+	if x != nil {
+		return x.ExpMonth
+	}
+	return 0
+}
+
+func (x *CardToken) GetExpYear() int32 {
+	if x != nil {
+		return x.ExpYear
+	}
+	return 0
+}
+
+func (x *CardToken) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *CardToken) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+var File_tokenization_tokenization_proto protoreflect.FileDescriptor
+
+const file_tokenization_tokenization_proto_rawDesc = "" +
+	"\n" +
+	"\x1ftokenization/tokenization.proto\x12\x13rpc.tokenization.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"q\n" +
+	"\x13TokenizeCardRequest\x12\x10\n" +
+	"\x03pan\x18\x01 \x01(\tR\x03pan\x12\x1b\n" +
+	"\texp_month\x18\x02 \x01(\x05R\bexpMonth\x12\x19\n" +
+	"\bexp_year\x18\x03 \x01(\x05R\aexpYear\x12\x10\n" +
+	"\x03cvc\x18\x04 \x01(\tR\x03cvc\"\xe9\x01\n" +
+	"\tCardToken\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x14\n" +
+	"\x05last4\x18\x02 \x01(\tR\x05last4\x12\x1b\n" +
+	"\texp_month\x18\x03 \x01(\x05R\bexpMonth\x12\x19\n" +
+	"\bexp_year\x18\x04 \x01(\x05R\aexpYear\x12;\n" +
+	"\vexpire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12;\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime2\x8b\x01\n" +
+	"\x13TokenizationService\x12t\n" +
+	"\fTokenizeCard\x12(.rpc.tokenization.v1.TokenizeCardRequest\x1a\x1e.rpc.tokenization.v1.CardToken\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/card-tokensB\xde\x01\n" +
+	"\x17com.rpc.tokenization.v1B\x11TokenizationProtoP\x01ZBgithub.com/grpc-buf/internal/gen/proto/tokenization;tokenizationv1\xa2\x02\x03RTX\xaa\x02\x13Rpc.Tokenization.V1\xca\x02\x13Rpc\\Tokenization\\V1\xe2\x02\x1fRpc\\Tokenization\\V1\\GPBMetadata\xea\x02\x15Rpc::Tokenization::V1b\x06proto3"
+
+var (
+	file_tokenization_tokenization_proto_rawDescOnce sync.Once
+	file_tokenization_tokenization_proto_rawDescData []byte
+)
+
+func file_tokenization_tokenization_proto_rawDescGZIP() []byte {
+	file_tokenization_tokenization_proto_rawDescOnce.Do(func() {
+		file_tokenization_tokenization_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tokenization_tokenization_proto_rawDesc), len(file_tokenization_tokenization_proto_rawDesc)))
+	})
+	return file_tokenization_tokenization_proto_rawDescData
+}
+
+var file_tokenization_tokenization_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_tokenization_tokenization_proto_goTypes = []any{
+	(*TokenizeCardRequest)(nil),   // 0: rpc.tokenization.v1.TokenizeCardRequest
+	(*CardToken)(nil),             // 1: rpc.tokenization.v1.CardToken
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_tokenization_tokenization_proto_depIdxs = []int32{
+	2, // 0: rpc.tokenization.v1.CardToken.expire_time:type_name -> google.protobuf.Timestamp
+	2, // 1: rpc.tokenization.v1.CardToken.create_time:type_name -> google.protobuf.Timestamp
+	0, // 2: rpc.tokenization.v1.TokenizationService.TokenizeCard:input_type -> rpc.tokenization.v1.TokenizeCardRequest
+	1, // 3: rpc.tokenization.v1.TokenizationService.TokenizeCard:output_type -> rpc.tokenization.v1.CardToken
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_tokenization_tokenization_proto_init() }
+func file_tokenization_tokenization_proto_init() {
+	if File_tokenization_tokenization_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tokenization_tokenization_proto_rawDesc), len(file_tokenization_tokenization_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tokenization_tokenization_proto_goTypes,
+		DependencyIndexes: file_tokenization_tokenization_proto_depIdxs,
+		MessageInfos:      file_tokenization_tokenization_proto_msgTypes,
+	}.Build()
+	File_tokenization_tokenization_proto = out.File
+	file_tokenization_tokenization_proto_goTypes = nil
+	file_tokenization_tokenization_proto_depIdxs = nil
+}
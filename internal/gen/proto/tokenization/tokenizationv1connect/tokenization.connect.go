@@ -0,0 +1,118 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: tokenization/tokenization.proto
+
+package tokenizationv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	tokenization "github.com/grpc-buf/internal/gen/proto/tokenization"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TokenizationServiceName is the fully-qualified name of the TokenizationService service.
+	TokenizationServiceName = "rpc.tokenization.v1.TokenizationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TokenizationServiceTokenizeCardProcedure is the fully-qualified name of the TokenizationService's
+	// TokenizeCard RPC.
+	TokenizationServiceTokenizeCardProcedure = "/rpc.tokenization.v1.TokenizationService/TokenizeCard"
+)
+
+// TokenizationServiceClient is a client for the rpc.tokenization.v1.TokenizationService service.
+type TokenizationServiceClient interface {
+	// TokenizeCard checks the card number (Luhn) and expiry, stores the card
+	// encrypted and returns a new token for it. The CVC is checked for format
+	// only and is never stored. Returns INVALID_ARGUMENT for malformed or
+	// expired cards and FAILED_PRECONDITION when no vault key is configured.
+	TokenizeCard(context.Context, *connect.Request[tokenization.TokenizeCardRequest]) (*connect.Response[tokenization.CardToken], error)
+}
+
+// NewTokenizationServiceClient constructs a client for the rpc.tokenization.v1.TokenizationService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTokenizationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TokenizationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	tokenizationServiceMethods := tokenization.File_tokenization_tokenization_proto.Services().ByName("TokenizationService").Methods()
+	return &tokenizationServiceClient{
+		tokenizeCard: connect.NewClient[tokenization.TokenizeCardRequest, tokenization.CardToken](
+			httpClient,
+			baseURL+TokenizationServiceTokenizeCardProcedure,
+			connect.WithSchema(tokenizationServiceMethods.ByName("TokenizeCard")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// tokenizationServiceClient implements TokenizationServiceClient.
+type tokenizationServiceClient struct {
+	tokenizeCard *connect.Client[tokenization.TokenizeCardRequest, tokenization.CardToken]
+}
+
+// TokenizeCard calls rpc.tokenization.v1.TokenizationService.TokenizeCard.
+func (c *tokenizationServiceClient) TokenizeCard(ctx context.Context, req *connect.Request[tokenization.TokenizeCardRequest]) (*connect.Response[tokenization.CardToken], error) {
+	return c.tokenizeCard.CallUnary(ctx, req)
+}
+
+// TokenizationServiceHandler is an implementation of the rpc.tokenization.v1.TokenizationService
+// service.
+type TokenizationServiceHandler interface {
+	// TokenizeCard checks the card number (Luhn) and expiry, stores the card
+	// encrypted and returns a new token for it. The CVC is checked for format
+	// only and is never stored. Returns INVALID_ARGUMENT for malformed or
+	// expired cards and FAILED_PRECONDITION when no vault key is configured.
+	TokenizeCard(context.Context, *connect.Request[tokenization.TokenizeCardRequest]) (*connect.Response[tokenization.CardToken], error)
+}
+
+// NewTokenizationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTokenizationServiceHandler(svc TokenizationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	tokenizationServiceMethods := tokenization.File_tokenization_tokenization_proto.Services().ByName("TokenizationService").Methods()
+	tokenizationServiceTokenizeCardHandler := connect.NewUnaryHandler(
+		TokenizationServiceTokenizeCardProcedure,
+		svc.TokenizeCard,
+		connect.WithSchema(tokenizationServiceMethods.ByName("TokenizeCard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/rpc.tokenization.v1.TokenizationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TokenizationServiceTokenizeCardProcedure:
+			tokenizationServiceTokenizeCardHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTokenizationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTokenizationServiceHandler struct{}
+
+func (UnimplementedTokenizationServiceHandler) TokenizeCard(context.Context, *connect.Request[tokenization.TokenizeCardRequest]) (*connect.Response[tokenization.CardToken], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.tokenization.v1.TokenizationService.TokenizeCard is not implemented"))
+}
@@ -0,0 +1,246 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: webhook/webhook.proto
+
+package webhookv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	webhook "github.com/grpc-buf/internal/gen/proto/webhook"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WebhookServiceName is the fully-qualified name of the WebhookService service.
+	WebhookServiceName = "rpc.webhook.v1.WebhookService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WebhookServiceCreateWebhookEndpointProcedure is the fully-qualified name of the WebhookService's
+	// CreateWebhookEndpoint RPC.
+	WebhookServiceCreateWebhookEndpointProcedure = "/rpc.webhook.v1.WebhookService/CreateWebhookEndpoint"
+	// WebhookServiceListWebhookEndpointsProcedure is the fully-qualified name of the WebhookService's
+	// ListWebhookEndpoints RPC.
+	WebhookServiceListWebhookEndpointsProcedure = "/rpc.webhook.v1.WebhookService/ListWebhookEndpoints"
+	// WebhookServiceDeleteWebhookEndpointProcedure is the fully-qualified name of the WebhookService's
+	// DeleteWebhookEndpoint RPC.
+	WebhookServiceDeleteWebhookEndpointProcedure = "/rpc.webhook.v1.WebhookService/DeleteWebhookEndpoint"
+	// WebhookServiceListWebhookDeliveriesProcedure is the fully-qualified name of the WebhookService's
+	// ListWebhookDeliveries RPC.
+	WebhookServiceListWebhookDeliveriesProcedure = "/rpc.webhook.v1.WebhookService/ListWebhookDeliveries"
+	// WebhookServiceReplayWebhookDeliveryProcedure is the fully-qualified name of the WebhookService's
+	// ReplayWebhookDelivery RPC.
+	WebhookServiceReplayWebhookDeliveryProcedure = "/rpc.webhook.v1.WebhookService/ReplayWebhookDelivery"
+)
+
+// WebhookServiceClient is a client for the rpc.webhook.v1.WebhookService service.
+type WebhookServiceClient interface {
+	// CreateWebhookEndpoint registers url for event_types. The signing secret
+	// is generated by the server and returned only in this response.
+	CreateWebhookEndpoint(context.Context, *connect.Request[webhook.CreateWebhookEndpointRequest]) (*connect.Response[webhook.WebhookEndpoint], error)
+	// ListWebhookEndpoints returns registered endpoints, newest first.
+	ListWebhookEndpoints(context.Context, *connect.Request[webhook.ListWebhookEndpointsRequest]) (*connect.Response[webhook.ListWebhookEndpointsResponse], error)
+	// DeleteWebhookEndpoint stops deliveries to an endpoint. Pending
+	// deliveries to it are abandoned.
+	DeleteWebhookEndpoint(context.Context, *connect.Request[webhook.DeleteWebhookEndpointRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListWebhookDeliveries returns deliveries newest first, optionally
+	// filtered by endpoint and status.
+	ListWebhookDeliveries(context.Context, *connect.Request[webhook.ListWebhookDeliveriesRequest]) (*connect.Response[webhook.ListWebhookDeliveriesResponse], error)
+	// ReplayWebhookDelivery schedules a finished delivery to be sent again
+	// immediately with a fresh retry budget. Returns FAILED_PRECONDITION when
+	// the delivery is still pending or its endpoint was deleted.
+	ReplayWebhookDelivery(context.Context, *connect.Request[webhook.ReplayWebhookDeliveryRequest]) (*connect.Response[webhook.WebhookDelivery], error)
+}
+
+// NewWebhookServiceClient constructs a client for the rpc.webhook.v1.WebhookService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWebhookServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WebhookServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	webhookServiceMethods := webhook.File_webhook_webhook_proto.Services().ByName("WebhookService").Methods()
+	return &webhookServiceClient{
+		createWebhookEndpoint: connect.NewClient[webhook.CreateWebhookEndpointRequest, webhook.WebhookEndpoint](
+			httpClient,
+			baseURL+WebhookServiceCreateWebhookEndpointProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("CreateWebhookEndpoint")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookEndpoints: connect.NewClient[webhook.ListWebhookEndpointsRequest, webhook.ListWebhookEndpointsResponse](
+			httpClient,
+			baseURL+WebhookServiceListWebhookEndpointsProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListWebhookEndpoints")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhookEndpoint: connect.NewClient[webhook.DeleteWebhookEndpointRequest, emptypb.Empty](
+			httpClient,
+			baseURL+WebhookServiceDeleteWebhookEndpointProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("DeleteWebhookEndpoint")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookDeliveries: connect.NewClient[webhook.ListWebhookDeliveriesRequest, webhook.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+WebhookServiceListWebhookDeliveriesProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		replayWebhookDelivery: connect.NewClient[webhook.ReplayWebhookDeliveryRequest, webhook.WebhookDelivery](
+			httpClient,
+			baseURL+WebhookServiceReplayWebhookDeliveryProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ReplayWebhookDelivery")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// webhookServiceClient implements WebhookServiceClient.
+type webhookServiceClient struct {
+	createWebhookEndpoint *connect.Client[webhook.CreateWebhookEndpointRequest, webhook.WebhookEndpoint]
+	listWebhookEndpoints  *connect.Client[webhook.ListWebhookEndpointsRequest, webhook.ListWebhookEndpointsResponse]
+	deleteWebhookEndpoint *connect.Client[webhook.DeleteWebhookEndpointRequest, emptypb.Empty]
+	listWebhookDeliveries *connect.Client[webhook.ListWebhookDeliveriesRequest, webhook.ListWebhookDeliveriesResponse]
+	replayWebhookDelivery *connect.Client[webhook.ReplayWebhookDeliveryRequest, webhook.WebhookDelivery]
+}
+
+// CreateWebhookEndpoint calls rpc.webhook.v1.WebhookService.CreateWebhookEndpoint.
+func (c *webhookServiceClient) CreateWebhookEndpoint(ctx context.Context, req *connect.Request[webhook.CreateWebhookEndpointRequest]) (*connect.Response[webhook.WebhookEndpoint], error) {
+	return c.createWebhookEndpoint.CallUnary(ctx, req)
+}
+
+// ListWebhookEndpoints calls rpc.webhook.v1.WebhookService.ListWebhookEndpoints.
+func (c *webhookServiceClient) ListWebhookEndpoints(ctx context.Context, req *connect.Request[webhook.ListWebhookEndpointsRequest]) (*connect.Response[webhook.ListWebhookEndpointsResponse], error) {
+	return c.listWebhookEndpoints.CallUnary(ctx, req)
+}
+
+// DeleteWebhookEndpoint calls rpc.webhook.v1.WebhookService.DeleteWebhookEndpoint.
+func (c *webhookServiceClient) DeleteWebhookEndpoint(ctx context.Context, req *connect.Request[webhook.DeleteWebhookEndpointRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteWebhookEndpoint.CallUnary(ctx, req)
+}
+
+// ListWebhookDeliveries calls rpc.webhook.v1.WebhookService.ListWebhookDeliveries.
+func (c *webhookServiceClient) ListWebhookDeliveries(ctx context.Context, req *connect.Request[webhook.ListWebhookDeliveriesRequest]) (*connect.Response[webhook.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// ReplayWebhookDelivery calls rpc.webhook.v1.WebhookService.ReplayWebhookDelivery.
+func (c *webhookServiceClient) ReplayWebhookDelivery(ctx context.Context, req *connect.Request[webhook.ReplayWebhookDeliveryRequest]) (*connect.Response[webhook.WebhookDelivery], error) {
+	return c.replayWebhookDelivery.CallUnary(ctx, req)
+}
+
+// WebhookServiceHandler is an implementation of the rpc.webhook.v1.WebhookService service.
+type WebhookServiceHandler interface {
+	// CreateWebhookEndpoint registers url for event_types. The signing secret
+	// is generated by the server and returned only in this response.
+	CreateWebhookEndpoint(context.Context, *connect.Request[webhook.CreateWebhookEndpointRequest]) (*connect.Response[webhook.WebhookEndpoint], error)
+	// ListWebhookEndpoints returns registered endpoints, newest first.
+	ListWebhookEndpoints(context.Context, *connect.Request[webhook.ListWebhookEndpointsRequest]) (*connect.Response[webhook.ListWebhookEndpointsResponse], error)
+	// DeleteWebhookEndpoint stops deliveries to an endpoint. Pending
+	// deliveries to it are abandoned.
+	DeleteWebhookEndpoint(context.Context, *connect.Request[webhook.DeleteWebhookEndpointRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListWebhookDeliveries returns deliveries newest first, optionally
+	// filtered by endpoint and status.
+	ListWebhookDeliveries(context.Context, *connect.Request[webhook.ListWebhookDeliveriesRequest]) (*connect.Response[webhook.ListWebhookDeliveriesResponse], error)
+	// ReplayWebhookDelivery schedules a finished delivery to be sent again
+	// immediately with a fresh retry budget. Returns FAILED_PRECONDITION when
+	// the delivery is still pending or its endpoint was deleted.
+	ReplayWebhookDelivery(context.Context, *connect.Request[webhook.ReplayWebhookDeliveryRequest]) (*connect.Response[webhook.WebhookDelivery], error)
+}
+
+// NewWebhookServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWebhookServiceHandler(svc WebhookServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	webhookServiceMethods := webhook.File_webhook_webhook_proto.Services().ByName("WebhookService").Methods()
+	webhookServiceCreateWebhookEndpointHandler := connect.NewUnaryHandler(
+		WebhookServiceCreateWebhookEndpointProcedure,
+		svc.CreateWebhookEndpoint,
+		connect.WithSchema(webhookServiceMethods.ByName("CreateWebhookEndpoint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListWebhookEndpointsHandler := connect.NewUnaryHandler(
+		WebhookServiceListWebhookEndpointsProcedure,
+		svc.ListWebhookEndpoints,
+		connect.WithSchema(webhookServiceMethods.ByName("ListWebhookEndpoints")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceDeleteWebhookEndpointHandler := connect.NewUnaryHandler(
+		WebhookServiceDeleteWebhookEndpointProcedure,
+		svc.DeleteWebhookEndpoint,
+		connect.WithSchema(webhookServiceMethods.ByName("DeleteWebhookEndpoint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		WebhookServiceListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		connect.WithSchema(webhookServiceMethods.ByName("ListWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceReplayWebhookDeliveryHandler := connect.NewUnaryHandler(
+		WebhookServiceReplayWebhookDeliveryProcedure,
+		svc.ReplayWebhookDelivery,
+		connect.WithSchema(webhookServiceMethods.ByName("ReplayWebhookDelivery")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/rpc.webhook.v1.WebhookService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WebhookServiceCreateWebhookEndpointProcedure:
+			webhookServiceCreateWebhookEndpointHandler.ServeHTTP(w, r)
+		case WebhookServiceListWebhookEndpointsProcedure:
+			webhookServiceListWebhookEndpointsHandler.ServeHTTP(w, r)
+		case WebhookServiceDeleteWebhookEndpointProcedure:
+			webhookServiceDeleteWebhookEndpointHandler.ServeHTTP(w, r)
+		case WebhookServiceListWebhookDeliveriesProcedure:
+			webhookServiceListWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case WebhookServiceReplayWebhookDeliveryProcedure:
+			webhookServiceReplayWebhookDeliveryHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWebhookServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWebhookServiceHandler struct{}
+
+func (UnimplementedWebhookServiceHandler) CreateWebhookEndpoint(context.Context, *connect.Request[webhook.CreateWebhookEndpointRequest]) (*connect.Response[webhook.WebhookEndpoint], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.webhook.v1.WebhookService.CreateWebhookEndpoint is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListWebhookEndpoints(context.Context, *connect.Request[webhook.ListWebhookEndpointsRequest]) (*connect.Response[webhook.ListWebhookEndpointsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.webhook.v1.WebhookService.ListWebhookEndpoints is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) DeleteWebhookEndpoint(context.Context, *connect.Request[webhook.DeleteWebhookEndpointRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.webhook.v1.WebhookService.DeleteWebhookEndpoint is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListWebhookDeliveries(context.Context, *connect.Request[webhook.ListWebhookDeliveriesRequest]) (*connect.Response[webhook.ListWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.webhook.v1.WebhookService.ListWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ReplayWebhookDelivery(context.Context, *connect.Request[webhook.ReplayWebhookDeliveryRequest]) (*connect.Response[webhook.WebhookDelivery], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rpc.webhook.v1.WebhookService.ReplayWebhookDelivery is not implemented"))
+}
@@ -0,0 +1,158 @@
+// Package ledger builds the balanced double-entry journals the datastore
+// writes to ledger_entries for every money movement.
+//
+// Accounts are debit-normal: an account's balance is its debits minus its
+// credits, so asset accounts (cash, accounts_receivable) and the refunds and
+// disputes contra-revenue accounts carry positive balances and revenue a
+// negative one.
+package ledger
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Accounts posted to.
+const (
+	// AccountCash holds captured funds at the payment processor.
+	AccountCash = "cash"
+	// AccountReceivable holds amounts invoiced but not yet settled.
+	AccountReceivable = "accounts_receivable"
+	// AccountRevenue is credited when a sale is made or invoiced.
+	AccountRevenue = "revenue"
+	// AccountRefunds is debited when captured funds are returned.
+	AccountRefunds = "refunds"
+	// AccountDisputes is debited when funds are lost to a dispute.
+	AccountDisputes = "disputes"
+)
+
+// KnownAccount reports whether a is one of the accounts above.
+func KnownAccount(a string) bool {
+	switch a {
+	case AccountCash, AccountReceivable, AccountRevenue, AccountRefunds, AccountDisputes:
+		return true
+	}
+	return false
+}
+
+// Journal kinds, stored with each entry.
+const (
+	KindPaymentCaptured  = "payment.captured"
+	KindPaymentRefunded  = "payment.refunded"
+	KindInvoiceIssued    = "invoice.issued"
+	KindInvoiceCancelled = "invoice.cancelled"
+	KindInvoiceSettled   = "invoice.settled"
+	KindInvoiceReopened  = "invoice.reopened"
+	KindDisputeLost      = "dispute.lost"
+)
+
+// ErrUnbalanced reports a journal whose debits and credits differ.
+var ErrUnbalanced = errors.New("ledger: journal is unbalanced")
+
+// Line is one side of a journal. Exactly one of Debit and Credit is set, in
+// minor units.
+type Line struct {
+	Account string
+	Debit   int64
+	Credit  int64
+}
+
+// Journal is a set of lines in one currency whose debits equal its credits.
+// PaymentID and InvoiceID link the entries to the business rows that caused
+// them; either may be empty.
+type Journal struct {
+	Kind      string
+	Currency  string
+	PaymentID string
+	InvoiceID string
+	Lines     []Line
+}
+
+// Validate checks that j has at least two one-sided, positive lines and
+// balances.
+func (j Journal) Validate() error {
+	if j.Kind == "" || j.Currency == "" {
+		return errors.New("ledger: journal kind and currency are required")
+	}
+	if len(j.Lines) < 2 {
+		return fmt.Errorf("ledger: %s journal needs at least two lines", j.Kind)
+	}
+	var debits, credits int64
+	for _, l := range j.Lines {
+		if l.Account == "" || l.Debit < 0 || l.Credit < 0 || (l.Debit == 0) == (l.Credit == 0) {
+			return fmt.Errorf("ledger: invalid %s line %+v", j.Kind, l)
+		}
+		debits += l.Debit
+		credits += l.Credit
+	}
+	if debits != credits {
+		return fmt.Errorf("%w: %s debits %d, credits %d", ErrUnbalanced, j.Kind, debits, credits)
+	}
+	return nil
+}
+
+func transfer(kind, currency, debit, credit string, amount int64) Journal {
+	return Journal{
+		Kind:     kind,
+		Currency: currency,
+		Lines:    []Line{{Account: debit, Debit: amount}, {Account: credit, Credit: amount}},
+	}
+}
+
+// PaymentCaptured moves captured funds into cash. A payment for an invoice
+// settles the receivable; any other payment is revenue.
+func PaymentCaptured(paymentID, invoiceID, currency string, amount int64) Journal {
+	credit := AccountRevenue
+	if invoiceID != "" {
+		credit = AccountReceivable
+	}
+	j := transfer(KindPaymentCaptured, currency, AccountCash, credit, amount)
+	j.PaymentID, j.InvoiceID = paymentID, invoiceID
+	return j
+}
+
+// PaymentRefunded returns amount of a captured payment.
+func PaymentRefunded(paymentID, invoiceID, currency string, amount int64) Journal {
+	j := transfer(KindPaymentRefunded, currency, AccountRefunds, AccountCash, amount)
+	j.PaymentID, j.InvoiceID = paymentID, invoiceID
+	return j
+}
+
+// InvoiceIssued books an invoice as receivable revenue.
+func InvoiceIssued(invoiceID, currency string, amount int64) Journal {
+	j := transfer(KindInvoiceIssued, currency, AccountReceivable, AccountRevenue, amount)
+	j.InvoiceID = invoiceID
+	return j
+}
+
+// InvoiceCancelled reverses InvoiceIssued for an invoice that will not be
+// paid.
+func InvoiceCancelled(invoiceID, currency string, amount int64) Journal {
+	j := transfer(KindInvoiceCancelled, currency, AccountRevenue, AccountReceivable, amount)
+	j.InvoiceID = invoiceID
+	return j
+}
+
+// InvoiceSettled settles an invoice paid outside a recorded payment.
+func InvoiceSettled(invoiceID, currency string, amount int64) Journal {
+	j := transfer(KindInvoiceSettled, currency, AccountCash, AccountReceivable, amount)
+	j.InvoiceID = invoiceID
+	return j
+}
+
+// InvoiceReopened makes amount of a charged-back invoice payment receivable
+// again. Together with the DisputeLost journal of the chargeback it nets to
+// receivable up, cash down.
+func InvoiceReopened(paymentID, invoiceID, currency string, amount int64) Journal {
+	j := transfer(KindInvoiceReopened, currency, AccountReceivable, AccountDisputes, amount)
+	j.PaymentID, j.InvoiceID = paymentID, invoiceID
+	return j
+}
+
+// DisputeLost returns amount of a captured payment to the cardholder after a
+// lost dispute.
+func DisputeLost(paymentID, invoiceID, currency string, amount int64) Journal {
+	j := transfer(KindDisputeLost, currency, AccountDisputes, AccountCash, amount)
+	j.PaymentID, j.InvoiceID = paymentID, invoiceID
+	return j
+}
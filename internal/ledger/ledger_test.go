@@ -0,0 +1,58 @@
+package ledger
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestJournalsBalance(t *testing.T) {
+	for _, j := range []Journal{
+		PaymentCaptured("p1", "", "USD", 1000),
+		PaymentCaptured("p1", "i1", "USD", 1000),
+		PaymentRefunded("p1", "", "USD", 250),
+		InvoiceIssued("i1", "EUR", 500),
+		InvoiceCancelled("i1", "EUR", 500),
+		InvoiceSettled("i1", "EUR", 500),
+		InvoiceReopened("p1", "i1", "EUR", 500),
+		DisputeLost("p1", "", "USD", 400),
+	} {
+		if err := j.Validate(); err != nil {
+			t.Errorf("%s: %v", j.Kind, err)
+		}
+	}
+}
+
+func TestPaymentCapturedAccounts(t *testing.T) {
+	if got := PaymentCaptured("p1", "", "USD", 1).Lines[1].Account; got != AccountRevenue {
+		t.Fatalf("direct payment credits %q, want %q", got, AccountRevenue)
+	}
+	if got := PaymentCaptured("p1", "i1", "USD", 1).Lines[1].Account; got != AccountReceivable {
+		t.Fatalf("invoice payment credits %q, want %q", got, AccountReceivable)
+	}
+}
+
+func TestInvoiceReopenedOffsetsDisputeLoss(t *testing.T) {
+	if got := InvoiceReopened("p1", "i1", "USD", 1).Lines[1].Account; got != AccountDisputes {
+		t.Fatalf("reopened invoice credits %q, want %q", got, AccountDisputes)
+	}
+}
+
+func TestValidateRejects(t *testing.T) {
+	tests := map[string]Journal{
+		"unbalanced": {Kind: "k", Currency: "USD", Lines: []Line{{Account: AccountCash, Debit: 2}, {Account: AccountRevenue, Credit: 1}}},
+		"one line":   {Kind: "k", Currency: "USD", Lines: []Line{{Account: AccountCash, Debit: 1}}},
+		"two-sided":  {Kind: "k", Currency: "USD", Lines: []Line{{Account: AccountCash, Debit: 1, Credit: 1}, {Account: AccountRevenue, Credit: 0, Debit: 0}}},
+		"zero":       transfer("k", "USD", AccountCash, AccountRevenue, 0),
+		"negative":   transfer("k", "USD", AccountCash, AccountRevenue, -5),
+		"no account": {Kind: "k", Currency: "USD", Lines: []Line{{Debit: 1}, {Account: AccountRevenue, Credit: 1}}},
+		"no kind":    {Currency: "USD", Lines: []Line{{Account: AccountCash, Debit: 1}, {Account: AccountRevenue, Credit: 1}}},
+	}
+	for name, j := range tests {
+		if err := j.Validate(); err == nil {
+			t.Errorf("%s: Validate succeeded, want error", name)
+		}
+	}
+	if err := tests["unbalanced"].Validate(); !errors.Is(err, ErrUnbalanced) {
+		t.Errorf("unbalanced: got %v, want ErrUnbalanced", err)
+	}
+}
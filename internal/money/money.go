@@ -0,0 +1,369 @@
+// Package money provides exact arithmetic on google.type.Money values and
+// conversion to and from integer minor units (cents, yen, fils, ...) using
+// each currency's ISO 4217 exponent.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+const nanosPerUnit = 1_000_000_000
+
+var (
+	// ErrInvalid reports a Money value that is nil, has an unknown currency
+	// code, nanos outside ±999,999,999, or units and nanos of opposite sign.
+	ErrInvalid = errors.New("invalid money")
+	// ErrCurrencyMismatch reports arithmetic across different currencies.
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+	// ErrPrecision reports an amount finer than its currency's minor unit.
+	ErrPrecision = errors.New("amount is more precise than the currency's minor unit")
+	// ErrOverflow reports a result that does not fit in int64.
+	ErrOverflow = errors.New("money overflow")
+)
+
+// Validate checks that m is a well-formed google.type.Money: a supported
+// upper-case ISO 4217 currency code, nanos within ±999,999,999 and units and
+// nanos sharing the same sign.
+func Validate(m *money.Money) error {
+	if m == nil {
+		return fmt.Errorf("%w: amount is required", ErrInvalid)
+	}
+	if _, ok := exponents[m.GetCurrencyCode()]; !ok {
+		return fmt.Errorf("%w: unsupported currency code %q", ErrInvalid, m.GetCurrencyCode())
+	}
+	n := m.GetNanos()
+	if n <= -nanosPerUnit || n >= nanosPerUnit {
+		return fmt.Errorf("%w: nanos %d out of range", ErrInvalid, n)
+	}
+	if (m.GetUnits() > 0 && n < 0) || (m.GetUnits() < 0 && n > 0) {
+		return fmt.Errorf("%w: units and nanos have different signs", ErrInvalid)
+	}
+	return nil
+}
+
+// ToMinor converts m to an exact count of minor units. It fails with
+// ErrPrecision rather than rounding when m has digits beyond the currency's
+// exponent, e.g. 1.005 USD or 1.5 JPY.
+func ToMinor(m *money.Money) (int64, error) {
+	if err := Validate(m); err != nil {
+		return 0, err
+	}
+	exp := exponents[m.GetCurrencyCode()]
+	step := int32(pow10(9 - exp))
+	if m.GetNanos()%step != 0 {
+		return 0, fmt.Errorf("%w: %s", ErrPrecision, m.GetCurrencyCode())
+	}
+	scale := pow10(exp)
+	if m.GetUnits() > math.MaxInt64/scale || m.GetUnits() < math.MinInt64/scale {
+		return 0, ErrOverflow
+	}
+	return m.GetUnits()*scale + int64(m.GetNanos()/step), nil
+}
+
+// FromMinor builds a Money value from minor units in currency.
+func FromMinor(minor int64, currency string) (*money.Money, error) {
+	exp, ok := exponents[currency]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported currency code %q", ErrInvalid, currency)
+	}
+	scale := pow10(exp)
+	return &money.Money{
+		CurrencyCode: currency,
+		Units:        minor / scale,
+		Nanos:        int32(minor%scale) * int32(pow10(9-exp)),
+	}, nil
+}
+
+// ParseMinor parses a decimal amount such as "12.50" or "-3" in currency into
+// minor units. Like ToMinor it fails with ErrPrecision rather than rounding.
+func ParseMinor(s, currency string) (int64, error) {
+	units, frac, _ := strings.Cut(strings.TrimSpace(s), ".")
+	neg := strings.HasPrefix(units, "-")
+	digits := strings.TrimPrefix(units, "-")
+	if digits == "" || len(frac) > 9 || !isDigits(digits) || !isDigits(frac) {
+		return 0, fmt.Errorf("%w: malformed amount %q", ErrInvalid, s)
+	}
+	u, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return 0, ErrOverflow
+	}
+	n, _ := strconv.ParseInt(frac+strings.Repeat("0", 9-len(frac)), 10, 64)
+	if neg {
+		u, n = -u, -n
+	}
+	return ToMinor(&money.Money{CurrencyCode: currency, Units: u, Nanos: int32(n)})
+}
+
+// FormatMinor renders minor units in currency as a decimal amount with the
+// currency's exponent, e.g. 1250 USD as "12.50". It is the inverse of
+// ParseMinor.
+func FormatMinor(minor int64, currency string) (string, error) {
+	exp, ok := exponents[currency]
+	if !ok {
+		return "", fmt.Errorf("%w: unsupported currency code %q", ErrInvalid, currency)
+	}
+	sign := ""
+	if minor < 0 {
+		sign = "-"
+	}
+	digits := strconv.FormatUint(uint64(absInt64(minor)), 10)
+	if exp == 0 {
+		return sign + digits, nil
+	}
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-exp] + "." + digits[len(digits)-exp:], nil
+}
+
+// Add returns a+b. Both must be valid and share a currency.
+func Add(a, b *money.Money) (*money.Money, error) {
+	if err := sameCurrency(a, b); err != nil {
+		return nil, err
+	}
+	units, ok := addInt64(a.GetUnits(), b.GetUnits())
+	if !ok {
+		return nil, ErrOverflow
+	}
+	return normalize(a.GetCurrencyCode(), units, int64(a.GetNanos())+int64(b.GetNanos()))
+}
+
+// Sub returns a-b. Both must be valid and share a currency.
+func Sub(a, b *money.Money) (*money.Money, error) {
+	if err := Validate(b); err != nil {
+		return nil, err
+	}
+	if b.GetUnits() == math.MinInt64 {
+		return nil, ErrOverflow
+	}
+	return Add(a, &money.Money{CurrencyCode: b.GetCurrencyCode(), Units: -b.GetUnits(), Nanos: -b.GetNanos()})
+}
+
+// Compare returns -1, 0 or +1 as a is less than, equal to or greater than b.
+// Both must be valid and share a currency.
+func Compare(a, b *money.Money) (int, error) {
+	if err := sameCurrency(a, b); err != nil {
+		return 0, err
+	}
+	switch {
+	case a.GetUnits() < b.GetUnits():
+		return -1, nil
+	case a.GetUnits() > b.GetUnits():
+		return 1, nil
+	case a.GetNanos() < b.GetNanos():
+		return -1, nil
+	case a.GetNanos() > b.GetNanos():
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// IsPositive reports whether m is valid and strictly greater than zero.
+func IsPositive(m *money.Money) bool {
+	return Validate(m) == nil && (m.GetUnits() > 0 || m.GetNanos() > 0)
+}
+
+// Allocate splits m into len(weights) parts proportional to weights without
+// losing or creating minor units; see AllocateMinor.
+func Allocate(m *money.Money, weights []int64) ([]*money.Money, error) {
+	total, err := ToMinor(m)
+	if err != nil {
+		return nil, err
+	}
+	parts, err := AllocateMinor(total, weights)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*money.Money, len(parts))
+	for i, p := range parts {
+		if out[i], err = FromMinor(p, m.GetCurrencyCode()); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// AllocateMinor splits total minor units into len(weights) parts proportional
+// to weights: each part is rounded toward zero and the leftover minor units
+// are handed out one at a time from the first part on. Weights must be
+// non-negative with a positive sum.
+func AllocateMinor(total int64, weights []int64) ([]int64, error) {
+	var (
+		sum int64
+		err error
+	)
+	for _, w := range weights {
+		if w < 0 {
+			return nil, errors.New("allocation weights must not be negative")
+		}
+		if sum, err = checkedAdd(sum, w); err != nil {
+			return nil, err
+		}
+	}
+	if sum == 0 {
+		return nil, errors.New("allocation weights must sum to a positive value")
+	}
+	if total == math.MinInt64 {
+		return nil, ErrOverflow
+	}
+
+	sign := int64(1)
+	if total < 0 {
+		sign, total = -1, -total
+	}
+	parts := make([]int64, len(weights))
+	remainder := total
+	for i, w := range weights {
+		share, ok := mulDiv(total, w, sum)
+		if !ok {
+			return nil, ErrOverflow
+		}
+		parts[i] = share
+		remainder -= share
+	}
+	for i := 0; remainder > 0; i = (i + 1) % len(parts) {
+		if weights[i] == 0 {
+			continue
+		}
+		parts[i]++
+		remainder--
+	}
+	for i := range parts {
+		parts[i] *= sign
+	}
+	return parts, nil
+}
+
+// MulMinor returns minor*n, failing with ErrOverflow instead of wrapping.
+func MulMinor(minor, n int64) (int64, error) {
+	hi, lo := bits.Mul64(uint64(absInt64(minor)), uint64(absInt64(n)))
+	if hi != 0 || lo > math.MaxInt64 || minor == math.MinInt64 || n == math.MinInt64 {
+		return 0, ErrOverflow
+	}
+	if (minor < 0) != (n < 0) {
+		return -int64(lo), nil
+	}
+	return int64(lo), nil
+}
+
+// ApplyBasisPoints returns minor*bps/10000 rounded to the nearest minor unit,
+// with halves rounded away from zero. bps must not be negative; 2000 is 20%.
+func ApplyBasisPoints(minor, bps int64) (int64, error) {
+	if bps < 0 {
+		return 0, errors.New("basis points must not be negative")
+	}
+	if minor == math.MinInt64 {
+		return 0, ErrOverflow
+	}
+	sign := int64(1)
+	if minor < 0 {
+		sign, minor = -1, -minor
+	}
+	q, ok := mulDiv(minor, bps, 10000)
+	if !ok {
+		return 0, ErrOverflow
+	}
+	// floor(x/5000) exceeds 2*floor(x/10000) exactly when the dropped
+	// remainder is at least half a minor unit.
+	doubled, ok := mulDiv(minor, bps, 5000)
+	if !ok {
+		return 0, ErrOverflow
+	}
+	if doubled > 2*q {
+		q++
+	}
+	return sign * q, nil
+}
+
+func sameCurrency(a, b *money.Money) error {
+	if err := Validate(a); err != nil {
+		return err
+	}
+	if err := Validate(b); err != nil {
+		return err
+	}
+	if a.GetCurrencyCode() != b.GetCurrencyCode() {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, a.GetCurrencyCode(), b.GetCurrencyCode())
+	}
+	return nil
+}
+
+// normalize carries nanos into units so that |nanos| < 1e9 and units and
+// nanos share a sign.
+func normalize(currency string, units, nanos int64) (*money.Money, error) {
+	units, ok := addInt64(units, nanos/nanosPerUnit)
+	if !ok {
+		return nil, ErrOverflow
+	}
+	nanos %= nanosPerUnit
+	switch {
+	case units > 0 && nanos < 0:
+		units--
+		nanos += nanosPerUnit
+	case units < 0 && nanos > 0:
+		units++
+		nanos -= nanosPerUnit
+	}
+	return &money.Money{CurrencyCode: currency, Units: units, Nanos: int32(nanos)}, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func pow10(n int) int64 {
+	p := int64(1)
+	for range n {
+		p *= 10
+	}
+	return p
+}
+
+func absInt64(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func addInt64(a, b int64) (int64, bool) {
+	c := a + b
+	if (b > 0 && c < a) || (b < 0 && c > a) {
+		return 0, false
+	}
+	return c, true
+}
+
+func checkedAdd(a, b int64) (int64, error) {
+	c, ok := addInt64(a, b)
+	if !ok {
+		return 0, ErrOverflow
+	}
+	return c, nil
+}
+
+// mulDiv returns floor(a*b/c) for non-negative a, b and positive c, reporting
+// false if the result does not fit in int64.
+func mulDiv(a, b, c int64) (int64, bool) {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	if hi >= uint64(c) {
+		return 0, false
+	}
+	q, _ := bits.Div64(hi, lo, uint64(c))
+	if q > math.MaxInt64 {
+		return 0, false
+	}
+	return int64(q), true
+}
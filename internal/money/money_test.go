@@ -0,0 +1,246 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+func m(currency string, units int64, nanos int32) *money.Money {
+	return &money.Money{CurrencyCode: currency, Units: units, Nanos: nanos}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *money.Money
+		ok   bool
+	}{
+		{name: "nil", in: nil},
+		{name: "usd", in: m("USD", 10, 500_000_000), ok: true},
+		{name: "negative", in: m("USD", -1, -500_000_000), ok: true},
+		{name: "negative nanos only", in: m("USD", 0, -1), ok: true},
+		{name: "lower case code", in: m("usd", 1, 0)},
+		{name: "unknown code", in: m("XYZ", 1, 0)},
+		{name: "nanos too large", in: m("USD", 1, 1_000_000_000)},
+		{name: "mixed signs", in: m("USD", 1, -1)},
+		{name: "mixed signs negative units", in: m("USD", -1, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.in)
+			if tt.ok != (err == nil) {
+				t.Fatalf("Validate(%v) = %v, want ok=%v", tt.in, err, tt.ok)
+			}
+			if err != nil && !errors.Is(err, ErrInvalid) {
+				t.Fatalf("error %v does not wrap ErrInvalid", err)
+			}
+		})
+	}
+}
+
+func TestMinorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    *money.Money
+		minor int64
+	}{
+		{name: "usd", in: m("USD", 12, 340_000_000), minor: 1234},
+		{name: "usd negative", in: m("USD", -12, -340_000_000), minor: -1234},
+		{name: "usd cents only", in: m("USD", 0, -50_000_000), minor: -5},
+		{name: "jpy", in: m("JPY", 500, 0), minor: 500},
+		{name: "kwd", in: m("KWD", 1, 234_000_000), minor: 1234},
+		{name: "clf", in: m("CLF", 2, 500_100_000), minor: 25001},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToMinor(tt.in)
+			if err != nil || got != tt.minor {
+				t.Fatalf("ToMinor(%v) = %d, %v; want %d", tt.in, got, err, tt.minor)
+			}
+			back, err := FromMinor(got, tt.in.GetCurrencyCode())
+			if err != nil {
+				t.Fatalf("FromMinor: %v", err)
+			}
+			if back.GetUnits() != tt.in.GetUnits() || back.GetNanos() != tt.in.GetNanos() {
+				t.Fatalf("FromMinor(%d) = %v, want %v", got, back, tt.in)
+			}
+		})
+	}
+}
+
+func TestToMinorRejectsLoss(t *testing.T) {
+	for _, in := range []*money.Money{
+		m("USD", 1, 5_000_000), // 1.005
+		m("JPY", 1, 500_000_000),
+		m("KWD", 0, 100_000),
+	} {
+		if _, err := ToMinor(in); !errors.Is(err, ErrPrecision) {
+			t.Errorf("ToMinor(%v) = %v, want ErrPrecision", in, err)
+		}
+	}
+	if _, err := ToMinor(m("USD", math.MaxInt64, 0)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("ToMinor overflow = %v, want ErrOverflow", err)
+	}
+}
+
+func TestAddSubCompare(t *testing.T) {
+	sum, err := Add(m("USD", 1, 750_000_000), m("USD", 2, 500_000_000))
+	if err != nil || sum.GetUnits() != 4 || sum.GetNanos() != 250_000_000 {
+		t.Fatalf("Add = %v, %v; want 4.25", sum, err)
+	}
+	diff, err := Sub(m("USD", 1, 250_000_000), m("USD", 2, 500_000_000))
+	if err != nil || diff.GetUnits() != -1 || diff.GetNanos() != -250_000_000 {
+		t.Fatalf("Sub = %v, %v; want -1.25", diff, err)
+	}
+	diff, err = Sub(m("USD", 2, 0), m("USD", 0, 1))
+	if err != nil || diff.GetUnits() != 1 || diff.GetNanos() != 999_999_999 {
+		t.Fatalf("Sub = %v, %v; want 1.999999999", diff, err)
+	}
+	if _, err := Add(m("USD", 1, 0), m("EUR", 1, 0)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("Add mixed currencies = %v, want ErrCurrencyMismatch", err)
+	}
+	if _, err := Add(m("USD", math.MaxInt64, 0), m("USD", 1, 0)); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("Add overflow = %v, want ErrOverflow", err)
+	}
+
+	cmp := []struct {
+		a, b *money.Money
+		want int
+	}{
+		{a: m("USD", 1, 0), b: m("USD", 1, 0), want: 0},
+		{a: m("USD", 1, 10_000_000), b: m("USD", 1, 0), want: 1},
+		{a: m("USD", -1, -10_000_000), b: m("USD", -1, 0), want: -1},
+		{a: m("USD", 0, -1), b: m("USD", 0, 0), want: -1},
+	}
+	for _, tt := range cmp {
+		got, err := Compare(tt.a, tt.b)
+		if err != nil || got != tt.want {
+			t.Errorf("Compare(%v, %v) = %d, %v; want %d", tt.a, tt.b, got, err, tt.want)
+		}
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *money.Money
+		weights []int64
+		want    []int64
+	}{
+		{name: "even thirds", in: m("USD", 1, 0), weights: []int64{1, 1, 1}, want: []int64{34, 33, 33}},
+		{name: "weighted", in: m("USD", 0, 50_000_000), weights: []int64{3, 7}, want: []int64{2, 3}},
+		{name: "jpy", in: m("JPY", 100, 0), weights: []int64{1, 2}, want: []int64{34, 66}},
+		{name: "negative", in: m("USD", -1, 0), weights: []int64{1, 1, 1}, want: []int64{-34, -33, -33}},
+		{name: "zero weight gets nothing", in: m("USD", 0, 10_000_000), weights: []int64{0, 1, 1}, want: []int64{0, 1, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parts, err := Allocate(tt.in, tt.weights)
+			if err != nil {
+				t.Fatalf("Allocate: %v", err)
+			}
+			for i, p := range parts {
+				got, err := ToMinor(p)
+				if err != nil || got != tt.want[i] {
+					t.Fatalf("part %d = %v (%v), want %d minor units", i, p, err, tt.want[i])
+				}
+			}
+		})
+	}
+	if _, err := Allocate(m("USD", 1, 0), []int64{0, 0}); err == nil {
+		t.Fatalf("expected error for zero weights")
+	}
+	if _, err := Allocate(m("USD", 1, 0), []int64{1, -1}); err == nil {
+		t.Fatalf("expected error for negative weight")
+	}
+}
+
+func TestApplyBasisPoints(t *testing.T) {
+	tests := []struct {
+		minor, bps, want int64
+	}{
+		{1000, 2000, 200},
+		{125, 1000, 13}, // 12.5 rounds away from zero
+		{124, 1000, 12},
+		{-125, 1000, -13},
+		{333, 825, 27}, // 27.4725
+		{0, 2000, 0},
+		{999, 0, 0},
+	}
+	for _, tt := range tests {
+		got, err := ApplyBasisPoints(tt.minor, tt.bps)
+		if err != nil || got != tt.want {
+			t.Errorf("ApplyBasisPoints(%d, %d) = %d, %v; want %d", tt.minor, tt.bps, got, err, tt.want)
+		}
+	}
+	if _, err := ApplyBasisPoints(100, -1); err == nil {
+		t.Error("negative basis points accepted")
+	}
+}
+
+func TestMulMinor(t *testing.T) {
+	if got, err := MulMinor(-250, 3); err != nil || got != -750 {
+		t.Fatalf("MulMinor(-250, 3) = %d, %v", got, err)
+	}
+	if _, err := MulMinor(math.MaxInt64/2, 3); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("MulMinor overflow err = %v", err)
+	}
+}
+
+func TestParseMinor(t *testing.T) {
+	tests := []struct {
+		in, currency string
+		want         int64
+		err          error
+	}{
+		{"12.50", "USD", 1250, nil},
+		{"5000", "USD", 500000, nil},
+		{" -3.1 ", "EUR", -310, nil},
+		{"1500", "JPY", 1500, nil},
+		{"1.234", "KWD", 1234, nil},
+		{"1.005", "USD", 0, ErrPrecision},
+		{"1.5", "JPY", 0, ErrPrecision},
+		{"", "USD", 0, ErrInvalid},
+		{"1,50", "USD", 0, ErrInvalid},
+		{".5", "USD", 0, ErrInvalid},
+		{"1e3", "USD", 0, ErrInvalid},
+		{"10", "XXX", 0, ErrInvalid},
+	}
+	for _, tt := range tests {
+		got, err := ParseMinor(tt.in, tt.currency)
+		if !errors.Is(err, tt.err) || got != tt.want {
+			t.Fatalf("ParseMinor(%q, %s) = %d, %v; want %d, %v", tt.in, tt.currency, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestFormatMinor(t *testing.T) {
+	tests := []struct {
+		minor    int64
+		currency string
+		want     string
+	}{
+		{1250, "USD", "12.50"},
+		{5, "USD", "0.05"},
+		{-310, "EUR", "-3.10"},
+		{1500, "JPY", "1500"},
+		{1234, "KWD", "1.234"},
+		{0, "USD", "0.00"},
+	}
+	for _, tt := range tests {
+		got, err := FormatMinor(tt.minor, tt.currency)
+		if err != nil || got != tt.want {
+			t.Fatalf("FormatMinor(%d, %s) = %q, %v; want %q", tt.minor, tt.currency, got, err, tt.want)
+		}
+		back, err := ParseMinor(got, tt.currency)
+		if err != nil || back != tt.minor {
+			t.Fatalf("ParseMinor(%q) = %d, %v; want %d", got, back, err, tt.minor)
+		}
+	}
+	if _, err := FormatMinor(1, "XXX"); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("FormatMinor with unknown currency: %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	tokenizationv1 "github.com/grpc-buf/internal/gen/proto/tokenization"
+	"github.com/grpc-buf/internal/vault"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TokenizeCard validates the card, seals its number with the vault key and
+// stores it under a new token. The CVC is validated and dropped; it is never
+// stored or logged.
+func (s *Store) TokenizeCard(ctx context.Context, req *connect.Request[tokenizationv1.TokenizeCardRequest]) (*connect.Response[tokenizationv1.CardToken], error) {
+	if s.vault == nil {
+		return nil, status.Error(codes.FailedPrecondition, "card vault is not configured")
+	}
+	card, err := vault.ParseCard(req.Msg.GetPan(), int(req.Msg.GetExpMonth()), int(req.Msg.GetExpYear()), req.Msg.GetCvc(), time.Now())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	token, err := vault.NewToken()
+	if err != nil {
+		slog.Error("card token generation failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to tokenize card")
+	}
+	sealed, err := s.vault.Seal(token, card.PAN)
+	if err != nil {
+		slog.Error("card seal failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to tokenize card")
+	}
+
+	res := &tokenizationv1.CardToken{
+		Token:      token,
+		Last4:      card.Last4(),
+		ExpMonth:   int32(card.ExpMonth),
+		ExpYear:    int32(card.ExpYear),
+		ExpireTime: timestamppb.New(card.ExpiresAt()),
+	}
+	var created time.Time
+	if err := s.db.QueryRow(ctx,
+		`INSERT INTO card_tokens (token, pan_sealed, last4, exp_month, exp_year, expires_at)
+         VALUES ($1, $2, $3, $4, $5, $6)
+         RETURNING created_at`,
+		token, sealed, res.Last4, card.ExpMonth, card.ExpYear, card.ExpiresAt()).Scan(&created); err != nil {
+		slog.Error("store card token failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to tokenize card")
+	}
+	res.CreateTime = timestamppb.New(created)
+	slog.Info("Card tokenized", "card_last4", res.Last4)
+	return connect.NewResponse(res), nil
+}
+
+// VerifyCardToken checks that token was issued by the vault and that its card
+// has not expired. Tokens the vault does not know are accepted only when
+// payments.vault.allow_unknown_tokens is set, which keeps the fake
+// processor's magic tokens usable in development.
+func (s *Store) VerifyCardToken(ctx context.Context, token string) error {
+	if token == "" {
+		return status.Error(codes.InvalidArgument, "card_token is required")
+	}
+	var expires time.Time
+	err := s.db.QueryRow(ctx, `SELECT expires_at FROM card_tokens WHERE token=$1`, token).Scan(&expires)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		if s.pay.Vault.AllowUnknownTokens {
+			return nil
+		}
+		return status.Error(codes.InvalidArgument, "card_token is unknown")
+	case err != nil:
+		slog.Error("card token lookup failed", "error", err)
+		return status.Error(codes.Internal, "failed to verify card token")
+	case !time.Now().Before(expires):
+		return status.Error(codes.FailedPrecondition, "card_token has expired")
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/grpc-buf/internal/security"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// denylist is the Postgres security.Denylist, shared by every replica.
+type denylist struct {
+	db *pgxpool.Pool
+}
+
+var _ security.Denylist = (*denylist)(nil)
+
+// Deny implements security.Denylist.
+func (d *denylist) Deny(ctx context.Context, jti string, expiresAt time.Time) error {
+	_, err := d.db.Exec(ctx,
+		`INSERT INTO revoked_tokens (jti, expires_at) VALUES ($1, $2)
+         ON CONFLICT (jti) DO UPDATE SET expires_at = GREATEST(revoked_tokens.expires_at, EXCLUDED.expires_at)`,
+		jti, expiresAt)
+	if err != nil {
+		return fmt.Errorf("deny token: %w", err)
+	}
+	return nil
+}
+
+// DenySubject implements security.Denylist.
+func (d *denylist) DenySubject(ctx context.Context, subject string, before, expiresAt time.Time) error {
+	_, err := d.db.Exec(ctx,
+		`INSERT INTO revoked_subjects (subject, revoked_before, expires_at) VALUES ($1, $2, $3)
+         ON CONFLICT (subject) DO UPDATE
+         SET revoked_before = GREATEST(revoked_subjects.revoked_before, EXCLUDED.revoked_before),
+             expires_at = GREATEST(revoked_subjects.expires_at, EXCLUDED.expires_at)`,
+		subject, before, expiresAt)
+	if err != nil {
+		return fmt.Errorf("deny subject: %w", err)
+	}
+	return nil
+}
+
+// Denied implements security.Denylist. Entries past their expiry are ignored
+// even before they are pruned.
+func (d *denylist) Denied(ctx context.Context, claims *security.Claims) (bool, error) {
+	var denied bool
+	err := d.db.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1 AND $1 <> '' AND expires_at > NOW())
+             OR EXISTS (SELECT 1 FROM revoked_subjects WHERE subject = $2 AND revoked_before >= $3 AND expires_at > NOW())`,
+		claims.ID, claims.Subject, claims.IssueTime()).Scan(&denied)
+	if err != nil {
+		return false, fmt.Errorf("check denylist: %w", err)
+	}
+	return denied, nil
+}
+
+// Prune implements security.Denylist.
+func (d *denylist) Prune(ctx context.Context, now time.Time) (int, error) {
+	var n int
+	err := d.db.QueryRow(ctx,
+		`WITH t AS (DELETE FROM revoked_tokens WHERE expires_at <= $1 RETURNING 1),
+              s AS (DELETE FROM revoked_subjects WHERE expires_at <= $1 RETURNING 1)
+         SELECT (SELECT COUNT(*) FROM t) + (SELECT COUNT(*) FROM s)`, now).Scan(&n)
+	if err != nil {
+		return 0, fmt.Errorf("prune denylist: %w", err)
+	}
+	return n, nil
+}
+
+// Denylist returns the token denylist selected by security.denylist.backend.
+// LogoutUser and RevokeUserSessions write to it; JWTAuthInterceptor reads it.
+func (s *Store) Denylist() security.Denylist {
+	return s.deny
+}
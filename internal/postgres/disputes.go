@@ -0,0 +1,514 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"github.com/grpc-buf/internal/ledger"
+	"github.com/grpc-buf/internal/money"
+	"github.com/jackc/pgx/v5"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultDisputeEvidenceWindow applies when
+	// payments.dispute_evidence_window is unset.
+	defaultDisputeEvidenceWindow = 7 * 24 * time.Hour
+	// maxDisputeReason bounds Dispute.reason, in characters.
+	maxDisputeReason = 1000
+	// maxEvidenceNote bounds each evidence note, in characters.
+	maxEvidenceNote = 4000
+	// maxDisputeEvidence bounds the evidence notes on one dispute.
+	maxDisputeEvidence = 50
+)
+
+const disputeColumns = `id, payment_id, amount_cents, currency_code, reason, status, evidence_due_at, created_at, updated_at, resolved_at`
+
+// disputeEvidenceWindow returns the configured evidence window, falling back
+// to seven days when unset or unparsable (config.Validate rejects bad values
+// at startup).
+func (s *Store) disputeEvidenceWindow() time.Duration {
+	if v := strings.TrimSpace(s.pay.DisputeEvidenceWindow); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			return d
+		}
+	}
+	return defaultDisputeEvidenceWindow
+}
+
+// CreateDispute opens a dispute for req.amount (or the payment's whole net
+// amount when unset) of a settled payment. Returns codes.FailedPrecondition
+// when the payment is not settled, has nothing left to dispute or already
+// has an OPEN dispute.
+func (s *Store) CreateDispute(ctx context.Context, req *connect.Request[paymentv1.CreateDisputeRequest]) (*connect.Response[paymentv1.Dispute], error) {
+	paymentID := strings.TrimSpace(req.Msg.GetPaymentId())
+	if paymentID == "" {
+		return nil, status.Error(codes.InvalidArgument, "payment_id is required")
+	}
+	reason := strings.TrimSpace(req.Msg.GetReason())
+	if utf8.RuneCountInString(reason) > maxDisputeReason {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", maxDisputeReason)
+	}
+	due := time.Now().Add(s.disputeEvidenceWindow())
+	if t := req.Msg.GetEvidenceDueTime(); t != nil {
+		if err := t.CheckValid(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid evidence_due_time")
+		}
+		if due = t.AsTime(); !due.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "evidence_due_time must be in the future")
+		}
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.Error("create dispute begin failed", "error", err, "payment_id", paymentID)
+		return nil, status.Error(codes.Internal, "failed to create dispute")
+	}
+	defer rollback(ctx, tx)
+
+	p, err := scanPayment(tx.QueryRow(ctx,
+		`SELECT `+paymentColumns+` FROM payments WHERE id=$1 FOR UPDATE`, paymentID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "payment not found")
+		}
+		slog.Error("create dispute payment load failed", "error", err, "payment_id", paymentID)
+		return nil, status.Error(codes.Internal, "failed to create dispute")
+	}
+	amountCents, err := disputeAmount(p, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	var open bool
+	if err := tx.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM disputes WHERE payment_id=$1 AND status=$2)`,
+		paymentID, int(paymentv1.DisputeStatus_DISPUTE_STATUS_OPEN)).Scan(&open); err != nil {
+		slog.Error("create dispute open check failed", "error", err, "payment_id", paymentID)
+		return nil, status.Error(codes.Internal, "failed to create dispute")
+	}
+	if open {
+		return nil, status.Error(codes.FailedPrecondition, "payment already has an open dispute")
+	}
+
+	d, err := scanDispute(tx.QueryRow(ctx,
+		`INSERT INTO disputes (payment_id, amount_cents, currency_code, reason, status, evidence_due_at)
+         VALUES ($1, $2, $3, $4, $5, $6)
+         RETURNING `+disputeColumns,
+		paymentID, amountCents, p.GetAmount().GetCurrencyCode(), reason, int(paymentv1.DisputeStatus_DISPUTE_STATUS_OPEN), due))
+	if err != nil {
+		slog.Error("insert dispute failed", "error", err, "payment_id", paymentID)
+		return nil, status.Error(codes.Internal, "failed to create dispute")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("create dispute commit failed", "error", err, "payment_id", paymentID)
+		return nil, status.Error(codes.Internal, "failed to create dispute")
+	}
+
+	slog.Info("Dispute opened", "id", d.GetId(), "payment_id", paymentID, "amount_cents", amountCents)
+	return connect.NewResponse(d), nil
+}
+
+// disputeAmount returns the amount req disputes of p: req.amount, or p's
+// whole net amount when unset. Only settled payments with a positive net
+// amount can be disputed, and never for more than that amount.
+func disputeAmount(p *paymentv1.Payment, req *paymentv1.CreateDisputeRequest) (int64, error) {
+	if !isSettled(p.GetStatus()) {
+		return 0, status.Errorf(codes.FailedPrecondition, "cannot dispute a payment in status %s", p.GetStatus())
+	}
+	net := storedMinor(p.GetNetAmount())
+	if net <= 0 {
+		return 0, status.Error(codes.FailedPrecondition, "payment has no net amount left to dispute")
+	}
+	amount := req.GetAmount()
+	if amount == nil {
+		return net, nil
+	}
+	cents, err := minorUnits("dispute amount", amount)
+	if err != nil {
+		return 0, err
+	}
+	if amount.GetCurrencyCode() != p.GetAmount().GetCurrencyCode() {
+		return 0, status.Error(codes.InvalidArgument, "dispute currency must match the payment currency")
+	}
+	if cents <= 0 {
+		return 0, status.Error(codes.InvalidArgument, "dispute amount must be positive")
+	}
+	if cents > net {
+		return 0, status.Error(codes.FailedPrecondition, "dispute exceeds the payment's net amount")
+	}
+	return cents, nil
+}
+
+// GetDispute returns a single dispute by id with its evidence. Returns
+// codes.NotFound when the dispute does not exist.
+func (s *Store) GetDispute(ctx context.Context, req *connect.Request[paymentv1.GetDisputeRequest]) (*connect.Response[paymentv1.Dispute], error) {
+	id := strings.TrimSpace(req.Msg.GetId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	d, err := scanDispute(s.db.QueryRow(ctx, `SELECT `+disputeColumns+` FROM disputes WHERE id=$1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "dispute not found")
+		}
+		slog.Error("get dispute query failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to get dispute")
+	}
+	if err := loadDisputeEvidence(ctx, s.db, d); err != nil {
+		slog.Error("load dispute evidence failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to get dispute")
+	}
+	return connect.NewResponse(d), nil
+}
+
+// ListDisputes returns a page of disputes ordered by creation time (newest
+// first), optionally filtered by payment and status. Pagination uses the same
+// opaque "o:<offset>" tokens as ListExpenses.
+func (s *Store) ListDisputes(ctx context.Context, req *connect.Request[paymentv1.ListDisputesRequest]) (*connect.Response[paymentv1.ListDisputesResponse], error) {
+	var (
+		conds []string
+		args  []any
+	)
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if id := strings.TrimSpace(req.Msg.GetPaymentId()); id != "" {
+		add("payment_id=$%d", id)
+	}
+	if st := req.Msg.GetStatus(); st != paymentv1.DisputeStatus_DISPUTE_STATUS_UNSPECIFIED {
+		if _, ok := paymentv1.DisputeStatus_name[int32(st)]; !ok {
+			return nil, status.Error(codes.InvalidArgument, "unknown dispute status filter")
+		}
+		add("status=$%d", int(st))
+	}
+	var where string
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	pageSize := req.Msg.GetPageSize()
+	if pageSize <= 0 || pageSize > 1000 {
+		pageSize = 50
+	}
+
+	offset := 0
+	if req.Msg.GetPageToken() != "" {
+		if n, err := fmt.Sscanf(req.Msg.GetPageToken(), "o:%d", &offset); n != 1 || err != nil {
+			offset = 0
+		}
+	}
+
+	args = append(args, pageSize, offset)
+	rows, err := s.db.Query(ctx,
+		`SELECT `+disputeColumns+` FROM disputes `+where+`
+         ORDER BY created_at DESC LIMIT $`+strconv.Itoa(len(args)-1)+` OFFSET $`+strconv.Itoa(len(args)), args...)
+	if err != nil {
+		slog.Error("list disputes query failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list disputes")
+	}
+	defer rows.Close()
+
+	resp := &paymentv1.ListDisputesResponse{}
+	for rows.Next() {
+		d, err := scanDispute(rows)
+		if err != nil {
+			slog.Error("list disputes scan failed", "error", err)
+			return nil, status.Error(codes.Internal, "failed to list disputes")
+		}
+		resp.Disputes = append(resp.Disputes, d)
+	}
+	if err := rows.Err(); err != nil {
+		slog.Error("list disputes iteration failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list disputes")
+	}
+	if err := loadDisputeEvidence(ctx, s.db, resp.Disputes...); err != nil {
+		slog.Error("load dispute evidence failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list disputes")
+	}
+	if len(resp.Disputes) == int(pageSize) {
+		resp.NextPageToken = fmt.Sprintf("o:%d", offset+int(pageSize))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// AddDisputeEvidence appends an evidence note to an OPEN dispute. Returns
+// codes.FailedPrecondition once the dispute is resolved, its evidence
+// deadline has passed or it already holds maxDisputeEvidence notes.
+func (s *Store) AddDisputeEvidence(ctx context.Context, req *connect.Request[paymentv1.AddDisputeEvidenceRequest]) (*connect.Response[paymentv1.Dispute], error) {
+	id := strings.TrimSpace(req.Msg.GetId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	note := strings.TrimSpace(req.Msg.GetNote())
+	if note == "" {
+		return nil, status.Error(codes.InvalidArgument, "note is required")
+	}
+	if utf8.RuneCountInString(note) > maxEvidenceNote {
+		return nil, status.Errorf(codes.InvalidArgument, "note must be at most %d characters", maxEvidenceNote)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.Error("add dispute evidence begin failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to add dispute evidence")
+	}
+	defer rollback(ctx, tx)
+
+	d, err := lockOpenDispute(ctx, tx, id, "add evidence to")
+	if err != nil {
+		return nil, err
+	}
+	if !time.Now().Before(d.GetEvidenceDueTime().AsTime()) {
+		return nil, status.Error(codes.FailedPrecondition, "the dispute's evidence deadline has passed")
+	}
+	var n int
+	if err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM dispute_evidence WHERE dispute_id=$1`, id).Scan(&n); err != nil {
+		slog.Error("count dispute evidence failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to add dispute evidence")
+	}
+	if n >= maxDisputeEvidence {
+		return nil, status.Errorf(codes.FailedPrecondition, "a dispute holds at most %d evidence notes", maxDisputeEvidence)
+	}
+	if _, err := tx.Exec(ctx, `INSERT INTO dispute_evidence (dispute_id, note) VALUES ($1, $2)`, id, note); err != nil {
+		slog.Error("insert dispute evidence failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to add dispute evidence")
+	}
+	d, err = scanDispute(tx.QueryRow(ctx,
+		`UPDATE disputes SET updated_at=NOW() WHERE id=$1 RETURNING `+disputeColumns, id))
+	if err != nil {
+		slog.Error("touch dispute failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to add dispute evidence")
+	}
+	if err := loadDisputeEvidence(ctx, tx, d); err != nil {
+		slog.Error("load dispute evidence failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to add dispute evidence")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("add dispute evidence commit failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to add dispute evidence")
+	}
+	return connect.NewResponse(d), nil
+}
+
+// ResolveDispute closes an OPEN dispute as WON or LOST. A lost dispute adds
+// its amount, capped at what the payment still nets, to the payment's
+// disputed total, posts the loss to the ledger and reopens the invoices the
+// payment paid, all in the same transaction.
+func (s *Store) ResolveDispute(ctx context.Context, req *connect.Request[paymentv1.ResolveDisputeRequest]) (*connect.Response[paymentv1.Dispute], error) {
+	id := strings.TrimSpace(req.Msg.GetId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	outcome := req.Msg.GetOutcome()
+	if outcome != paymentv1.DisputeStatus_DISPUTE_STATUS_WON && outcome != paymentv1.DisputeStatus_DISPUTE_STATUS_LOST {
+		return nil, status.Error(codes.InvalidArgument, "outcome must be DISPUTE_STATUS_WON or DISPUTE_STATUS_LOST")
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.Error("resolve dispute begin failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to resolve dispute")
+	}
+	defer rollback(ctx, tx)
+
+	d, err := lockOpenDispute(ctx, tx, id, "resolve")
+	if err != nil {
+		return nil, err
+	}
+	var lost int64
+	if outcome == paymentv1.DisputeStatus_DISPUTE_STATUS_LOST {
+		if lost, err = loseDispute(ctx, tx, d); err != nil {
+			return nil, err
+		}
+	}
+	d, err = scanDispute(tx.QueryRow(ctx,
+		`UPDATE disputes SET status=$2, resolved_at=NOW(), updated_at=NOW() WHERE id=$1 RETURNING `+disputeColumns,
+		id, int(outcome)))
+	if err != nil {
+		slog.Error("resolve dispute update failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to resolve dispute")
+	}
+	if err := loadDisputeEvidence(ctx, tx, d); err != nil {
+		slog.Error("load dispute evidence failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to resolve dispute")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("resolve dispute commit failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to resolve dispute")
+	}
+
+	slog.Info("Dispute resolved", "id", id, "payment_id", d.GetPaymentId(), "outcome", outcome.String(), "lost_cents", lost)
+	return connect.NewResponse(d), nil
+}
+
+// chargeBack records a provider chargeback of amount (or the payment's whole
+// net amount when nil) as a lost dispute: the payment's OPEN dispute, if it
+// has one, or a new dispute otherwise. The loss is added to disputed_cents,
+// posted to the ledger and taken off the payment's invoices by loseDispute.
+func chargeBack(ctx context.Context, tx pgx.Tx, paymentID string, amount *moneypb.Money) error {
+	p, err := scanPayment(tx.QueryRow(ctx,
+		`SELECT `+paymentColumns+` FROM payments WHERE id=$1 FOR UPDATE`, paymentID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.NotFound, "payment not found")
+		}
+		slog.Error("chargeback payment load failed", "error", err, "payment_id", paymentID)
+		return status.Error(codes.Internal, "failed to record chargeback")
+	}
+	amountCents, err := disputeAmount(p, &paymentv1.CreateDisputeRequest{Amount: amount})
+	if err != nil {
+		return err
+	}
+
+	lost := int(paymentv1.DisputeStatus_DISPUTE_STATUS_LOST)
+	d, err := scanDispute(tx.QueryRow(ctx,
+		`UPDATE disputes SET amount_cents=$3, status=$4, resolved_at=NOW(), updated_at=NOW()
+         WHERE payment_id=$1 AND status=$2
+         RETURNING `+disputeColumns,
+		paymentID, int(paymentv1.DisputeStatus_DISPUTE_STATUS_OPEN), amountCents, lost))
+	if errors.Is(err, pgx.ErrNoRows) {
+		d, err = scanDispute(tx.QueryRow(ctx,
+			`INSERT INTO disputes (payment_id, amount_cents, currency_code, reason, status, evidence_due_at, resolved_at)
+             VALUES ($1, $2, $3, 'processor chargeback', $4, NOW(), NOW())
+             RETURNING `+disputeColumns,
+			paymentID, amountCents, p.GetAmount().GetCurrencyCode(), lost))
+	}
+	if err != nil {
+		slog.Error("chargeback dispute write failed", "error", err, "payment_id", paymentID)
+		return status.Error(codes.Internal, "failed to record chargeback")
+	}
+	charged, err := loseDispute(ctx, tx, d)
+	if err != nil {
+		return err
+	}
+	slog.Info("Chargeback recorded", "dispute_id", d.GetId(), "payment_id", paymentID, "lost_cents", charged)
+	return nil
+}
+
+// lockOpenDispute loads dispute id FOR UPDATE and rejects it with
+// codes.FailedPrecondition unless it is OPEN.
+func lockOpenDispute(ctx context.Context, tx pgx.Tx, id, op string) (*paymentv1.Dispute, error) {
+	d, err := scanDispute(tx.QueryRow(ctx, `SELECT `+disputeColumns+` FROM disputes WHERE id=$1 FOR UPDATE`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "dispute not found")
+		}
+		slog.Error("dispute load failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to update dispute")
+	}
+	if d.GetStatus() != paymentv1.DisputeStatus_DISPUTE_STATUS_OPEN {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot %s a dispute in status %s", op, d.GetStatus())
+	}
+	return d, nil
+}
+
+// loseDispute deducts d from its payment's net amount, posts the loss to the
+// ledger and reopens the invoices the payment paid by the same amount.
+// Refunds made while the dispute was open may have lowered the net amount
+// below d.amount; only what remains is lost. It returns the amount deducted.
+func loseDispute(ctx context.Context, tx pgx.Tx, d *paymentv1.Dispute) (int64, error) {
+	p, err := scanPayment(tx.QueryRow(ctx,
+		`SELECT `+paymentColumns+` FROM payments WHERE id=$1 FOR UPDATE`, d.GetPaymentId()))
+	if err != nil {
+		slog.Error("lost dispute payment load failed", "error", err, "id", d.GetId())
+		return 0, status.Error(codes.Internal, "failed to resolve dispute")
+	}
+	lost := min(storedMinor(d.GetAmount()), storedMinor(p.GetNetAmount()))
+	if lost <= 0 {
+		return 0, nil
+	}
+	if _, err := tx.Exec(ctx,
+		`UPDATE payments SET disputed_cents=disputed_cents+$2, updated_at=NOW() WHERE id=$1`,
+		p.GetId(), lost); err != nil {
+		slog.Error("lost dispute payment update failed", "error", err, "id", d.GetId())
+		return 0, status.Error(codes.Internal, "failed to resolve dispute")
+	}
+	if err := postJournal(ctx, tx, ledger.DisputeLost(p.GetId(), p.GetInvoiceId(), p.GetAmount().GetCurrencyCode(), lost)); err != nil {
+		slog.Error("lost dispute ledger failed", "error", err, "id", d.GetId())
+		return 0, status.Error(codes.Internal, "failed to resolve dispute")
+	}
+	// The amount lost is owed again on the invoices the payment paid.
+	if err := reopenInvoices(ctx, tx, p.GetId(), p.GetAmount().GetCurrencyCode(), lost); err != nil {
+		return 0, err
+	}
+	return lost, nil
+}
+
+// loadDisputeEvidence fills in the evidence of ds, oldest first.
+func loadDisputeEvidence(ctx context.Context, q queryer, ds ...*paymentv1.Dispute) error {
+	if len(ds) == 0 {
+		return nil
+	}
+	byID := make(map[string]*paymentv1.Dispute, len(ds))
+	ids := make([]string, 0, len(ds))
+	for _, d := range ds {
+		d.Evidence = nil
+		byID[d.GetId()] = d
+		ids = append(ids, d.GetId())
+	}
+
+	rows, err := q.Query(ctx,
+		`SELECT dispute_id, note, created_at FROM dispute_evidence
+         WHERE dispute_id = ANY($1::text[]::uuid[]) ORDER BY dispute_id, created_at, id`, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			disputeID, note string
+			createdAt       time.Time
+		)
+		if err := rows.Scan(&disputeID, &note, &createdAt); err != nil {
+			return err
+		}
+		d := byID[disputeID]
+		d.Evidence = append(d.Evidence, &paymentv1.DisputeEvidence{Note: note, CreateTime: timestamppb.New(createdAt)})
+	}
+	return rows.Err()
+}
+
+// scanDispute reads a row selected with disputeColumns. Evidence is loaded
+// separately by loadDisputeEvidence.
+func scanDispute(row pgx.Row) (*paymentv1.Dispute, error) {
+	var (
+		id, paymentID, currency, reason string
+		amountCents                     int64
+		st                              int32
+		dueAt, createdAt, updatedAt     time.Time
+		resolvedAt                      *time.Time
+	)
+	if err := row.Scan(&id, &paymentID, &amountCents, &currency, &reason, &st, &dueAt, &createdAt, &updatedAt, &resolvedAt); err != nil {
+		return nil, err
+	}
+	amount, err := money.FromMinor(amountCents, currency)
+	if err != nil {
+		return nil, err
+	}
+	d := &paymentv1.Dispute{
+		Id:              id,
+		PaymentId:       paymentID,
+		Amount:          amount,
+		Reason:          reason,
+		Status:          paymentv1.DisputeStatus(st),
+		EvidenceDueTime: timestamppb.New(dueAt),
+		CreateTime:      timestamppb.New(createdAt),
+		UpdateTime:      timestamppb.New(updatedAt),
+	}
+	if resolvedAt != nil {
+		d.ResolveTime = timestamppb.New(*resolvedAt)
+	}
+	return d, nil
+}
@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"testing"
+
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDisputeAmount(t *testing.T) {
+	usd := func(units int64) *money.Money { return &money.Money{CurrencyCode: "USD", Units: units} }
+	p := &paymentv1.Payment{
+		Status:    paymentv1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED,
+		Amount:    usd(100),
+		NetAmount: usd(60),
+	}
+
+	if got, err := disputeAmount(p, &paymentv1.CreateDisputeRequest{}); err != nil || got != 6000 {
+		t.Fatalf("default amount = %d, %v; want 6000", got, err)
+	}
+	if got, err := disputeAmount(p, &paymentv1.CreateDisputeRequest{Amount: usd(25)}); err != nil || got != 2500 {
+		t.Fatalf("explicit amount = %d, %v; want 2500", got, err)
+	}
+
+	unsettled := &paymentv1.Payment{Status: paymentv1.PaymentStatus_PAYMENT_STATUS_AUTHORIZED, Amount: usd(100), NetAmount: usd(0)}
+	refunded := &paymentv1.Payment{Status: paymentv1.PaymentStatus_PAYMENT_STATUS_REFUNDED, Amount: usd(100), NetAmount: usd(0)}
+	tests := map[string]struct {
+		p    *paymentv1.Payment
+		req  *paymentv1.CreateDisputeRequest
+		code codes.Code
+	}{
+		"over net":     {p, &paymentv1.CreateDisputeRequest{Amount: usd(61)}, codes.FailedPrecondition},
+		"zero":         {p, &paymentv1.CreateDisputeRequest{Amount: usd(0)}, codes.InvalidArgument},
+		"currency":     {p, &paymentv1.CreateDisputeRequest{Amount: &money.Money{CurrencyCode: "EUR", Units: 1}}, codes.InvalidArgument},
+		"not settled":  {unsettled, &paymentv1.CreateDisputeRequest{}, codes.FailedPrecondition},
+		"nothing left": {refunded, &paymentv1.CreateDisputeRequest{}, codes.FailedPrecondition},
+	}
+	for name, tt := range tests {
+		if _, err := disputeAmount(tt.p, tt.req); status.Code(err) != tt.code {
+			t.Errorf("%s: got %v, want %s", name, err, tt.code)
+		}
+	}
+}
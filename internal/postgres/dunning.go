@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/grpc-buf/internal/dunning"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	webhookv1 "github.com/grpc-buf/internal/gen/proto/webhook"
+	"github.com/jackc/pgx/v5"
+)
+
+// MarkOverdueInvoices flags up to limit open invoices due before today as
+// overdue and queues an invoice.overdue webhook for each, in one
+// transaction. Rows locked by another replica are skipped.
+func (s *Store) MarkOverdueInvoices(ctx context.Context, today time.Time, limit int) (int, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer rollback(ctx, tx)
+
+	invs, err := collectInvoices(tx.Query(ctx,
+		`UPDATE invoices SET overdue_at=NOW(), updated_at=NOW()
+         WHERE id IN (
+             SELECT id FROM invoices
+             WHERE NOT paid AND cancelled_at IS NULL AND overdue_at IS NULL AND due_date < $1::date
+             ORDER BY due_date
+             LIMIT $2
+             FOR UPDATE SKIP LOCKED)
+         RETURNING `+invoiceColumns, today, limit))
+	if err != nil {
+		return 0, fmt.Errorf("mark overdue: %w", err)
+	}
+	if err := loadLineItems(ctx, tx, invs...); err != nil {
+		return 0, fmt.Errorf("load line items: %w", err)
+	}
+	for _, inv := range invs {
+		if err := enqueueWebhook(ctx, tx, webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_INVOICE_OVERDUE, inv); err != nil {
+			return 0, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	for _, inv := range invs {
+		slog.Info("Invoice overdue", "id", inv.GetId())
+	}
+	return len(invs), nil
+}
+
+// SendInvoiceReminders claims up to limit unpaid invoices that have reached
+// their next reminder in schedule (days after the due date), records the
+// latest reminder due for each in invoice_reminders and queues an
+// invoice.reminder webhook, in one transaction. Rows locked by another
+// replica are skipped.
+func (s *Store) SendInvoiceReminders(ctx context.Context, today time.Time, schedule []int, limit int) (int, error) {
+	if len(schedule) == 0 {
+		return 0, nil
+	}
+	days := make([]int32, len(schedule))
+	for i, d := range schedule {
+		days[i] = int32(d)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer rollback(ctx, tx)
+
+	// ($2)[reminders_sent + 1] is the day offset of the next reminder; NULL
+	// once the schedule is exhausted, which excludes the row.
+	invs, err := collectInvoices(tx.Query(ctx,
+		`SELECT `+invoiceColumns+` FROM invoices
+         WHERE NOT paid AND cancelled_at IS NULL AND due_date IS NOT NULL
+           AND due_date + ($2::int[])[reminders_sent + 1] <= $1::date
+         ORDER BY due_date
+         LIMIT $3
+         FOR UPDATE SKIP LOCKED`, today, days, limit))
+	if err != nil {
+		return 0, fmt.Errorf("claim reminders: %w", err)
+	}
+	if err := loadLineItems(ctx, tx, invs...); err != nil {
+		return 0, fmt.Errorf("load line items: %w", err)
+	}
+
+	sent := 0
+	for _, inv := range invs {
+		due, err := calendarDate("due_date", inv.GetDueDate())
+		if err != nil {
+			return 0, err
+		}
+		overdue := int(today.Sub(due).Hours() / 24)
+		next := dunning.NextReminder(schedule, overdue, int(inv.GetRemindersSent()))
+		if next == 0 {
+			continue
+		}
+		if _, err := tx.Exec(ctx,
+			`UPDATE invoices SET reminders_sent=$2 WHERE id=$1`, inv.GetId(), next); err != nil {
+			return 0, fmt.Errorf("update reminders_sent: %w", err)
+		}
+		inv.RemindersSent = int32(next)
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO invoice_reminders (invoice_id, reminder, days_after_due) VALUES ($1, $2, $3)`,
+			inv.GetId(), next, overdue); err != nil {
+			return 0, fmt.Errorf("record reminder: %w", err)
+		}
+		reminder := &paymentv1.InvoiceReminder{Invoice: inv, Reminder: int32(next), DaysOverdue: int32(overdue)}
+		if err := enqueueWebhook(ctx, tx, webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_INVOICE_REMINDER, reminder); err != nil {
+			return 0, err
+		}
+		slog.Info("Invoice reminder queued", "id", inv.GetId(), "reminder", next, "days_overdue", overdue)
+		sent++
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return sent, nil
+}
+
+// collectInvoices scans every row selected with invoiceColumns.
+func collectInvoices(rows pgx.Rows, err error) ([]*paymentv1.Invoice, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*paymentv1.Invoice
+	for rows.Next() {
+		inv, err := scanInvoice(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, inv)
+	}
+	return out, rows.Err()
+}
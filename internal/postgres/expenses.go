@@ -9,66 +9,83 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/grpc-buf/internal/auth"
 	expensev1 "github.com/grpc-buf/internal/gen/proto/expense"
+	"github.com/grpc-buf/internal/money"
+	"github.com/grpc-buf/internal/security"
 	"github.com/jackc/pgx/v5"
-	"google.golang.org/genproto/googleapis/type/money"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const nanosPerCent = 10_000_000
-
-func moneyToCents(m *money.Money) int64 {
-	if m == nil {
-		return 0
+// expenseScope returns the user whose expenses the caller may act on, given
+// the user_id and all_users named in the request. Every caller, admins
+// included, defaults to their own expenses. Only callers with
+// security.RoleAdmin may opt out explicitly: naming another user_id scopes
+// the call to that user and all_users (returned as "") to every user.
+func expenseScope(ctx context.Context, requested string, allUsers bool) (string, error) {
+	caller, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "authentication required")
 	}
-	return m.GetUnits()*100 + int64(m.GetNanos())/nanosPerCent
-}
-
-func centsToMoney(cents int64, currency string) *money.Money {
-	units := cents / 100
-	rem := int32(cents % 100)
-	return &money.Money{
-		CurrencyCode: currency,
-		Units:        units,
-		Nanos:        rem * nanosPerCent,
+	requested = strings.TrimSpace(requested)
+	if caller.HasRole(security.RoleAdmin) {
+		switch {
+		case allUsers && requested != "":
+			return "", status.Error(codes.InvalidArgument, "user_id and all_users are mutually exclusive")
+		case allUsers:
+			return "", nil
+		case requested != "":
+			return requested, nil
+		}
+	} else if allUsers || requested != "" && requested != caller.Subject {
+		return "", status.Error(codes.PermissionDenied, "cannot access another user's expenses")
+	}
+	if caller.Subject == "" {
+		// Callers without a user of their own, such as the MCP operator,
+		// must name one.
+		return "", status.Error(codes.InvalidArgument, "user_id is required")
 	}
+	return caller.Subject, nil
 }
 
 // CreateExpense inserts a new expense row and returns it with its generated id
-// and timestamps.
+// and timestamps. user_id defaults to the caller; only admins may create
+// expenses for other users.
 func (s *Store) CreateExpense(ctx context.Context, req *connect.Request[expensev1.CreateExpenseRequest]) (*connect.Response[expensev1.Expense], error) {
 	exp := req.Msg.GetExpense()
 	if exp == nil {
 		return nil, status.Error(codes.InvalidArgument, "expense is required")
 	}
-	if strings.TrimSpace(exp.GetUserId()) == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	userID, err := expenseScope(ctx, exp.GetUserId(), false)
+	if err != nil {
+		return nil, err
 	}
-	if exp.Amount == nil || exp.Amount.CurrencyCode == "" {
-		return nil, status.Error(codes.InvalidArgument, "amount currency_code is required")
+	amountMinor, err := minorUnits("amount", exp.GetAmount())
+	if err != nil {
+		return nil, err
 	}
 
 	var (
 		id                     string
 		createTime, updateTime time.Time
 	)
-	err := s.db.QueryRow(ctx,
+	err = s.db.QueryRow(ctx,
 		`INSERT INTO expenses (user_id, amount_cents, currency_code, category, description)
          VALUES ($1, $2, $3, $4, $5)
          RETURNING id, created_at, updated_at`,
-		exp.GetUserId(), moneyToCents(exp.GetAmount()), exp.GetAmount().GetCurrencyCode(), exp.GetCategory(), exp.GetDescription(),
+		userID, amountMinor, exp.GetAmount().GetCurrencyCode(), exp.GetCategory(), exp.GetDescription(),
 	).Scan(&id, &createTime, &updateTime)
 	if err != nil {
-		slog.Error("create expense query failed", "error", err, "user_id", exp.GetUserId())
+		slog.Error("create expense query failed", "error", err, "user_id", userID)
 		return nil, status.Error(codes.Internal, "failed to create expense")
 	}
 
 	return connect.NewResponse(&expensev1.Expense{
 		Id:          id,
-		UserId:      exp.GetUserId(),
+		UserId:      userID,
 		Amount:      exp.GetAmount(),
 		Category:    exp.GetCategory(),
 		Description: exp.GetDescription(),
@@ -78,21 +95,26 @@ func (s *Store) CreateExpense(ctx context.Context, req *connect.Request[expensev
 }
 
 // GetExpense fetches a single expense by id. Returns codes.NotFound when the
-// row does not exist.
+// row does not exist or belongs to another user than user_id, which defaults
+// to the caller.
 func (s *Store) GetExpense(ctx context.Context, req *connect.Request[expensev1.GetExpenseRequest]) (*connect.Response[expensev1.Expense], error) {
 	id := strings.TrimSpace(req.Msg.GetId())
 	if id == "" {
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
+	owner, err := expenseScope(ctx, req.Msg.GetUserId(), false)
+	if err != nil {
+		return nil, err
+	}
 
 	var (
 		userID, currency, category, description string
 		amountCents                             int64
 		createdAt, updatedAt                    time.Time
 	)
-	err := s.db.QueryRow(ctx,
+	err = s.db.QueryRow(ctx,
 		`SELECT user_id, amount_cents, currency_code, category, description, created_at, updated_at
-         FROM expenses WHERE id=$1`, id,
+         FROM expenses WHERE id=$1 AND user_id::text=$2`, id, owner,
 	).Scan(&userID, &amountCents, &currency, &category, &description, &createdAt, &updatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -101,11 +123,16 @@ func (s *Store) GetExpense(ctx context.Context, req *connect.Request[expensev1.G
 		slog.Error("get expense query failed", "error", err, "id", id)
 		return nil, status.Error(codes.Internal, "failed to get expense")
 	}
+	amount, err := money.FromMinor(amountCents, currency)
+	if err != nil {
+		slog.Error("get expense amount decode failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to get expense")
+	}
 
 	return connect.NewResponse(&expensev1.Expense{
 		Id:          id,
 		UserId:      userID,
-		Amount:      centsToMoney(amountCents, currency),
+		Amount:      amount,
 		Category:    category,
 		Description: description,
 		CreateTime:  timestamppb.New(createdAt),
@@ -114,10 +141,14 @@ func (s *Store) GetExpense(ctx context.Context, req *connect.Request[expensev1.G
 }
 
 // ListExpenses returns a page of expenses ordered by creation time (newest
-// first), optionally filtered by user_id. Pagination uses opaque "o:<offset>"
-// tokens.
+// first), filtered by user_id, which defaults to the caller; only admins may
+// list other users' expenses or, with all_users, everyone's. Pagination uses
+// opaque "o:<offset>" tokens.
 func (s *Store) ListExpenses(ctx context.Context, req *connect.Request[expensev1.ListExpensesRequest]) (*connect.Response[expensev1.ListExpensesResponse], error) {
-	userID := strings.TrimSpace(req.Msg.GetUserId())
+	userID, err := expenseScope(ctx, req.Msg.GetUserId(), req.Msg.GetAllUsers())
+	if err != nil {
+		return nil, err
+	}
 	pageSize := req.Msg.GetPageSize()
 	if pageSize <= 0 || pageSize > 1000 {
 		pageSize = 50
@@ -130,10 +161,7 @@ func (s *Store) ListExpenses(ctx context.Context, req *connect.Request[expensev1
 		}
 	}
 
-	var (
-		rows pgx.Rows
-		err  error
-	)
+	var rows pgx.Rows
 	if userID == "" {
 		rows, err = s.db.Query(ctx,
 			`SELECT id, user_id, amount_cents, currency_code, category, description, created_at, updated_at
@@ -142,7 +170,7 @@ func (s *Store) ListExpenses(ctx context.Context, req *connect.Request[expensev1
 	} else {
 		rows, err = s.db.Query(ctx,
 			`SELECT id, user_id, amount_cents, currency_code, category, description, created_at, updated_at
-             FROM expenses WHERE user_id=$1 ORDER BY created_at DESC LIMIT $2 OFFSET $3`, userID, pageSize, offset,
+             FROM expenses WHERE user_id::text=$1 ORDER BY created_at DESC LIMIT $2 OFFSET $3`, userID, pageSize, offset,
 		)
 	}
 	if err != nil {
@@ -162,10 +190,15 @@ func (s *Store) ListExpenses(ctx context.Context, req *connect.Request[expensev1
 			slog.Error("list expenses scan failed", "error", err)
 			return nil, status.Error(codes.Internal, "failed to list expenses")
 		}
+		amount, err := money.FromMinor(amountCents, currency)
+		if err != nil {
+			slog.Error("list expenses amount decode failed", "error", err, "id", id)
+			return nil, status.Error(codes.Internal, "failed to list expenses")
+		}
 		resp.Expenses = append(resp.Expenses, &expensev1.Expense{
 			Id:          id,
 			UserId:      uid,
-			Amount:      centsToMoney(amountCents, currency),
+			Amount:      amount,
 			Category:    category,
 			Description: description,
 			CreateTime:  timestamppb.New(createdAt),
@@ -182,13 +215,71 @@ func (s *Store) ListExpenses(ctx context.Context, req *connect.Request[expensev1
 	return connect.NewResponse(resp), nil
 }
 
+// SummarizeExpenses totals expenses within an optional [start_time, end_time)
+// creation window, converted into target_currency. user_id is scoped like
+// ListExpenses.
+func (s *Store) SummarizeExpenses(ctx context.Context, req *connect.Request[expensev1.SummarizeExpensesRequest]) (*connect.Response[expensev1.SummarizeExpensesResponse], error) {
+	target, on, err := reportTarget(req.Msg.GetTargetCurrency(), req.Msg.GetRateDate())
+	if err != nil {
+		return nil, err
+	}
+	start, end := req.Msg.GetStartTime(), req.Msg.GetEndTime()
+	if start != nil && end != nil && !start.AsTime().Before(end.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "start_time must be before end_time")
+	}
+
+	userID, err := expenseScope(ctx, req.Msg.GetUserId(), req.Msg.GetAllUsers())
+	if err != nil {
+		return nil, err
+	}
+
+	conds := []string{}
+	args := []any{}
+	if userID != "" {
+		args = append(args, userID)
+		conds = append(conds, fmt.Sprintf("user_id::text=$%d", len(args)))
+	}
+	if start != nil {
+		args = append(args, start.AsTime())
+		conds = append(conds, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if end != nil {
+		args = append(args, end.AsTime())
+		conds = append(conds, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	totals, err := s.queryCurrencyTotals(ctx,
+		`SELECT currency_code, SUM(amount_cents)::bigint
+         FROM expenses `+where+`
+         GROUP BY currency_code ORDER BY currency_code`, args...)
+	if err != nil {
+		slog.Error("summarize expenses query failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to summarize expenses")
+	}
+	total, breakdown, err := s.convertTotals(ctx, totals, target, on)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&expensev1.SummarizeExpensesResponse{Total: total, ByCurrency: breakdown}), nil
+}
+
 // UpdateExpense applies a field-mask update to an expense row. Supported
-// mask paths: category, description, amount.
+// mask paths: category, description, amount. Returns codes.NotFound when the
+// row does not exist or belongs to another user than expense.user_id, which
+// defaults to the caller.
 func (s *Store) UpdateExpense(ctx context.Context, req *connect.Request[expensev1.UpdateExpenseRequest]) (*connect.Response[expensev1.Expense], error) {
 	exp := req.Msg.GetExpense()
 	if exp == nil || strings.TrimSpace(exp.GetId()) == "" {
 		return nil, status.Error(codes.InvalidArgument, "expense.id is required")
 	}
+	owner, err := expenseScope(ctx, exp.GetUserId(), false)
+	if err != nil {
+		return nil, err
+	}
 
 	paths := map[string]bool{}
 	if mask := req.Msg.GetUpdateMask(); mask != nil {
@@ -211,31 +302,44 @@ func (s *Store) UpdateExpense(ctx context.Context, req *connect.Request[expensev
 		idx++
 	}
 	if paths["amount"] && exp.Amount != nil {
+		amountMinor, err := minorUnits("amount", exp.GetAmount())
+		if err != nil {
+			return nil, err
+		}
 		set = append(set, fmt.Sprintf("amount_cents=$%d,currency_code=$%d", idx, idx+1))
-		args = append(args, moneyToCents(exp.Amount), exp.Amount.GetCurrencyCode())
+		args = append(args, amountMinor, exp.Amount.GetCurrencyCode())
 		idx += 2
 	}
 	if len(set) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "update_mask has no supported fields")
 	}
-	args = append(args, exp.GetId())
+	args = append(args, exp.GetId(), owner)
 
-	_, err := s.db.Exec(ctx, fmt.Sprintf("UPDATE expenses SET %s, updated_at=NOW() WHERE id=$%d", strings.Join(set, ","), idx), args...)
+	res, err := s.db.Exec(ctx, fmt.Sprintf("UPDATE expenses SET %s, updated_at=NOW() WHERE id=$%d AND user_id::text=$%d",
+		strings.Join(set, ","), idx, idx+1), args...)
 	if err != nil {
 		slog.Error("update expense query failed", "error", err, "id", exp.GetId())
 		return nil, status.Error(codes.Internal, "failed to update expense")
 	}
-	return s.GetExpense(ctx, connect.NewRequest(&expensev1.GetExpenseRequest{Id: exp.GetId()}))
+	if res.RowsAffected() == 0 {
+		return nil, status.Error(codes.NotFound, "expense not found")
+	}
+	return s.GetExpense(ctx, connect.NewRequest(&expensev1.GetExpenseRequest{Id: exp.GetId(), UserId: owner}))
 }
 
 // DeleteExpense removes an expense by id. Returns codes.NotFound when no row
-// matched. Returns an empty response on success (AIP-135).
+// matched or it belongs to another user than user_id, which defaults to the
+// caller. Returns an empty response on success (AIP-135).
 func (s *Store) DeleteExpense(ctx context.Context, req *connect.Request[expensev1.DeleteExpenseRequest]) (*connect.Response[emptypb.Empty], error) {
 	id := strings.TrimSpace(req.Msg.GetId())
 	if id == "" {
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
-	res, err := s.db.Exec(ctx, `DELETE FROM expenses WHERE id=$1`, id)
+	owner, err := expenseScope(ctx, req.Msg.GetUserId(), false)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.db.Exec(ctx, `DELETE FROM expenses WHERE id=$1 AND user_id::text=$2`, id, owner)
 	if err != nil {
 		slog.Error("delete expense query failed", "error", err, "id", id)
 		return nil, status.Error(codes.Internal, "failed to delete expense")
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grpc-buf/internal/auth"
+	"github.com/grpc-buf/internal/security"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestExpenseScope(t *testing.T) {
+	alice := auth.WithUser(context.Background(), auth.User{Subject: "alice"})
+	admin := auth.WithUser(context.Background(), auth.User{Subject: "root", Roles: []string{security.RoleAdmin}})
+	operator := auth.WithUser(context.Background(), auth.User{Roles: []string{security.RoleAdmin}})
+
+	cases := []struct {
+		name      string
+		ctx       context.Context
+		requested string
+		allUsers  bool
+		want      string
+		code      codes.Code
+	}{
+		{"no caller", context.Background(), "", false, "", codes.Unauthenticated},
+		{"defaults to caller", alice, "", false, "alice", codes.OK},
+		{"own user", alice, "alice", false, "alice", codes.OK},
+		{"other user", alice, "bob", false, "", codes.PermissionDenied},
+		{"all users", alice, "", true, "", codes.PermissionDenied},
+		{"admin defaults to self", admin, "", false, "root", codes.OK},
+		{"admin names user", admin, "bob", false, "bob", codes.OK},
+		{"admin opts into everyone", admin, "", true, "", codes.OK},
+		{"admin names user and everyone", admin, "bob", true, "", codes.InvalidArgument},
+		{"operator must name user", operator, "", false, "", codes.InvalidArgument},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := expenseScope(c.ctx, c.requested, c.allUsers)
+			if code := status.Code(err); code != c.code {
+				t.Fatalf("code = %v, want %v (err %v)", code, c.code, err)
+			}
+			if got != c.want {
+				t.Fatalf("scope = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/grpc-buf/internal/fraud"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fraudHistory counts earlier attempts in fraud_checks for fraud.Checker.
+type fraudHistory struct {
+	db *pgxpool.Pool
+}
+
+func (h fraudHistory) TokenAttempts(ctx context.Context, token string, since time.Time) (int, error) {
+	var n int
+	err := h.db.QueryRow(ctx,
+		`SELECT COUNT(*) FROM fraud_checks WHERE card_token=$1 AND created_at > $2`, token, since).Scan(&n)
+	return n, err
+}
+
+func (h fraudHistory) IPTokens(ctx context.Context, ip, exclude string, since time.Time) (int, error) {
+	var n int
+	err := h.db.QueryRow(ctx,
+		`SELECT COUNT(DISTINCT card_token) FROM fraud_checks
+         WHERE client_ip=$1 AND card_token <> $2 AND created_at > $3`, ip, exclude, since).Scan(&n)
+	return n, err
+}
+
+// record stores a and its result in fraud_checks and returns the row id.
+func (h fraudHistory) record(ctx context.Context, a fraud.Attempt, r fraud.Result) (string, error) {
+	reasons := r.Reasons
+	if reasons == nil {
+		reasons = []string{}
+	}
+	var id string
+	err := h.db.QueryRow(ctx,
+		`INSERT INTO fraud_checks (card_token, client_ip, decision, reasons) VALUES ($1, NULLIF($2, ''), $3, $4)
+         RETURNING id`,
+		a.CardToken, a.ClientIP, int(r.Decision), reasons).Scan(&id)
+	return id, err
+}
+
+// fraudLog is where screening reads earlier attempts and records new ones.
+type fraudLog interface {
+	fraud.History
+	record(ctx context.Context, a fraud.Attempt, r fraud.Result) (string, error)
+}
+
+// screenPayment runs the fraud rules on a and records the attempt and its
+// decision in fraud_checks outside any transaction, so attempts that are
+// later denied or declined still count towards the velocity rules. It
+// returns the result and the fraud_checks id, and the result's error when the
+// attempt is denied.
+func (s *Store) screenPayment(ctx context.Context, a fraud.Attempt) (fraud.Result, string, error) {
+	return s.screen(ctx, fraudHistory{db: s.db}, a)
+}
+
+// screen implements screenPayment on checks. When checks fail, the attempt is
+// refused with codes.Internal unless payments.fraud.on_error is review; then
+// it is charged for review, and the id is empty if it was not recorded.
+func (s *Store) screen(ctx context.Context, checks fraudLog, a fraud.Attempt) (fraud.Result, string, error) {
+	r, err := s.fraud.Check(ctx, checks, a)
+	if err != nil {
+		slog.Error("fraud check failed", "error", err)
+		return fraud.Result{}, "", status.Error(codes.Internal, "failed to screen payment")
+	}
+	id, err := checks.record(ctx, a, r)
+	if err != nil {
+		slog.Error("record fraud check failed", "error", err)
+		var ok bool
+		if r, ok = s.fraud.Unrecorded(r); !ok {
+			return fraud.Result{}, "", status.Error(codes.Internal, "failed to screen payment")
+		}
+	}
+	if err := r.Err(); err != nil {
+		slog.Warn("Payment denied by fraud checks", "check_id", id, "reasons", r.Reasons,
+			"amount_cents", a.Amount, "currency", a.Currency)
+		return r, id, err
+	}
+	return r, id, nil
+}
+
+// linkFraudCheck records in tx that fraud check checkID led to paymentID. An
+// empty checkID, an attempt that could not be recorded, is skipped.
+func linkFraudCheck(ctx context.Context, tx pgx.Tx, checkID, paymentID string) error {
+	if checkID == "" {
+		return nil
+	}
+	if _, err := tx.Exec(ctx, `UPDATE fraud_checks SET payment_id=$2 WHERE id=$1`, checkID, paymentID); err != nil {
+		slog.Error("Error linking fraud check", "error", err, "id", paymentID)
+		return status.Error(codes.Internal, "failed to store payment")
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/grpc-buf/internal/config"
+	"github.com/grpc-buf/internal/fraud"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// brokenFraudLog fails every query, as when the database is unreachable.
+type brokenFraudLog struct{ err error }
+
+func (l brokenFraudLog) TokenAttempts(context.Context, string, time.Time) (int, error) {
+	return 0, l.err
+}
+
+func (l brokenFraudLog) IPTokens(context.Context, string, string, time.Time) (int, error) {
+	return 0, l.err
+}
+
+func (l brokenFraudLog) record(context.Context, fraud.Attempt, fraud.Result) (string, error) {
+	return "", l.err
+}
+
+func TestScreenOnDatabaseError(t *testing.T) {
+	attempt := fraud.Attempt{CardToken: "tok_visa", ClientIP: "203.0.113.7", Amount: 500, Currency: "USD"}
+	checks := brokenFraudLog{err: errors.New("connection refused")}
+	for _, tt := range []struct {
+		onError  string
+		velocity int
+		code     codes.Code
+	}{
+		{"", 5, codes.Internal},
+		{"deny", 0, codes.Internal},
+		{"review", 5, codes.OK},
+		{"review", 0, codes.OK},
+	} {
+		checker, err := fraud.NewChecker(config.FraudConfig{
+			TokenVelocity: config.VelocityRule{Max: tt.velocity, Window: "1h"},
+			OnError:       tt.onError,
+		})
+		if err != nil {
+			t.Fatalf("NewChecker: %v", err)
+		}
+		s := &Store{fraud: checker}
+		r, id, err := s.screen(context.Background(), checks, attempt)
+		if status.Code(err) != tt.code {
+			t.Fatalf("on_error=%q velocity=%d: screen = %v, want %v", tt.onError, tt.velocity, err, tt.code)
+		}
+		if err == nil && (r.Decision != paymentv1.FraudDecision_FRAUD_DECISION_REVIEW || id != "") {
+			t.Errorf("on_error=%q velocity=%d: got %v, id %q; want REVIEW and no id", tt.onError, tt.velocity, r.Decision, id)
+		}
+	}
+}
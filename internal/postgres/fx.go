@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/grpc-buf/internal/fx"
+	fxv1 "github.com/grpc-buf/internal/gen/proto/fx"
+	"github.com/grpc-buf/internal/money"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/genproto/googleapis/type/date"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LatestRate implements fx.Source over the fx_rates table.
+func (s *Store) LatestRate(ctx context.Context, base, quote string, on time.Time) (fx.Rate, error) {
+	var (
+		value    string
+		rateDate time.Time
+	)
+	err := s.db.QueryRow(ctx,
+		`SELECT rate::text, rate_date FROM fx_rates
+         WHERE base_currency=$1 AND quote_currency=$2 AND rate_date <= $3
+         ORDER BY rate_date DESC LIMIT 1`, base, quote, on).Scan(&value, &rateDate)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fx.Rate{}, fmt.Errorf("%w for %s/%s", fx.ErrNoRate, base, quote)
+		}
+		return fx.Rate{}, fmt.Errorf("query fx rate: %w", err)
+	}
+	v, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return fx.Rate{}, fmt.Errorf("decode fx rate %q", value)
+	}
+	return fx.Rate{Base: base, Quote: quote, Date: fx.Day(rateDate), Value: v}, nil
+}
+
+// LoadFXRates upserts rates in one transaction, replacing any existing rate
+// for the same pair and date. It returns the number of rates written.
+func (s *Store) LoadFXRates(ctx context.Context, rates []fx.Rate) (int, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin fx load: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	for _, r := range rates {
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO fx_rates (base_currency, quote_currency, rate_date, rate)
+             VALUES ($1, $2, $3, $4::numeric)
+             ON CONFLICT (base_currency, quote_currency, rate_date)
+             DO UPDATE SET rate=EXCLUDED.rate, updated_at=NOW()`,
+			r.Base, r.Quote, r.Date, r.Value.FloatString(18)); err != nil {
+			return 0, fmt.Errorf("upsert fx rate %s/%s %s: %w", r.Base, r.Quote, r.Date.Format(time.DateOnly), err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("commit fx load: %w", err)
+	}
+	return len(rates), nil
+}
+
+// currencyTotal is a per-currency subtotal in minor units.
+type currencyTotal struct {
+	currency string
+	minor    int64
+}
+
+// reportTarget validates a report's target currency and rate date, defaulting
+// the date to today (UTC).
+func reportTarget(target string, rateDate *date.Date) (string, time.Time, error) {
+	code, ok := money.NormalizeCurrency(target)
+	if !ok {
+		return "", time.Time{}, status.Error(codes.InvalidArgument, "target_currency must be a supported ISO 4217 code")
+	}
+	if rateDate == nil {
+		return code, fx.Day(time.Now()), nil
+	}
+	on, err := calendarDate("rate_date", rateDate)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return code, on, nil
+}
+
+// calendarDate converts d to midnight UTC, rejecting partial dates such as
+// a year and month without a day, and impossible ones such as February 30.
+func calendarDate(field string, d *date.Date) (time.Time, error) {
+	on := time.Date(int(d.GetYear()), time.Month(d.GetMonth()), int(d.GetDay()), 0, 0, 0, 0, time.UTC)
+	if d.GetYear() == 0 || d.GetMonth() == 0 || d.GetDay() == 0 ||
+		on.Day() != int(d.GetDay()) || on.Month() != time.Month(d.GetMonth()) {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "%s must be a full calendar date", field)
+	}
+	return on, nil
+}
+
+// protoDate returns the calendar date of t.
+func protoDate(t time.Time) *date.Date {
+	return &date.Date{Year: int32(t.Year()), Month: int32(t.Month()), Day: int32(t.Day())}
+}
+
+// convertTotals converts each subtotal into target using rates on or before
+// on and returns the grand total with the per-currency breakdown. A missing
+// rate is reported as codes.FailedPrecondition.
+func (s *Store) convertTotals(ctx context.Context, totals []currencyTotal, target string, on time.Time) (*moneypb.Money, []*fxv1.ConvertedTotal, error) {
+	conv := fx.NewConverter(s)
+	sum, err := money.FromMinor(0, target)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var breakdown []*fxv1.ConvertedTotal
+	for _, t := range totals {
+		original, err := money.FromMinor(t.minor, t.currency)
+		if err != nil {
+			slog.Error("report subtotal decode failed", "error", err, "currency", t.currency)
+			return nil, nil, status.Error(codes.Internal, "failed to convert totals")
+		}
+		c, err := conv.Convert(ctx, original, target, on)
+		if err != nil {
+			if errors.Is(err, fx.ErrNoRate) {
+				return nil, nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
+			slog.Error("report conversion failed", "error", err, "currency", t.currency, "target", target)
+			return nil, nil, status.Error(codes.Internal, "failed to convert totals")
+		}
+		if sum, err = money.Add(sum, c.Amount); err != nil {
+			slog.Error("report total overflow", "error", err, "target", target)
+			return nil, nil, status.Error(codes.Internal, "failed to convert totals")
+		}
+		ct := &fxv1.ConvertedTotal{Original: original, Converted: c.Amount, Rate: "1"}
+		if c.Rate != nil {
+			ct.Rate = fx.FormatRate(c.Rate.Value)
+			ct.RateDate = protoDate(c.Rate.Date)
+		}
+		breakdown = append(breakdown, ct)
+	}
+	return sum, breakdown, nil
+}
+
+// queryCurrencyTotals runs a "currency_code, SUM(...)" grouping query.
+func (s *Store) queryCurrencyTotals(ctx context.Context, sql string, args ...any) ([]currencyTotal, error) {
+	rows, err := s.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var totals []currencyTotal
+	for rows.Next() {
+		var t currencyTotal
+		if err := rows.Scan(&t.currency, &t.minor); err != nil {
+			return nil, err
+		}
+		t.currency = strings.TrimSpace(t.currency)
+		totals = append(totals, t)
+	}
+	return totals, rows.Err()
+}
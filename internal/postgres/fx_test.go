@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReportTarget(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		rateDate *date.Date
+		wantCode string
+		wantOn   time.Time
+		wantErr  bool
+	}{
+		{name: "explicit date", target: "usd", rateDate: &date.Date{Year: 2026, Month: 2, Day: 28}, wantCode: "USD", wantOn: time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{name: "unknown currency", target: "XXX", wantErr: true},
+		{name: "empty currency", target: "", wantErr: true},
+		{name: "partial date", target: "EUR", rateDate: &date.Date{Year: 2026, Month: 2}, wantErr: true},
+		{name: "impossible date", target: "EUR", rateDate: &date.Date{Year: 2026, Month: 2, Day: 30}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, on, err := reportTarget(tt.target, tt.rateDate)
+			if tt.wantErr {
+				if status.Code(err) != codes.InvalidArgument {
+					t.Fatalf("reportTarget err = %v, want InvalidArgument", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("reportTarget: %v", err)
+			}
+			if code != tt.wantCode || !on.Equal(tt.wantOn) {
+				t.Fatalf("reportTarget = %s %v, want %s %v", code, on, tt.wantCode, tt.wantOn)
+			}
+		})
+	}
+
+	if _, on, err := reportTarget("USD", nil); err != nil || on.Hour() != 0 || on.Location() != time.UTC {
+		t.Fatalf("reportTarget default date = %v, %v", on, err)
+	}
+}
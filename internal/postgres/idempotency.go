@@ -0,0 +1,189 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-buf/internal/auth"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// IdempotencyKeyHeader is the request header clients may use instead of the
+// idempotency_key request field to make payment retries safe.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentReplayedHeader is set on responses that were replayed from a
+// stored idempotency record rather than freshly executed.
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+const (
+	maxIdempotencyKeyLen  = 255
+	defaultIdempotencyTTL = 24 * time.Hour
+	// DefaultIdempotencyPruneInterval applies when
+	// payments.idempotency_prune_interval is unset.
+	DefaultIdempotencyPruneInterval = time.Hour
+)
+
+// resolveIdempotencyKey picks the idempotency key from the header or the
+// request field. Either may be empty; if both are set they must agree.
+func resolveIdempotencyKey(h http.Header, field string) (string, error) {
+	header := strings.TrimSpace(h.Get(IdempotencyKeyHeader))
+	field = strings.TrimSpace(field)
+	key := header
+	if key == "" {
+		key = field
+	} else if field != "" && field != header {
+		return "", status.Error(codes.InvalidArgument, "idempotency key header and field disagree")
+	}
+	if len(key) > maxIdempotencyKeyLen {
+		return "", status.Error(codes.InvalidArgument, "idempotency key is too long")
+	}
+	return key, nil
+}
+
+// requestHash fingerprints msg for idempotency comparison. The
+// idempotency_key field, when present, is cleared first so the same payload
+// hashes identically whether the key came from the header or the body.
+func requestHash(msg proto.Message) ([]byte, error) {
+	m := proto.Clone(msg)
+	if fd := m.ProtoReflect().Descriptor().Fields().ByName("idempotency_key"); fd != nil {
+		m.ProtoReflect().Clear(fd)
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(b)
+	return sum[:], nil
+}
+
+// idempotencyTTL returns the configured key lifetime, falling back to 24h when
+// unset or unparsable (config.Validate rejects bad values at startup).
+func (s *Store) idempotencyTTL() time.Duration {
+	if v := strings.TrimSpace(s.pay.IdempotencyTTL); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			return d
+		}
+	}
+	return defaultIdempotencyTTL
+}
+
+// idempotencyScope qualifies scope with the authenticated caller, so two
+// callers that pick the same key neither replay nor block each other.
+// Unauthenticated and operator calls keep the bare scope.
+func idempotencyScope(ctx context.Context, scope string) string {
+	if u, ok := auth.UserFromContext(ctx); ok && u.Subject != "" {
+		return scope + ":" + u.Subject
+	}
+	return scope
+}
+
+// PruneIdempotencyKeys deletes keys that expired at or before now and
+// returns how many were removed.
+func (s *Store) PruneIdempotencyKeys(ctx context.Context, now time.Time) (int, error) {
+	tag, err := s.db.Exec(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= $1`, now)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// NewIdempotencyPruner returns a Pruner that deletes expired idempotency
+// keys, which are otherwise only removed when the same key is used again. An
+// invalid or unset interval falls back to DefaultIdempotencyPruneInterval;
+// config.Validate rejects it at startup.
+func NewIdempotencyPruner(store DataStore, interval string) *Pruner {
+	return newPruner("idempotency keys", store.PruneIdempotencyKeys, interval, DefaultIdempotencyPruneInterval)
+}
+
+// idempotent runs do inside tx guarded by key within scope. With an empty key
+// it simply runs do. Otherwise the key is claimed in tx before do runs and the
+// response is stored in the same transaction, so a failed attempt leaves no
+// record and may be retried. A replay with a matching request returns the
+// stored response unmarshalled into replay and true; a mismatching request
+// returns codes.AlreadyExists. Keys are per caller: scope is qualified with
+// the authenticated subject.
+func idempotent[T proto.Message](ctx context.Context, tx pgx.Tx, ttl time.Duration, scope, key string, req proto.Message, replay T, do func() (T, error)) (T, bool, error) {
+	var zero T
+	if key == "" {
+		resp, err := do()
+		return resp, false, err
+	}
+	scope = idempotencyScope(ctx, scope)
+
+	hash, err := requestHash(req)
+	if err != nil {
+		slog.Error("idempotency request hash failed", "error", err, "scope", scope)
+		return zero, false, status.Error(codes.Internal, "failed to process idempotency key")
+	}
+
+	// Expired keys are treated as never seen.
+	if _, err := tx.Exec(ctx,
+		`DELETE FROM idempotency_keys WHERE scope=$1 AND idem_key=$2 AND expires_at <= NOW()`,
+		scope, key); err != nil {
+		slog.Error("idempotency expiry delete failed", "error", err, "scope", scope)
+		return zero, false, status.Error(codes.Internal, "failed to process idempotency key")
+	}
+	// A concurrent request holding the same key blocks here until it commits
+	// or rolls back, so at most one of them executes do.
+	tag, err := tx.Exec(ctx,
+		`INSERT INTO idempotency_keys (scope, idem_key, request_hash, expires_at)
+         VALUES ($1, $2, $3, $4)
+         ON CONFLICT (scope, idem_key) DO NOTHING`,
+		scope, key, hash, time.Now().Add(ttl))
+	if err != nil {
+		slog.Error("idempotency claim failed", "error", err, "scope", scope)
+		return zero, false, status.Error(codes.Internal, "failed to process idempotency key")
+	}
+
+	if tag.RowsAffected() == 0 {
+		var storedHash, stored []byte
+		err := tx.QueryRow(ctx,
+			`SELECT request_hash, response FROM idempotency_keys WHERE scope=$1 AND idem_key=$2`,
+			scope, key).Scan(&storedHash, &stored)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return zero, false, status.Error(codes.Aborted, "idempotency key was released concurrently; retry")
+			}
+			slog.Error("idempotency lookup failed", "error", err, "scope", scope)
+			return zero, false, status.Error(codes.Internal, "failed to process idempotency key")
+		}
+		if !bytes.Equal(storedHash, hash) {
+			return zero, false, status.Error(codes.AlreadyExists, "idempotency key already used with a different request")
+		}
+		if stored == nil {
+			return zero, false, status.Error(codes.Aborted, "request with this idempotency key is still in progress")
+		}
+		if err := proto.Unmarshal(stored, replay); err != nil {
+			slog.Error("idempotency response decode failed", "error", err, "scope", scope)
+			return zero, false, status.Error(codes.Internal, "failed to process idempotency key")
+		}
+		return replay, true, nil
+	}
+
+	resp, err := do()
+	if err != nil {
+		return zero, false, err
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(resp)
+	if err != nil {
+		slog.Error("idempotency response encode failed", "error", err, "scope", scope)
+		return zero, false, status.Error(codes.Internal, "failed to process idempotency key")
+	}
+	if _, err := tx.Exec(ctx,
+		`UPDATE idempotency_keys SET response=$3 WHERE scope=$1 AND idem_key=$2`,
+		scope, key, b); err != nil {
+		slog.Error("idempotency response store failed", "error", err, "scope", scope)
+		return zero, false, status.Error(codes.Internal, "failed to process idempotency key")
+	}
+	return resp, false, nil
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/grpc-buf/internal/auth"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResolveIdempotencyKey(t *testing.T) {
+	cases := []struct {
+		name   string
+		header string
+		field  string
+		want   string
+		code   codes.Code
+	}{
+		{"none", "", "", "", codes.OK},
+		{"header only", "k1", "", "k1", codes.OK},
+		{"field only", "", "k2", "k2", codes.OK},
+		{"both agree", "k3", "k3", "k3", codes.OK},
+		{"both disagree", "k3", "k4", "", codes.InvalidArgument},
+		{"too long", strings.Repeat("x", maxIdempotencyKeyLen+1), "", "", codes.InvalidArgument},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			h := http.Header{}
+			if c.header != "" {
+				h.Set(IdempotencyKeyHeader, c.header)
+			}
+			got, err := resolveIdempotencyKey(h, c.field)
+			if status.Code(err) != c.code {
+				t.Fatalf("code = %v, want %v (err %v)", status.Code(err), c.code, err)
+			}
+			if got != c.want {
+				t.Fatalf("key = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestRequestHashIgnoresIdempotencyKey(t *testing.T) {
+	base := &paymentv1.PaymentRequest{
+		CardToken:    "tok_1",
+		Name:         "Jane",
+		AddressLines: []string{"1 Main St"},
+		Amount:       &money.Money{CurrencyCode: "USD", Units: 10},
+	}
+	withKey := &paymentv1.PaymentRequest{
+		CardToken:      "tok_1",
+		Name:           "Jane",
+		AddressLines:   []string{"1 Main St"},
+		Amount:         &money.Money{CurrencyCode: "USD", Units: 10},
+		IdempotencyKey: "k1",
+	}
+	h1, err := requestHash(base)
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+	h2, err := requestHash(withKey)
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+	if !bytes.Equal(h1, h2) {
+		t.Fatalf("expected idempotency_key to be excluded from the hash")
+	}
+	if withKey.GetIdempotencyKey() != "k1" {
+		t.Fatalf("requestHash must not mutate its input")
+	}
+
+	changed := &paymentv1.PaymentRequest{
+		CardToken:    "tok_1",
+		Name:         "Jane",
+		AddressLines: []string{"1 Main St"},
+		Amount:       &money.Money{CurrencyCode: "USD", Units: 11},
+	}
+	h3, err := requestHash(changed)
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+	if bytes.Equal(h1, h3) {
+		t.Fatalf("expected different payloads to hash differently")
+	}
+}
+
+func TestIdempotencyScopeIsPerCaller(t *testing.T) {
+	ctx := context.Background()
+	if got := idempotencyScope(ctx, "MakePayment"); got != "MakePayment" {
+		t.Errorf("unauthenticated scope = %q", got)
+	}
+	alice := idempotencyScope(auth.WithUser(ctx, auth.User{Subject: "alice"}), "MakePayment")
+	bob := idempotencyScope(auth.WithUser(ctx, auth.User{Subject: "bob"}), "MakePayment")
+	if alice == bob || !strings.HasPrefix(alice, "MakePayment") {
+		t.Errorf("scopes for alice and bob = %q, %q", alice, bob)
+	}
+	if got := idempotencyScope(auth.WithUser(ctx, auth.User{Roles: []string{"admin"}}), "MakePayment"); got != "MakePayment" {
+		t.Errorf("operator scope = %q", got)
+	}
+}
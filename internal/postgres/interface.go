@@ -13,10 +13,22 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/grpc-buf/internal/config"
+	"github.com/grpc-buf/internal/dunning"
+	"github.com/grpc-buf/internal/fraud"
+	"github.com/grpc-buf/internal/fx"
 	expensev1 "github.com/grpc-buf/internal/gen/proto/expense"
 	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
 	userv1 "github.com/grpc-buf/internal/gen/proto/registration"
+	subscriptionv1 "github.com/grpc-buf/internal/gen/proto/subscription"
+	tokenizationv1 "github.com/grpc-buf/internal/gen/proto/tokenization"
+	webhookv1 "github.com/grpc-buf/internal/gen/proto/webhook"
 	"github.com/grpc-buf/internal/postgres/migrations"
+	"github.com/grpc-buf/internal/processor"
+	"github.com/grpc-buf/internal/reconcile"
+	"github.com/grpc-buf/internal/security"
+	"github.com/grpc-buf/internal/subscription"
+	"github.com/grpc-buf/internal/vault"
+	"github.com/grpc-buf/internal/webhook"
 	"github.com/jackc/pgx/v5/pgxpool"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -26,14 +38,75 @@ import (
 // services and the MCP transport.
 type DataStore interface {
 	MakePayment(ctx context.Context, req *connect.Request[paymentv1.PaymentRequest]) (*connect.Response[paymentv1.PaymentResponse], error)
+	GetPayment(ctx context.Context, req *connect.Request[paymentv1.GetPaymentRequest]) (*connect.Response[paymentv1.Payment], error)
+	ListPayments(ctx context.Context, req *connect.Request[paymentv1.ListPaymentsRequest]) (*connect.Response[paymentv1.ListPaymentsResponse], error)
+	SummarizePayments(ctx context.Context, req *connect.Request[paymentv1.SummarizePaymentsRequest]) (*connect.Response[paymentv1.SummarizePaymentsResponse], error)
+	GetLedgerBalances(ctx context.Context, req *connect.Request[paymentv1.GetLedgerBalancesRequest]) (*connect.Response[paymentv1.GetLedgerBalancesResponse], error)
+	ReconcileSettlement(ctx context.Context, req *connect.Request[paymentv1.ReconcileSettlementRequest]) (*connect.Response[paymentv1.ReconcileSettlementResponse], error)
+	CapturePayment(ctx context.Context, req *connect.Request[paymentv1.CapturePaymentRequest]) (*connect.Response[paymentv1.Payment], error)
+	VoidPayment(ctx context.Context, req *connect.Request[paymentv1.VoidPaymentRequest]) (*connect.Response[paymentv1.Payment], error)
+	RefundPayment(ctx context.Context, req *connect.Request[paymentv1.RefundPaymentRequest]) (*connect.Response[paymentv1.Payment], error)
+	CreateRefund(ctx context.Context, req *connect.Request[paymentv1.CreateRefundRequest]) (*connect.Response[paymentv1.Refund], error)
+	GetRefund(ctx context.Context, req *connect.Request[paymentv1.GetRefundRequest]) (*connect.Response[paymentv1.Refund], error)
+	ListRefunds(ctx context.Context, req *connect.Request[paymentv1.ListRefundsRequest]) (*connect.Response[paymentv1.ListRefundsResponse], error)
+	CreateDispute(ctx context.Context, req *connect.Request[paymentv1.CreateDisputeRequest]) (*connect.Response[paymentv1.Dispute], error)
+	GetDispute(ctx context.Context, req *connect.Request[paymentv1.GetDisputeRequest]) (*connect.Response[paymentv1.Dispute], error)
+	ListDisputes(ctx context.Context, req *connect.Request[paymentv1.ListDisputesRequest]) (*connect.Response[paymentv1.ListDisputesResponse], error)
+	AddDisputeEvidence(ctx context.Context, req *connect.Request[paymentv1.AddDisputeEvidenceRequest]) (*connect.Response[paymentv1.Dispute], error)
+	ResolveDispute(ctx context.Context, req *connect.Request[paymentv1.ResolveDisputeRequest]) (*connect.Response[paymentv1.Dispute], error)
+	// Invoice APIs
+	CreateInvoice(ctx context.Context, req *connect.Request[paymentv1.CreateInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error)
+	GetInvoice(ctx context.Context, req *connect.Request[paymentv1.GetInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error)
+	ListInvoices(ctx context.Context, req *connect.Request[paymentv1.ListInvoicesRequest]) (*connect.Response[paymentv1.ListInvoicesResponse], error)
+	CancelInvoice(ctx context.Context, req *connect.Request[paymentv1.CancelInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error)
+	MarkInvoicePaid(ctx context.Context, req *connect.Request[paymentv1.MarkInvoicePaidRequest]) (*connect.Response[paymentv1.Invoice], error)
+	PayInvoice(ctx context.Context, req *connect.Request[paymentv1.PayInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error)
+	PayInvoices(ctx context.Context, req *connect.Request[paymentv1.PayInvoicesRequest]) (*connect.Response[paymentv1.PayInvoicesResponse], error)
 	LoginUser(ctx context.Context, req *connect.Request[userv1.LoginRequest]) (*connect.Response[userv1.LoginResponse], error)
+	RefreshToken(ctx context.Context, req *connect.Request[userv1.RefreshTokenRequest]) (*connect.Response[userv1.LoginResponse], error)
+	LogoutUser(ctx context.Context, req *connect.Request[userv1.LogoutRequest]) (*connect.Response[userv1.LogoutResponse], error)
+	RevokeUserSessions(ctx context.Context, req *connect.Request[userv1.RevokeUserSessionsRequest]) (*connect.Response[userv1.RevokeUserSessionsResponse], error)
 	RegisterUser(ctx context.Context, req *connect.Request[userv1.RegisterRequest]) (*connect.Response[userv1.RegisterResponse], error)
 	// Expense APIs
 	CreateExpense(ctx context.Context, req *connect.Request[expensev1.CreateExpenseRequest]) (*connect.Response[expensev1.Expense], error)
 	GetExpense(ctx context.Context, req *connect.Request[expensev1.GetExpenseRequest]) (*connect.Response[expensev1.Expense], error)
 	ListExpenses(ctx context.Context, req *connect.Request[expensev1.ListExpensesRequest]) (*connect.Response[expensev1.ListExpensesResponse], error)
+	SummarizeExpenses(ctx context.Context, req *connect.Request[expensev1.SummarizeExpensesRequest]) (*connect.Response[expensev1.SummarizeExpensesResponse], error)
 	UpdateExpense(ctx context.Context, req *connect.Request[expensev1.UpdateExpenseRequest]) (*connect.Response[expensev1.Expense], error)
 	DeleteExpense(ctx context.Context, req *connect.Request[expensev1.DeleteExpenseRequest]) (*connect.Response[emptypb.Empty], error)
+	// Webhook APIs
+	CreateWebhookEndpoint(ctx context.Context, req *connect.Request[webhookv1.CreateWebhookEndpointRequest]) (*connect.Response[webhookv1.WebhookEndpoint], error)
+	ListWebhookEndpoints(ctx context.Context, req *connect.Request[webhookv1.ListWebhookEndpointsRequest]) (*connect.Response[webhookv1.ListWebhookEndpointsResponse], error)
+	DeleteWebhookEndpoint(ctx context.Context, req *connect.Request[webhookv1.DeleteWebhookEndpointRequest]) (*connect.Response[emptypb.Empty], error)
+	ListWebhookDeliveries(ctx context.Context, req *connect.Request[webhookv1.ListWebhookDeliveriesRequest]) (*connect.Response[webhookv1.ListWebhookDeliveriesResponse], error)
+	ReplayWebhookDelivery(ctx context.Context, req *connect.Request[webhookv1.ReplayWebhookDeliveryRequest]) (*connect.Response[webhookv1.WebhookDelivery], error)
+	// Subscription APIs
+	CreateSubscription(ctx context.Context, req *connect.Request[subscriptionv1.CreateSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error)
+	GetSubscription(ctx context.Context, req *connect.Request[subscriptionv1.GetSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error)
+	ListSubscriptions(ctx context.Context, req *connect.Request[subscriptionv1.ListSubscriptionsRequest]) (*connect.Response[subscriptionv1.ListSubscriptionsResponse], error)
+	PauseSubscription(ctx context.Context, req *connect.Request[subscriptionv1.PauseSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error)
+	ResumeSubscription(ctx context.Context, req *connect.Request[subscriptionv1.ResumeSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error)
+	CancelSubscription(ctx context.Context, req *connect.Request[subscriptionv1.CancelSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error)
+	// Card vault
+	TokenizeCard(ctx context.Context, req *connect.Request[tokenizationv1.TokenizeCardRequest]) (*connect.Response[tokenizationv1.CardToken], error)
+	// Webhook delivery queue, drained by webhook.Dispatcher
+	webhook.Queue
+	// Overdue detection and reminders, driven by dunning.Job
+	dunning.Store
+	// Period invoicing, driven by subscription.Scheduler
+	subscription.Store
+	// Provider webhook events
+	ApplyProcessorEvent(ctx context.Context, ev processor.Event) (processor.EventStatus, error)
+	// FX rates
+	LoadFXRates(ctx context.Context, rates []fx.Rate) (int, error)
+	// Settlement reconciliation, also run by cmd/reconcile
+	Reconcile(ctx context.Context, entries []reconcile.Entry, from, to time.Time) (reconcile.Report, error)
+	// Expired idempotency keys, deleted by the idempotency pruner
+	PruneIdempotencyKeys(ctx context.Context, now time.Time) (int, error)
+	// Expired refresh tokens, deleted by the refresh token pruner
+	PruneRefreshTokens(ctx context.Context, now time.Time) (int, error)
+	// Revoked access tokens, consulted by the JWT auth interceptor
+	Denylist() security.Denylist
 	// Health
 	Ping(ctx context.Context) error
 	Close()
@@ -41,8 +114,20 @@ type DataStore interface {
 
 // Store is the pgx-backed implementation of DataStore.
 type Store struct {
-	db  *pgxpool.Pool
-	sec config.SecurityConfig
+	db   *pgxpool.Pool
+	sec  config.SecurityConfig
+	pay  config.PaymentsConfig
+	proc processor.PaymentProcessor
+	// fraud screens MakePayment attempts before they are charged.
+	fraud *fraud.Checker
+	// vault seals card numbers for TokenizeCard; nil while no key is set.
+	vault *vault.Cipher
+	// recon maps the columns of settlement files.
+	recon config.ReconciliationConfig
+	// deny records revoked access tokens.
+	deny security.Denylist
+	// hooks decides which webhook endpoint URLs are accepted.
+	hooks config.WebhooksConfig
 }
 
 // NewDatabaseConnection creates a PostgreSQL pool from process environment
@@ -73,6 +158,21 @@ func NewDatabaseConnection(ctx context.Context) (DataStore, error) {
 // and optionally runs migrations. It returns an error instead of panicking so
 // callers can report and exit gracefully.
 func NewDatabaseConnectionFromConfig(ctx context.Context, cfg *config.Config) (DataStore, error) {
+	proc, err := processor.New(cfg.Payments.Processor)
+	if err != nil {
+		return nil, fmt.Errorf("configure payment processor: %w", err)
+	}
+	checker, err := fraud.NewChecker(cfg.Payments.Fraud)
+	if err != nil {
+		return nil, fmt.Errorf("configure fraud rules: %w", err)
+	}
+	var cardVault *vault.Cipher
+	if k := strings.TrimSpace(cfg.Payments.Vault.Key); k != "" {
+		if cardVault, err = vault.NewCipher(k); err != nil {
+			return nil, fmt.Errorf("configure card vault: %w", err)
+		}
+	}
+
 	connectionString := cfg.Database.URL
 	if strings.ToLower(cfg.Environment) == "dev" && connectionString == "" {
 		slog.Info("Connecting to PostgreSQL local (dev)")
@@ -116,7 +216,13 @@ func NewDatabaseConnectionFromConfig(ctx context.Context, cfg *config.Config) (D
 		slog.Info("Skipping migrations as configured")
 	}
 
-	return &Store{db: pool, sec: cfg.Security}, nil
+	store := &Store{db: pool, sec: cfg.Security, pay: cfg.Payments, proc: proc, fraud: checker, vault: cardVault, recon: cfg.Reconciliation, hooks: cfg.Webhooks}
+	if strings.EqualFold(strings.TrimSpace(cfg.Security.Denylist.Backend), config.DenylistMemory) {
+		store.deny = security.NewMemoryDenylist()
+	} else {
+		store.deny = &denylist{db: pool}
+	}
+	return store, nil
 }
 
 // waitForDatabase pings the pool with exponential backoff until it succeeds or
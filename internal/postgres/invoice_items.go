@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/grpc-buf/internal/billing"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"github.com/grpc-buf/internal/money"
+	"github.com/jackc/pgx/v5"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const lineItemColumns = `invoice_id, description, quantity, unit_price_cents, tax_rate_bps, subtotal_cents, discount_cents, tax_cents, total_cents`
+
+// queryer is the read side shared by the pool and transactions.
+type queryer interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// pricedInvoice is a validated CreateInvoice request with its computed totals.
+type pricedInvoice struct {
+	currency string
+	lines    []billing.Line
+	items    []*paymentv1.InvoiceLineItem
+	rateBps  *int32
+	totals   billing.Totals
+}
+
+// priceInvoice validates the line items and discount of inv and computes its
+// totals. Client-supplied amounts are rejected rather than ignored.
+func priceInvoice(inv *paymentv1.Invoice) (*pricedInvoice, error) {
+	if inv.GetAmount() != nil {
+		return nil, status.Error(codes.InvalidArgument, "amount is computed from line_items and must not be set")
+	}
+	items := inv.GetLineItems()
+	if len(items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one line item is required")
+	}
+
+	p := &pricedInvoice{}
+	for i, it := range items {
+		field := fmt.Sprintf("line_items[%d]", i)
+		desc := strings.TrimSpace(it.GetDescription())
+		if desc == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "%s.description is required", field)
+		}
+		price, err := minorUnits(field+".unit_price", it.GetUnitPrice())
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			p.currency = it.GetUnitPrice().GetCurrencyCode()
+		}
+		if it.GetUnitPrice().GetCurrencyCode() != p.currency {
+			return nil, status.Error(codes.InvalidArgument, "all line items must share one currency")
+		}
+		p.lines = append(p.lines, billing.Line{Quantity: it.GetQuantity(), UnitPrice: price, TaxRateBps: int64(it.GetTaxRateBps())})
+		p.items = append(p.items, &paymentv1.InvoiceLineItem{
+			Description: desc,
+			Quantity:    it.GetQuantity(),
+			UnitPrice:   it.GetUnitPrice(),
+			TaxRateBps:  it.GetTaxRateBps(),
+		})
+	}
+
+	var d billing.Discount
+	switch v := inv.GetDiscount().GetValue().(type) {
+	case *paymentv1.InvoiceDiscount_RateBps:
+		d.RateBps = int64(v.RateBps)
+		p.rateBps = &v.RateBps
+	case *paymentv1.InvoiceDiscount_AmountOff:
+		off, err := minorUnits("discount.amount_off", v.AmountOff)
+		if err != nil {
+			return nil, err
+		}
+		if v.AmountOff.GetCurrencyCode() != p.currency {
+			return nil, status.Error(codes.InvalidArgument, "discount.amount_off must be in the invoice currency")
+		}
+		if off <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "discount.amount_off must be positive")
+		}
+		d.AmountOff = off
+	}
+
+	totals, err := billing.Compute(p.lines, d)
+	switch {
+	case errors.Is(err, billing.ErrInvalidLine), errors.Is(err, billing.ErrInvalidDiscount), errors.Is(err, billing.ErrNoLines):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.InvalidArgument, "invoice amounts are out of range")
+	}
+	if totals.Total <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invoice total must be positive")
+	}
+	p.totals = totals
+	for i, a := range totals.Lines {
+		if err := setLineAmounts(p.items[i], p.currency, a); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invoice amounts are out of range")
+		}
+	}
+	return p, nil
+}
+
+// insertLineItems stores the priced line items of invoice id in tx.
+func insertLineItems(ctx context.Context, tx pgx.Tx, id string, p *pricedInvoice) error {
+	b := &pgx.Batch{}
+	for i, l := range p.lines {
+		a := p.totals.Lines[i]
+		b.Queue(`INSERT INTO invoice_line_items (invoice_id, position, description, quantity, unit_price_cents,
+                                                 tax_rate_bps, subtotal_cents, discount_cents, tax_cents, total_cents)
+                 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			id, i+1, p.items[i].GetDescription(), l.Quantity, l.UnitPrice, l.TaxRateBps, a.Subtotal, a.Discount, a.Tax, a.Total)
+	}
+	return tx.SendBatch(ctx, b).Close()
+}
+
+// loadLineItems fills in the line items of invs, in position order.
+func loadLineItems(ctx context.Context, q queryer, invs ...*paymentv1.Invoice) error {
+	if len(invs) == 0 {
+		return nil
+	}
+	byID := make(map[string]*paymentv1.Invoice, len(invs))
+	ids := make([]string, 0, len(invs))
+	for _, inv := range invs {
+		inv.LineItems = nil
+		byID[inv.GetId()] = inv
+		ids = append(ids, inv.GetId())
+	}
+
+	rows, err := q.Query(ctx,
+		`SELECT `+lineItemColumns+` FROM invoice_line_items
+         WHERE invoice_id = ANY($1::text[]::uuid[]) ORDER BY invoice_id, position`, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			invoiceID, desc string
+			quantity, price int64
+			rate            int32
+			a               billing.Amounts
+		)
+		if err := rows.Scan(&invoiceID, &desc, &quantity, &price, &rate, &a.Subtotal, &a.Discount, &a.Tax, &a.Total); err != nil {
+			return err
+		}
+		inv := byID[invoiceID]
+		currency := inv.GetAmount().GetCurrencyCode()
+		unit, err := money.FromMinor(price, currency)
+		if err != nil {
+			return err
+		}
+		it := &paymentv1.InvoiceLineItem{Description: desc, Quantity: quantity, UnitPrice: unit, TaxRateBps: rate}
+		if err := setLineAmounts(it, currency, a); err != nil {
+			return err
+		}
+		inv.LineItems = append(inv.LineItems, it)
+	}
+	return rows.Err()
+}
+
+// withLineItems is loadLineItems for RPC handlers: failures are logged and
+// reported as codes.Internal with msg.
+func withLineItems(ctx context.Context, q queryer, msg string, invs ...*paymentv1.Invoice) error {
+	if err := loadLineItems(ctx, q, invs...); err != nil {
+		slog.Error("load invoice line items failed", "error", err)
+		return status.Error(codes.Internal, msg)
+	}
+	return nil
+}
+
+// setLineAmounts sets the computed amounts of it from a.
+func setLineAmounts(it *paymentv1.InvoiceLineItem, currency string, a billing.Amounts) error {
+	var err error
+	for _, f := range []struct {
+		dst   **moneypb.Money
+		minor int64
+	}{
+		{&it.Subtotal, a.Subtotal},
+		{&it.Discount, a.Discount},
+		{&it.Tax, a.Tax},
+		{&it.Total, a.Total},
+	} {
+		if *f.dst, err = money.FromMinor(f.minor, currency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"testing"
+
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPriceInvoice(t *testing.T) {
+	eur := func(units int64) *money.Money { return &money.Money{CurrencyCode: "EUR", Units: units} }
+	p, err := priceInvoice(&paymentv1.Invoice{
+		LineItems: []*paymentv1.InvoiceLineItem{
+			{Description: " Hosting ", Quantity: 3, UnitPrice: eur(10), TaxRateBps: 1900},
+			{Description: "Setup", Quantity: 1, UnitPrice: eur(20)},
+		},
+		Discount: &paymentv1.InvoiceDiscount{Value: &paymentv1.InvoiceDiscount_RateBps{RateBps: 1000}},
+	})
+	if err != nil {
+		t.Fatalf("priceInvoice: %v", err)
+	}
+	if p.currency != "EUR" || p.rateBps == nil || *p.rateBps != 1000 {
+		t.Fatalf("currency %q, rate %v", p.currency, p.rateBps)
+	}
+	// 50.00 subtotal, 5.00 discount, 19% of 27.00 = 5.13 tax.
+	if got := p.totals.Amounts; got.Subtotal != 5000 || got.Discount != 500 || got.Tax != 513 || got.Total != 5013 {
+		t.Fatalf("totals = %+v", got)
+	}
+	if it := p.items[0]; it.GetDescription() != "Hosting" || it.GetTotal().GetUnits() != 32 || it.GetTotal().GetNanos() != 130_000_000 {
+		t.Fatalf("first item = %v", it)
+	}
+
+	for name, inv := range map[string]*paymentv1.Invoice{
+		"client amount":  {Amount: eur(1), LineItems: []*paymentv1.InvoiceLineItem{{Description: "x", Quantity: 1, UnitPrice: eur(1)}}},
+		"no items":       {},
+		"no description": {LineItems: []*paymentv1.InvoiceLineItem{{Quantity: 1, UnitPrice: eur(1)}}},
+		"mixed currency": {LineItems: []*paymentv1.InvoiceLineItem{
+			{Description: "a", Quantity: 1, UnitPrice: eur(1)},
+			{Description: "b", Quantity: 1, UnitPrice: &money.Money{CurrencyCode: "USD", Units: 1}},
+		}},
+		"zero quantity": {LineItems: []*paymentv1.InvoiceLineItem{{Description: "x", UnitPrice: eur(1)}}},
+		"zero total":    {LineItems: []*paymentv1.InvoiceLineItem{{Description: "x", Quantity: 1, UnitPrice: eur(0)}}},
+		"discount currency": {
+			LineItems: []*paymentv1.InvoiceLineItem{{Description: "x", Quantity: 1, UnitPrice: eur(5)}},
+			Discount:  &paymentv1.InvoiceDiscount{Value: &paymentv1.InvoiceDiscount_AmountOff{AmountOff: &money.Money{CurrencyCode: "USD", Units: 1}}},
+		},
+	} {
+		if _, err := priceInvoice(inv); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: err = %v, want InvalidArgument", name, err)
+		}
+	}
+}
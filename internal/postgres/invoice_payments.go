@@ -0,0 +1,287 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/grpc-buf/internal/fraud"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	webhookv1 "github.com/grpc-buf/internal/gen/proto/webhook"
+	"github.com/grpc-buf/internal/ledger"
+	"github.com/jackc/pgx/v5"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxAllocations caps the invoices one PayInvoices call may pay.
+const maxAllocations = 100
+
+// invoicePayment asks for amount, or the whole amount due when amount is
+// nil, to be applied to invoice id.
+type invoicePayment struct {
+	id     string
+	amount *moneypb.Money
+}
+
+// PayInvoices charges one captured payment against card_token and allocates
+// it across the requested invoices, which must share a currency. The invoices
+// are locked, charged and updated in one transaction, so either every
+// allocation is applied or none is. Retries under the same idempotency key
+// return the original response.
+func (s *Store) PayInvoices(ctx context.Context, req *connect.Request[paymentv1.PayInvoicesRequest]) (*connect.Response[paymentv1.PayInvoicesResponse], error) {
+	cardToken := strings.TrimSpace(req.Msg.GetCardToken())
+	cardType := req.Msg.GetCard()
+	if !validCardType(cardType) {
+		return nil, status.Error(codes.InvalidArgument, "card type is required")
+	}
+	if err := s.VerifyCardToken(ctx, cardToken); err != nil {
+		return nil, err
+	}
+	allocs := req.Msg.GetAllocations()
+	if len(allocs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "allocations are required")
+	}
+	if len(allocs) > maxAllocations {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d allocations are allowed", maxAllocations)
+	}
+	pays := make([]invoicePayment, 0, len(allocs))
+	seen := make(map[string]bool, len(allocs))
+	for _, a := range allocs {
+		id := strings.TrimSpace(a.GetInvoiceId())
+		if id == "" {
+			return nil, status.Error(codes.InvalidArgument, "allocations.invoice_id is required")
+		}
+		if seen[id] {
+			return nil, status.Error(codes.InvalidArgument, "allocations.invoice_id must be unique")
+		}
+		seen[id] = true
+		pays = append(pays, invoicePayment{id: id, amount: a.GetAmount()})
+	}
+	key, err := resolveIdempotencyKey(req.Header(), req.Msg.GetIdempotencyKey())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.beginProcessorTx(ctx)
+	if err != nil {
+		slog.Error("pay invoices begin failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to pay invoices")
+	}
+	defer rollback(ctx, tx)
+
+	res, replayed, err := idempotent(ctx, tx, s.idempotencyTTL(), "PayInvoices", key, req.Msg, &paymentv1.PayInvoicesResponse{},
+		func() (*paymentv1.PayInvoicesResponse, error) {
+			paymentID, invs, err := s.payInvoices(ctx, tx, cardToken, cardType, s.fraud.ClientIP(req), pays)
+			if err != nil {
+				return nil, err
+			}
+			p, err := scanPayment(tx.QueryRow(ctx, `SELECT `+paymentColumns+` FROM payments WHERE id=$1`, paymentID))
+			if err != nil {
+				slog.Error("pay invoices payment reload failed", "error", err, "payment_id", paymentID)
+				return nil, status.Error(codes.Internal, "failed to pay invoices")
+			}
+			return &paymentv1.PayInvoicesResponse{Payment: p, Invoices: invs}, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("pay invoices commit failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to pay invoices")
+	}
+
+	resp := connect.NewResponse(res)
+	if replayed {
+		resp.Header().Set(IdempotentReplayedHeader, "true")
+		return resp, nil
+	}
+	slog.Info("Invoices paid", "payment_id", res.GetPayment().GetId(), "invoices", len(res.GetInvoices()),
+		"amount_cents", storedMinor(res.GetPayment().GetAmount()), "currency", res.GetPayment().GetAmount().GetCurrencyCode())
+	return resp, nil
+}
+
+// payInvoices locks the invoices of pays, screens and charges their
+// allocations to cardToken, a card of cardType, as one captured payment and
+// applies each allocation, in tx. clientIP is the caller's address for the
+// fraud rules. It returns the payment id and the updated invoices in the
+// order of pays.
+func (s *Store) payInvoices(ctx context.Context, tx *processorTx, cardToken string, cardType paymentv1.CardType, clientIP string, pays []invoicePayment) (string, []*paymentv1.Invoice, error) {
+	// Lock in id order so concurrent payments sharing invoices cannot
+	// deadlock.
+	order := make([]int, len(pays))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(a, b int) int { return strings.Compare(pays[a].id, pays[b].id) })
+
+	allocs := make([]allocation, len(pays))
+	names := make([]string, len(pays))
+	var (
+		currency string
+		total    int64
+	)
+	for _, i := range order {
+		inv, err := lockOpenInvoice(ctx, tx, pays[i].id)
+		if err != nil {
+			return "", nil, err
+		}
+		cents, err := allocationAmount(inv, pays[i].amount)
+		if err != nil {
+			return "", nil, err
+		}
+		if c := inv.GetAmount().GetCurrencyCode(); currency == "" {
+			currency = c
+		} else if c != currency {
+			return "", nil, status.Error(codes.InvalidArgument, "invoices must share one currency")
+		}
+		allocs[i] = allocation{invoiceID: pays[i].id, amountCents: cents}
+		names[i] = inv.GetInvoiceName()
+		total += cents
+	}
+
+	return s.chargeInvoices(ctx, tx, fraudHistory{db: s.db}, fraud.Attempt{
+		CardToken: cardToken,
+		ClientIP:  clientIP,
+		Name:      strings.Join(names, ", "),
+		Amount:    total,
+		Currency:  currency,
+	}, cardType, allocs)
+}
+
+// chargeInvoices screens a, the payment of allocs, against the fraud rules
+// with checks, charges it to a card of cardType as one captured payment and
+// applies each allocation, in tx. Invoice payments count towards the velocity
+// rules like MakePayment attempts do.
+func (s *Store) chargeInvoices(ctx context.Context, tx *processorTx, checks fraudLog, a fraud.Attempt, cardType paymentv1.CardType, allocs []allocation) (string, []*paymentv1.Invoice, error) {
+	risk, checkID, err := s.screen(ctx, checks, a)
+	if err != nil {
+		return "", nil, err
+	}
+	paymentID, _, err := s.chargePayment(ctx, tx, newPayment{
+		cardToken:   a.CardToken,
+		cardType:    cardType,
+		name:        a.Name,
+		amountCents: a.Amount,
+		currency:    a.Currency,
+		allocations: allocs,
+		capture:     true,
+		fraud:       risk,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	if err := linkFraudCheck(ctx, tx, checkID, paymentID); err != nil {
+		return "", nil, err
+	}
+	invs := make([]*paymentv1.Invoice, len(allocs))
+	for i, a := range allocs {
+		if invs[i], err = applyInvoicePayment(ctx, tx, a.invoiceID, a.amountCents); err != nil {
+			return "", nil, err
+		}
+	}
+	return paymentID, invs, nil
+}
+
+// allocationAmount returns the minor units of amount to apply to inv, or its
+// whole amount due when amount is nil. Amounts in another currency, not
+// positive or above the amount due are rejected.
+func allocationAmount(inv *paymentv1.Invoice, amount *moneypb.Money) (int64, error) {
+	due := storedMinor(inv.GetAmountDue())
+	if amount == nil {
+		return due, nil
+	}
+	cents, err := minorUnits("amount", amount)
+	if err != nil {
+		return 0, err
+	}
+	if amount.GetCurrencyCode() != inv.GetAmount().GetCurrencyCode() {
+		return 0, status.Error(codes.InvalidArgument, "amount currency must match the invoice currency")
+	}
+	if cents <= 0 {
+		return 0, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+	if cents > due {
+		return 0, status.Error(codes.FailedPrecondition, "amount exceeds the amount due")
+	}
+	return cents, nil
+}
+
+// applyInvoicePayment adds cents to the invoice's amount paid, flags it paid
+// once nothing is left due and then queues the invoice.paid webhook, in tx.
+// The invoice must be locked and cents at most its amount due.
+func applyInvoicePayment(ctx context.Context, tx pgx.Tx, id string, cents int64) (*paymentv1.Invoice, error) {
+	inv, err := scanInvoice(tx.QueryRow(ctx,
+		`UPDATE invoices
+         SET amount_paid_cents = amount_paid_cents + $2, paid = amount_paid_cents + $2 >= amount_cents, updated_at=NOW()
+         WHERE id=$1
+         RETURNING `+invoiceColumns, id, cents))
+	if err != nil {
+		slog.Error("update invoice amount paid failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to update invoice")
+	}
+	if err := withLineItems(ctx, tx, "failed to update invoice", inv); err != nil {
+		return nil, err
+	}
+	if inv.GetPaid() {
+		if err := enqueueWebhook(ctx, tx, webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_INVOICE_PAID, inv); err != nil {
+			slog.Error("invoice paid webhook failed", "error", err, "id", id)
+			return nil, status.Error(codes.Internal, "failed to update invoice")
+		}
+	}
+	return inv, nil
+}
+
+// reopenInvoices takes charged, the amount lost to a chargeback, off the
+// invoices the payment paid, spread over its allocations in order, and posts
+// the same amounts back to receivables. An invoice is left unpaid once its
+// amount paid drops below its total. Each invoice gives back at most its
+// allocation and what is still paid on it, so later partial chargebacks move
+// on to the next allocation.
+func reopenInvoices(ctx context.Context, tx pgx.Tx, paymentID, currency string, charged int64) error {
+	rows, err := tx.Query(ctx,
+		`SELECT invoice_id, amount_cents FROM invoice_payments WHERE payment_id=$1 ORDER BY invoice_id`, paymentID)
+	if err != nil {
+		slog.Error("chargeback allocations query failed", "error", err, "payment_id", paymentID)
+		return status.Error(codes.Internal, "failed to update invoice")
+	}
+	allocs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (allocation, error) {
+		var a allocation
+		err := row.Scan(&a.invoiceID, &a.amountCents)
+		return a, err
+	})
+	if err != nil {
+		slog.Error("chargeback allocations scan failed", "error", err, "payment_id", paymentID)
+		return status.Error(codes.Internal, "failed to update invoice")
+	}
+	for _, a := range allocs {
+		if charged <= 0 {
+			break
+		}
+		var paid int64
+		if err := tx.QueryRow(ctx,
+			`SELECT amount_paid_cents FROM invoices WHERE id=$1 FOR UPDATE`, a.invoiceID).Scan(&paid); err != nil {
+			slog.Error("chargeback invoice load failed", "error", err, "invoice_id", a.invoiceID)
+			return status.Error(codes.Internal, "failed to update invoice")
+		}
+		reopened := min(a.amountCents, charged, paid)
+		if reopened <= 0 {
+			continue
+		}
+		if _, err := tx.Exec(ctx,
+			`UPDATE invoices SET amount_paid_cents = amount_paid_cents - $2, paid = amount_paid_cents - $2 >= amount_cents, updated_at=NOW()
+             WHERE id=$1`, a.invoiceID, reopened); err != nil {
+			slog.Error("chargeback invoice reopen failed", "error", err, "invoice_id", a.invoiceID)
+			return status.Error(codes.Internal, "failed to update invoice")
+		}
+		charged -= reopened
+		if err := postJournal(ctx, tx, ledger.InvoiceReopened(paymentID, a.invoiceID, currency, reopened)); err != nil {
+			slog.Error("chargeback invoice ledger failed", "error", err, "invoice_id", a.invoiceID)
+			return status.Error(codes.Internal, "failed to update invoice")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grpc-buf/internal/config"
+	"github.com/grpc-buf/internal/fraud"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAllocationAmount(t *testing.T) {
+	// 30.00 EUR invoice with 10.00 already paid.
+	inv := &paymentv1.Invoice{
+		Amount:    &money.Money{CurrencyCode: "EUR", Units: 30},
+		AmountDue: &money.Money{CurrencyCode: "EUR", Units: 20},
+	}
+	tests := []struct {
+		name   string
+		amount *money.Money
+		want   int64
+		code   codes.Code
+	}{
+		{"defaults to amount due", nil, 2000, codes.OK},
+		{"instalment", &money.Money{CurrencyCode: "EUR", Units: 5, Nanos: 500_000_000}, 550, codes.OK},
+		{"exactly due", &money.Money{CurrencyCode: "EUR", Units: 20}, 2000, codes.OK},
+		{"overpayment", &money.Money{CurrencyCode: "EUR", Units: 20, Nanos: 10_000_000}, 0, codes.FailedPrecondition},
+		{"zero", &money.Money{CurrencyCode: "EUR"}, 0, codes.InvalidArgument},
+		{"negative", &money.Money{CurrencyCode: "EUR", Units: -1}, 0, codes.InvalidArgument},
+		{"other currency", &money.Money{CurrencyCode: "USD", Units: 1}, 0, codes.InvalidArgument},
+		{"sub-minor unit", &money.Money{CurrencyCode: "EUR", Nanos: 5_000_000}, 0, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		got, err := allocationAmount(inv, tt.amount)
+		if status.Code(err) != tt.code {
+			t.Fatalf("%s: err = %v, want %v", tt.name, err, tt.code)
+		}
+		if got != tt.want {
+			t.Fatalf("%s: got %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+// velocityLog reports the same number of earlier attempts for every card
+// token and records each new attempt.
+type velocityLog struct {
+	attempts int
+	recorded []fraud.Attempt
+}
+
+func (l *velocityLog) TokenAttempts(context.Context, string, time.Time) (int, error) {
+	return l.attempts, nil
+}
+
+func (l *velocityLog) IPTokens(context.Context, string, string, time.Time) (int, error) {
+	return 0, nil
+}
+
+func (l *velocityLog) record(_ context.Context, a fraud.Attempt, _ fraud.Result) (string, error) {
+	l.recorded = append(l.recorded, a)
+	return "", nil
+}
+
+func TestChargeInvoicesScreensVelocity(t *testing.T) {
+	checker, err := fraud.NewChecker(config.FraudConfig{
+		TokenVelocity: config.VelocityRule{Action: "deny", Max: 3, Window: "1h"},
+	})
+	if err != nil {
+		t.Fatalf("NewChecker: %v", err)
+	}
+	proc := &recordingProcessor{}
+	s := &Store{proc: proc, fraud: checker}
+	checks := &velocityLog{attempts: 3}
+	a := fraud.Attempt{CardToken: "tok_visa", ClientIP: "203.0.113.7", Name: "Invoice 1", Amount: 2500, Currency: "USD"}
+
+	_, _, err = s.chargeInvoices(context.Background(), &processorTx{Tx: &stubTx{}}, checks, a,
+		paymentv1.CardType_CARD_TYPE_DEBIT, []allocation{{invoiceID: "inv_1", amountCents: 2500}})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("chargeInvoices = %v, want PermissionDenied", err)
+	}
+	if len(proc.calls) != 0 {
+		t.Fatalf("processor called for a denied payment: %q", proc.calls)
+	}
+	if len(checks.recorded) != 1 || checks.recorded[0] != a {
+		t.Fatalf("recorded attempts = %v, want the denied one", checks.recorded)
+	}
+}
+
+func TestPayInvoicesRequiresCardType(t *testing.T) {
+	s := &Store{}
+	for _, card := range []paymentv1.CardType{paymentv1.CardType_CARD_TYPE_UNSPECIFIED, paymentv1.CardType(99)} {
+		_, err := s.PayInvoice(context.Background(), connect.NewRequest(&paymentv1.PayInvoiceRequest{
+			Id: "inv_1", CardToken: "tok_visa", Card: card,
+		}))
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("PayInvoice(card %v) = %v, want InvalidArgument", card, err)
+		}
+		_, err = s.PayInvoices(context.Background(), connect.NewRequest(&paymentv1.PayInvoicesRequest{
+			CardToken: "tok_visa", Card: card, Allocations: []*paymentv1.InvoiceAllocation{{InvoiceId: "inv_1"}},
+		}))
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("PayInvoices(card %v) = %v, want InvalidArgument", card, err)
+		}
+	}
+}
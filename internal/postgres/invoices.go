@@ -0,0 +1,460 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"github.com/grpc-buf/internal/ledger"
+	"github.com/grpc-buf/internal/money"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const invoiceColumns = `id, invoice_name, amount_cents, currency_code, paid, cancelled_at, created_at, updated_at,
+    subtotal_cents, discount_cents, discount_rate_bps, tax_cents, due_date, overdue_at, reminders_sent, subscription_id,
+    amount_paid_cents`
+
+// CreateInvoice prices the invoice's line items, inserts it as open with its
+// items, books it as receivable in the ledger and returns it with its
+// generated id, timestamps and computed amounts.
+func (s *Store) CreateInvoice(ctx context.Context, req *connect.Request[paymentv1.CreateInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error) {
+	inv := req.Msg.GetInvoice()
+	if inv == nil {
+		return nil, status.Error(codes.InvalidArgument, "invoice is required")
+	}
+	name := strings.TrimSpace(inv.GetInvoiceName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "invoice_name is required")
+	}
+	priced, err := priceInvoice(inv)
+	if err != nil {
+		return nil, err
+	}
+	var dueDate *time.Time
+	if d := inv.GetDueDate(); d != nil {
+		on, err := calendarDate("due_date", d)
+		if err != nil {
+			return nil, err
+		}
+		dueDate = &on
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.Error("create invoice begin failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create invoice")
+	}
+	defer rollback(ctx, tx)
+
+	created, err := insertInvoice(ctx, tx, newInvoice{name: name, priced: priced, dueDate: dueDate})
+	if err != nil {
+		slog.Error("create invoice failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create invoice")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("create invoice commit failed", "error", err, "id", created.GetId())
+		return nil, status.Error(codes.Internal, "failed to create invoice")
+	}
+	return connect.NewResponse(created), nil
+}
+
+// newInvoice is an invoice to insert. Subscription invoices also carry the
+// subscription and the start of the billed period.
+type newInvoice struct {
+	name           string
+	priced         *pricedInvoice
+	dueDate        *time.Time
+	subscriptionID *string
+	periodStart    *time.Time
+}
+
+// insertInvoice inserts an open invoice with its line items and books it as
+// receivable in the ledger, in tx.
+func insertInvoice(ctx context.Context, tx pgx.Tx, n newInvoice) (*paymentv1.Invoice, error) {
+	t := n.priced.totals
+	created, err := scanInvoice(tx.QueryRow(ctx,
+		`INSERT INTO invoices (invoice_name, amount_cents, currency_code, subtotal_cents, discount_cents,
+                               discount_rate_bps, tax_cents, due_date, subscription_id, billing_period_start)
+         VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+         RETURNING `+invoiceColumns,
+		n.name, t.Total, n.priced.currency, t.Subtotal, t.Discount, n.priced.rateBps, t.Tax, n.dueDate,
+		n.subscriptionID, n.periodStart))
+	if err != nil {
+		return nil, fmt.Errorf("insert invoice: %w", err)
+	}
+	if err := insertLineItems(ctx, tx, created.GetId(), n.priced); err != nil {
+		return nil, fmt.Errorf("insert line items: %w", err)
+	}
+	created.LineItems = n.priced.items
+	if err := postJournal(ctx, tx, ledger.InvoiceIssued(created.GetId(), n.priced.currency, t.Total)); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetInvoice fetches a single invoice by id. Returns codes.NotFound when the
+// row does not exist.
+func (s *Store) GetInvoice(ctx context.Context, req *connect.Request[paymentv1.GetInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error) {
+	id := strings.TrimSpace(req.Msg.GetId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	inv, err := scanInvoice(s.db.QueryRow(ctx,
+		`SELECT `+invoiceColumns+` FROM invoices WHERE id=$1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "invoice not found")
+		}
+		slog.Error("get invoice query failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to get invoice")
+	}
+	if err := withLineItems(ctx, s.db, "failed to get invoice", inv); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(inv), nil
+}
+
+// ListInvoices returns a page of invoices ordered by creation time (newest
+// first), optionally filtered by state. Pagination uses the same opaque
+// "o:<offset>" tokens as ListExpenses.
+func (s *Store) ListInvoices(ctx context.Context, req *connect.Request[paymentv1.ListInvoicesRequest]) (*connect.Response[paymentv1.ListInvoicesResponse], error) {
+	pageSize := req.Msg.GetPageSize()
+	if pageSize <= 0 || pageSize > 1000 {
+		pageSize = 50
+	}
+
+	offset := 0
+	if req.Msg.GetPageToken() != "" {
+		if n, err := fmt.Sscanf(req.Msg.GetPageToken(), "o:%d", &offset); n != 1 || err != nil {
+			offset = 0
+		}
+	}
+
+	var where string
+	switch req.Msg.GetState() {
+	case paymentv1.InvoiceState_INVOICE_STATE_UNSPECIFIED:
+	case paymentv1.InvoiceState_INVOICE_STATE_OPEN:
+		where = `WHERE NOT paid AND cancelled_at IS NULL AND overdue_at IS NULL`
+	case paymentv1.InvoiceState_INVOICE_STATE_OVERDUE:
+		where = `WHERE NOT paid AND cancelled_at IS NULL AND overdue_at IS NOT NULL`
+	case paymentv1.InvoiceState_INVOICE_STATE_PAID:
+		where = `WHERE paid`
+	case paymentv1.InvoiceState_INVOICE_STATE_CANCELLED:
+		where = `WHERE cancelled_at IS NOT NULL`
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown invoice state filter")
+	}
+
+	rows, err := s.db.Query(ctx,
+		`SELECT `+invoiceColumns+` FROM invoices `+where+`
+         ORDER BY created_at DESC LIMIT $1 OFFSET $2`, pageSize, offset)
+	if err != nil {
+		slog.Error("list invoices query failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list invoices")
+	}
+	defer rows.Close()
+
+	resp := &paymentv1.ListInvoicesResponse{}
+	for rows.Next() {
+		inv, err := scanInvoice(rows)
+		if err != nil {
+			slog.Error("list invoices scan failed", "error", err)
+			return nil, status.Error(codes.Internal, "failed to list invoices")
+		}
+		resp.Invoices = append(resp.Invoices, inv)
+	}
+	if err := rows.Err(); err != nil {
+		slog.Error("list invoices iteration failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list invoices")
+	}
+	if err := withLineItems(ctx, s.db, "failed to list invoices", resp.Invoices...); err != nil {
+		return nil, err
+	}
+	if len(resp.Invoices) == int(pageSize) {
+		resp.NextPageToken = fmt.Sprintf("o:%d", offset+int(pageSize))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// CancelInvoice voids an open invoice and reverses its receivable. Returns codes.NotFound when the
+// invoice does not exist and codes.FailedPrecondition when it is already paid
+// or cancelled.
+func (s *Store) CancelInvoice(ctx context.Context, req *connect.Request[paymentv1.CancelInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error) {
+	id := strings.TrimSpace(req.Msg.GetId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.Error("cancel invoice begin failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to cancel invoice")
+	}
+	defer rollback(ctx, tx)
+
+	locked, err := lockOpenInvoice(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if storedMinor(locked.GetAmountPaid()) > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "invoice is partially paid")
+	}
+	inv, err := scanInvoice(tx.QueryRow(ctx,
+		`UPDATE invoices SET cancelled_at=NOW(), updated_at=NOW() WHERE id=$1
+         RETURNING `+invoiceColumns, id))
+	if err != nil {
+		slog.Error("cancel invoice update failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to cancel invoice")
+	}
+	if err := postJournal(ctx, tx, ledger.InvoiceCancelled(id, inv.GetAmount().GetCurrencyCode(), storedMinor(inv.GetAmount()))); err != nil {
+		slog.Error("cancel invoice ledger failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to cancel invoice")
+	}
+	if err := withLineItems(ctx, tx, "failed to cancel invoice", inv); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("cancel invoice commit failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to cancel invoice")
+	}
+	return connect.NewResponse(inv), nil
+}
+
+// MarkInvoicePaid flags an invoice as settled without recording a payment,
+// e.g. for invoices paid out of band. Returns codes.NotFound when the invoice
+// does not exist and codes.FailedPrecondition when it is already paid or
+// cancelled.
+func (s *Store) MarkInvoicePaid(ctx context.Context, req *connect.Request[paymentv1.MarkInvoicePaidRequest]) (*connect.Response[paymentv1.Invoice], error) {
+	id := strings.TrimSpace(req.Msg.GetId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.Error("mark invoice paid begin failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to mark invoice paid")
+	}
+	defer rollback(ctx, tx)
+
+	inv, err := settleInvoice(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("mark invoice paid commit failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to mark invoice paid")
+	}
+	return connect.NewResponse(inv), nil
+}
+
+// PayInvoice charges the requested amount, or the whole amount due, against
+// card_token as a captured payment and applies it to the invoice, which is
+// flagged paid once nothing is left due. The payment insert and the invoice
+// update share one transaction, and the invoice row is locked first so
+// concurrent calls cannot overpay it. Paying an already-paid or cancelled
+// invoice, or more than is due, returns codes.FailedPrecondition, unless the
+// call is a replay of the original request under the same idempotency key,
+// in which case the original result is returned.
+func (s *Store) PayInvoice(ctx context.Context, req *connect.Request[paymentv1.PayInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error) {
+	id := strings.TrimSpace(req.Msg.GetId())
+	cardToken := strings.TrimSpace(req.Msg.GetCardToken())
+	cardType := req.Msg.GetCard()
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if !validCardType(cardType) {
+		return nil, status.Error(codes.InvalidArgument, "card type is required")
+	}
+	if err := s.VerifyCardToken(ctx, cardToken); err != nil {
+		return nil, err
+	}
+	key, err := resolveIdempotencyKey(req.Header(), req.Msg.GetIdempotencyKey())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.beginProcessorTx(ctx)
+	if err != nil {
+		slog.Error("pay invoice begin failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to pay invoice")
+	}
+	defer rollback(ctx, tx)
+
+	paid, replayed, err := idempotent(ctx, tx, s.idempotencyTTL(), "PayInvoice", key, req.Msg, &paymentv1.Invoice{},
+		func() (*paymentv1.Invoice, error) {
+			_, invs, err := s.payInvoices(ctx, tx, cardToken, cardType, s.fraud.ClientIP(req), []invoicePayment{{id: id, amount: req.Msg.GetAmount()}})
+			if err != nil {
+				return nil, err
+			}
+			return invs[0], nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("pay invoice commit failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to pay invoice")
+	}
+
+	resp := connect.NewResponse(paid)
+	if replayed {
+		resp.Header().Set(IdempotentReplayedHeader, "true")
+		return resp, nil
+	}
+	slog.Info("Invoice payment applied", "id", id, "paid", paid.GetPaid(),
+		"amount_due_cents", storedMinor(paid.GetAmountDue()), "currency", paid.GetAmount().GetCurrencyCode())
+	return resp, nil
+}
+
+// lockOpenInvoice loads the invoice with a row lock held until tx ends.
+// It maps an id that is not a UUID to codes.InvalidArgument, a missing row to
+// codes.NotFound and a paid or cancelled invoice to codes.FailedPrecondition.
+func lockOpenInvoice(ctx context.Context, tx pgx.Tx, id string) (*paymentv1.Invoice, error) {
+	inv, err := scanInvoice(tx.QueryRow(ctx,
+		`SELECT `+invoiceColumns+` FROM invoices WHERE id=$1 FOR UPDATE`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "invoice not found")
+		}
+		var pgerr *pgconn.PgError
+		if errors.As(err, &pgerr) && pgerr.Code == "22P02" { // invalid_text_representation
+			return nil, status.Error(codes.InvalidArgument, "invoice id is not a valid UUID")
+		}
+		slog.Error("lock invoice query failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to load invoice")
+	}
+	switch inv.GetState() {
+	case paymentv1.InvoiceState_INVOICE_STATE_PAID:
+		return nil, status.Error(codes.FailedPrecondition, "invoice already paid")
+	case paymentv1.InvoiceState_INVOICE_STATE_CANCELLED:
+		return nil, status.Error(codes.FailedPrecondition, "invoice is cancelled")
+	}
+	return inv, nil
+}
+
+// settleInvoice marks an open invoice paid without a payment row, settling
+// whatever is still due, and posts the settlement to the ledger, in tx.
+func settleInvoice(ctx context.Context, tx pgx.Tx, id string) (*paymentv1.Invoice, error) {
+	locked, err := lockOpenInvoice(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	due := storedMinor(locked.GetAmountDue())
+	inv, err := applyInvoicePayment(ctx, tx, id, due)
+	if err != nil {
+		return nil, err
+	}
+	if err := postJournal(ctx, tx, ledger.InvoiceSettled(id, inv.GetAmount().GetCurrencyCode(), due)); err != nil {
+		slog.Error("settle invoice ledger failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to update invoice")
+	}
+	return inv, nil
+}
+
+// scanInvoice reads a row selected with invoiceColumns. Line items are
+// loaded separately; see loadLineItems.
+func scanInvoice(row pgx.Row) (*paymentv1.Invoice, error) {
+	var (
+		id, name, currency           string
+		amountCents                  int64
+		paid                         bool
+		cancelledAt                  *time.Time
+		createdAt, updatedAt         time.Time
+		subtotalCents, discountCents int64
+		discountRateBps              *int32
+		taxCents                     int64
+		dueDate, overdueAt           *time.Time
+		remindersSent                int32
+		subscriptionID               *string
+		amountPaidCents              int64
+	)
+	if err := row.Scan(&id, &name, &amountCents, &currency, &paid, &cancelledAt, &createdAt, &updatedAt,
+		&subtotalCents, &discountCents, &discountRateBps, &taxCents, &dueDate, &overdueAt, &remindersSent, &subscriptionID,
+		&amountPaidCents); err != nil {
+		return nil, err
+	}
+	amount, err := money.FromMinor(amountCents, currency)
+	if err != nil {
+		return nil, err
+	}
+	subtotal, err := money.FromMinor(subtotalCents, currency)
+	if err != nil {
+		return nil, err
+	}
+	discountAmount, err := money.FromMinor(discountCents, currency)
+	if err != nil {
+		return nil, err
+	}
+	tax, err := money.FromMinor(taxCents, currency)
+	if err != nil {
+		return nil, err
+	}
+	amountPaid, err := money.FromMinor(amountPaidCents, currency)
+	if err != nil {
+		return nil, err
+	}
+	dueCents := amountCents - amountPaidCents
+	if paid || cancelledAt != nil {
+		dueCents = 0
+	}
+	amountDue, err := money.FromMinor(dueCents, currency)
+	if err != nil {
+		return nil, err
+	}
+	var discount *paymentv1.InvoiceDiscount
+	switch {
+	case discountRateBps != nil:
+		discount = &paymentv1.InvoiceDiscount{Value: &paymentv1.InvoiceDiscount_RateBps{RateBps: *discountRateBps}}
+	case discountCents > 0:
+		discount = &paymentv1.InvoiceDiscount{Value: &paymentv1.InvoiceDiscount_AmountOff{AmountOff: discountAmount}}
+	}
+	state := paymentv1.InvoiceState_INVOICE_STATE_OPEN
+	switch {
+	case paid:
+		state = paymentv1.InvoiceState_INVOICE_STATE_PAID
+	case cancelledAt != nil:
+		state = paymentv1.InvoiceState_INVOICE_STATE_CANCELLED
+	case overdueAt != nil:
+		state = paymentv1.InvoiceState_INVOICE_STATE_OVERDUE
+	}
+	inv := &paymentv1.Invoice{
+		Id:             id,
+		InvoiceName:    name,
+		Amount:         amount,
+		Paid:           paid,
+		CreateTime:     timestamppb.New(createdAt),
+		UpdateTime:     timestamppb.New(updatedAt),
+		State:          state,
+		Discount:       discount,
+		Subtotal:       subtotal,
+		DiscountAmount: discountAmount,
+		Tax:            tax,
+		RemindersSent:  remindersSent,
+		AmountPaid:     amountPaid,
+		AmountDue:      amountDue,
+	}
+	if dueDate != nil {
+		inv.DueDate = protoDate(*dueDate)
+	}
+	if subscriptionID != nil {
+		inv.SubscriptionId = *subscriptionID
+	}
+	return inv, nil
+}
+
+// rollback aborts tx, ignoring the error returned after a successful commit.
+func rollback(ctx context.Context, tx pgx.Tx) {
+	if err := tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+		slog.Warn("transaction rollback failed", "error", err)
+	}
+}
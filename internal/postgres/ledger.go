@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"github.com/grpc-buf/internal/ledger"
+	"github.com/grpc-buf/internal/money"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// postJournal validates j and appends its lines to ledger_entries in tx.
+// The database re-checks the balance when tx commits.
+func postJournal(ctx context.Context, tx pgx.Tx, j ledger.Journal) error {
+	if err := j.Validate(); err != nil {
+		return err
+	}
+	accounts := make([]string, len(j.Lines))
+	debits := make([]int64, len(j.Lines))
+	credits := make([]int64, len(j.Lines))
+	for i, l := range j.Lines {
+		accounts[i], debits[i], credits[i] = l.Account, l.Debit, l.Credit
+	}
+	_, err := tx.Exec(ctx,
+		`WITH journal AS (SELECT gen_random_uuid() AS id)
+         INSERT INTO ledger_entries (journal_id, kind, account, currency_code, debit_minor, credit_minor, payment_id, invoice_id)
+         SELECT journal.id, $1, l.account, $2, l.debit, l.credit, NULLIF($6, '')::uuid, NULLIF($7, '')::uuid
+         FROM journal, unnest($3::text[], $4::bigint[], $5::bigint[]) AS l(account, debit, credit)`,
+		j.Kind, j.Currency, accounts, debits, credits, j.PaymentID, j.InvoiceID)
+	if err != nil {
+		return fmt.Errorf("post %s journal: %w", j.Kind, err)
+	}
+	return nil
+}
+
+// GetLedgerBalances sums ledger_entries per account and currency up to
+// as_of_time (default now), optionally for a single account.
+func (s *Store) GetLedgerBalances(ctx context.Context, req *connect.Request[paymentv1.GetLedgerBalancesRequest]) (*connect.Response[paymentv1.GetLedgerBalancesResponse], error) {
+	asOf := time.Now()
+	if t := req.Msg.GetAsOfTime(); t != nil {
+		if err := t.CheckValid(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "as_of_time is invalid")
+		}
+		asOf = t.AsTime()
+	}
+	args := []any{asOf}
+	where := "WHERE created_at <= $1"
+	if account := strings.TrimSpace(req.Msg.GetAccount()); account != "" {
+		if !ledger.KnownAccount(account) {
+			return nil, status.Error(codes.InvalidArgument, "unknown ledger account")
+		}
+		args = append(args, account)
+		where += " AND account = $2"
+	}
+
+	rows, err := s.db.Query(ctx,
+		`SELECT account, currency_code, SUM(debit_minor)::bigint, SUM(credit_minor)::bigint
+         FROM ledger_entries `+where+`
+         GROUP BY account, currency_code
+         ORDER BY account, currency_code`, args...)
+	if err != nil {
+		slog.Error("ledger balances query failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get ledger balances")
+	}
+	defer rows.Close()
+
+	resp := &paymentv1.GetLedgerBalancesResponse{AsOfTime: timestamppb.New(asOf)}
+	for rows.Next() {
+		var (
+			account, currency string
+			debits, credits   int64
+		)
+		if err := rows.Scan(&account, &currency, &debits, &credits); err != nil {
+			slog.Error("ledger balances scan failed", "error", err)
+			return nil, status.Error(codes.Internal, "failed to get ledger balances")
+		}
+		b, err := ledgerBalance(account, currency, debits, credits)
+		if err != nil {
+			slog.Error("ledger balance decode failed", "error", err, "account", account, "currency", currency)
+			return nil, status.Error(codes.Internal, "failed to get ledger balances")
+		}
+		resp.Balances = append(resp.Balances, b)
+	}
+	if err := rows.Err(); err != nil {
+		slog.Error("ledger balances iteration failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get ledger balances")
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ledgerBalance builds the balance row for summed debits and credits in
+// minor units.
+func ledgerBalance(account, currency string, debits, credits int64) (*paymentv1.LedgerBalance, error) {
+	d, err := money.FromMinor(debits, currency)
+	if err != nil {
+		return nil, err
+	}
+	c, err := money.FromMinor(credits, currency)
+	if err != nil {
+		return nil, err
+	}
+	bal, err := money.Sub(d, c)
+	if err != nil {
+		return nil, err
+	}
+	return &paymentv1.LedgerBalance{Account: account, Debits: d, Credits: c, Balance: bal}, nil
+}
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS payments_invoice_id_idx;
+ALTER TABLE payments DROP COLUMN IF EXISTS invoice_id;
+DROP TABLE IF EXISTS invoices;
@@ -0,0 +1,19 @@
+-- Invoices use the same integer-cents + ISO-4217 representation as payments
+-- so an invoice can be settled by a payment row without any conversion.
+
+CREATE TABLE IF NOT EXISTS invoices (
+    id            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    invoice_name  TEXT        NOT NULL,
+    amount_cents  BIGINT      NOT NULL,
+    currency_code TEXT        NOT NULL,
+    paid          BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Payments made through PayInvoice reference the invoice they settled.
+-- MakePayment rows leave this NULL.
+ALTER TABLE payments
+    ADD COLUMN IF NOT EXISTS invoice_id UUID REFERENCES invoices (id);
+
+CREATE INDEX IF NOT EXISTS payments_invoice_id_idx ON payments (invoice_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS invoices_created_at_idx;
+ALTER TABLE invoices DROP COLUMN IF EXISTS cancelled_at;
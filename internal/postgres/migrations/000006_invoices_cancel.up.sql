@@ -0,0 +1,7 @@
+-- CancelInvoice voids an open invoice. A non-NULL cancelled_at marks the
+-- invoice as cancelled; paid and cancelled are mutually exclusive.
+
+ALTER TABLE invoices
+    ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS invoices_created_at_idx ON invoices (created_at DESC);
@@ -0,0 +1,17 @@
+-- Idempotency keys make MakePayment/PayInvoice retries safe. Each row records
+-- a hash of the original request and the serialized response so a replay
+-- with the same key returns the original result instead of charging again.
+-- Keys are scoped per RPC so the same client key cannot collide across
+-- operations.
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    scope          TEXT        NOT NULL,
+    idem_key       TEXT        NOT NULL,
+    request_hash   BYTEA       NOT NULL,
+    response       BYTEA,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at     TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (scope, idem_key)
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
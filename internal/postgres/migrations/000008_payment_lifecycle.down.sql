@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS payment_events;
+
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS payments_refunded_within_amount;
+ALTER TABLE payments DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE payments DROP COLUMN IF EXISTS refunded_cents;
+ALTER TABLE payments DROP COLUMN IF EXISTS status;
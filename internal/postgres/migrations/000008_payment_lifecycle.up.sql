@@ -0,0 +1,29 @@
+-- Payment lifecycle: every payment carries a PaymentStatus (stored as the
+-- proto enum number, like card_type) and a running refunded total. Rows
+-- written before the lifecycle existed were immediately settled, so they are
+-- backfilled as CAPTURED (4).
+--
+-- payment_events is the append-only history of status transitions. The
+-- creating transition has a NULL from_status.
+
+ALTER TABLE payments
+    ADD COLUMN IF NOT EXISTS status         INTEGER     NOT NULL DEFAULT 4,
+    ADD COLUMN IF NOT EXISTS refunded_cents BIGINT      NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+ALTER TABLE payments ALTER COLUMN status DROP DEFAULT;
+
+ALTER TABLE payments
+    ADD CONSTRAINT payments_refunded_within_amount
+    CHECK (refunded_cents >= 0 AND refunded_cents <= amount_cents);
+
+CREATE TABLE IF NOT EXISTS payment_events (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    payment_id   UUID        NOT NULL REFERENCES payments (id),
+    from_status  INTEGER,
+    to_status    INTEGER     NOT NULL,
+    amount_cents BIGINT      NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS payment_events_payment_id_idx ON payment_events (payment_id, created_at);
@@ -0,0 +1,5 @@
+-- processor_ref is the payment processor's identifier for the authorization
+-- behind a payment, used to capture, void or refund it. Payments written
+-- before a processor was wired in have none.
+
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS processor_ref TEXT;
@@ -0,0 +1,4 @@
+-- ListPayments pages through payments newest first, optionally narrowed to a
+-- creation-time window.
+
+CREATE INDEX IF NOT EXISTS payments_created_at_idx ON payments (created_at DESC);
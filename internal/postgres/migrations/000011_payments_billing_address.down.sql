@@ -0,0 +1,8 @@
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS address TEXT NOT NULL DEFAULT '';
+
+UPDATE payments
+SET    address = COALESCE(billing_address -> 'addressLines' ->> 0, '')
+WHERE  billing_address IS NOT NULL;
+
+ALTER TABLE payments ALTER COLUMN address DROP DEFAULT;
+ALTER TABLE payments DROP COLUMN IF EXISTS billing_address;
@@ -0,0 +1,24 @@
+-- Payments keep the full cardholder billing address as a google.type.PostalAddress
+-- encoded with protojson, instead of only the first address line.
+--
+-- Existing rows only ever stored address_lines[0] in `address`; backfill it as
+-- a single-line PostalAddress so reads see a consistent shape. Payments made
+-- through PayInvoice have no billing address and are left NULL.
+
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS billing_address JSONB;
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM   information_schema.columns
+        WHERE  table_name  = 'payments'
+        AND    column_name = 'address'
+    ) THEN
+        EXECUTE 'UPDATE payments
+                 SET    billing_address = jsonb_build_object(''addressLines'', jsonb_build_array(address))
+                 WHERE  billing_address IS NULL AND address <> ''''';
+    END IF;
+END $$;
+
+ALTER TABLE payments DROP COLUMN IF EXISTS address;
@@ -0,0 +1,44 @@
+-- Reverts 000012: rescale non-two-decimal currencies back to units*100.
+--
+-- currency_minor_exponents lists the exceptions; all other codes use 2.
+
+CREATE TEMPORARY TABLE currency_minor_exponents (code TEXT PRIMARY KEY, exponent INTEGER NOT NULL);
+
+INSERT INTO currency_minor_exponents (code, exponent) VALUES
+    ('BIF', 0), ('CLP', 0), ('DJF', 0), ('GNF', 0), ('ISK', 0), ('JPY', 0), ('KMF', 0),
+    ('KRW', 0), ('PYG', 0), ('RWF', 0), ('UGX', 0), ('UYI', 0), ('VND', 0), ('VUV', 0),
+    ('XAF', 0), ('XOF', 0), ('XPF', 0),
+    ('BHD', 3), ('IQD', 3), ('JOD', 3), ('KWD', 3), ('LYD', 3), ('OMR', 3), ('TND', 3),
+    ('CLF', 4), ('UYW', 4);
+
+-- Scaling KWD and similar back to two decimals truncates the third decimal,
+-- matching what the legacy representation could hold.
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS payments_refunded_within_amount;
+
+UPDATE payments p
+SET    amount_cents   = trunc(p.amount_cents::numeric   * power(10::numeric, 2 - e.exponent))::bigint,
+       refunded_cents = trunc(p.refunded_cents::numeric * power(10::numeric, 2 - e.exponent))::bigint
+FROM   currency_minor_exponents e
+WHERE  p.currency_code = e.code;
+
+UPDATE payment_events pe
+SET    amount_cents = trunc(pe.amount_cents::numeric * power(10::numeric, 2 - e.exponent))::bigint
+FROM   payments p
+JOIN   currency_minor_exponents e ON e.code = p.currency_code
+WHERE  pe.payment_id = p.id;
+
+UPDATE invoices i
+SET    amount_cents = trunc(i.amount_cents::numeric * power(10::numeric, 2 - e.exponent))::bigint
+FROM   currency_minor_exponents e
+WHERE  i.currency_code = e.code;
+
+UPDATE expenses x
+SET    amount_cents = trunc(x.amount_cents::numeric * power(10::numeric, 2 - e.exponent))::bigint
+FROM   currency_minor_exponents e
+WHERE  x.currency_code = e.code;
+
+ALTER TABLE payments
+    ADD CONSTRAINT payments_refunded_within_amount
+    CHECK (refunded_cents >= 0 AND refunded_cents <= amount_cents);
+
+DROP TABLE currency_minor_exponents;
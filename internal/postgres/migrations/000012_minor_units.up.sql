@@ -0,0 +1,95 @@
+-- The *_cents columns now hold minor units of currency_code using the ISO 4217
+-- exponent (0 for JPY, 3 for KWD, ...) instead of always assuming two decimal
+-- places. Rows written before this migration stored units*100 for every
+-- currency, so rescale the ones whose currency is not two-decimal.
+--
+-- Legacy writes accepted any non-empty currency_code, so codes are first
+-- upper-cased and trimmed the way money.NormalizeCurrency does. Rows whose
+-- normalized code is still not a supported ISO 4217 code cannot be read back
+-- and are refused: the migration fails before changing anything, naming the
+-- offending codes. Correct or delete those rows, run `migrate force 11`, and
+-- migrate again.
+--
+-- currency_exponents mirrors internal/money's table of supported codes.
+
+CREATE TEMPORARY TABLE currency_exponents (code TEXT PRIMARY KEY, exponent INTEGER NOT NULL);
+
+INSERT INTO currency_exponents (code, exponent) VALUES
+    ('AED', 2), ('AFN', 2), ('ALL', 2), ('AMD', 2), ('ANG', 2), ('AOA', 2), ('ARS', 2), ('AUD', 2),
+    ('AWG', 2), ('AZN', 2), ('BAM', 2), ('BBD', 2), ('BDT', 2), ('BGN', 2), ('BHD', 3), ('BIF', 0),
+    ('BMD', 2), ('BND', 2), ('BOB', 2), ('BOV', 2), ('BRL', 2), ('BSD', 2), ('BTN', 2), ('BWP', 2),
+    ('BYN', 2), ('BZD', 2), ('CAD', 2), ('CDF', 2), ('CHE', 2), ('CHF', 2), ('CHW', 2), ('CLF', 4),
+    ('CLP', 0), ('CNY', 2), ('COP', 2), ('COU', 2), ('CRC', 2), ('CUP', 2), ('CVE', 2), ('CZK', 2),
+    ('DJF', 0), ('DKK', 2), ('DOP', 2), ('DZD', 2), ('EGP', 2), ('ERN', 2), ('ETB', 2), ('EUR', 2),
+    ('FJD', 2), ('FKP', 2), ('GBP', 2), ('GEL', 2), ('GHS', 2), ('GIP', 2), ('GMD', 2), ('GNF', 0),
+    ('GTQ', 2), ('GYD', 2), ('HKD', 2), ('HNL', 2), ('HTG', 2), ('HUF', 2), ('IDR', 2), ('ILS', 2),
+    ('INR', 2), ('IQD', 3), ('IRR', 2), ('ISK', 0), ('JMD', 2), ('JOD', 3), ('JPY', 0), ('KES', 2),
+    ('KGS', 2), ('KHR', 2), ('KMF', 0), ('KPW', 2), ('KRW', 0), ('KWD', 3), ('KYD', 2), ('KZT', 2),
+    ('LAK', 2), ('LBP', 2), ('LKR', 2), ('LRD', 2), ('LSL', 2), ('LYD', 3), ('MAD', 2), ('MDL', 2),
+    ('MGA', 2), ('MKD', 2), ('MMK', 2), ('MNT', 2), ('MOP', 2), ('MRU', 2), ('MUR', 2), ('MVR', 2),
+    ('MWK', 2), ('MXN', 2), ('MXV', 2), ('MYR', 2), ('MZN', 2), ('NAD', 2), ('NGN', 2), ('NIO', 2),
+    ('NOK', 2), ('NPR', 2), ('NZD', 2), ('OMR', 3), ('PAB', 2), ('PEN', 2), ('PGK', 2), ('PHP', 2),
+    ('PKR', 2), ('PLN', 2), ('PYG', 0), ('QAR', 2), ('RON', 2), ('RSD', 2), ('RUB', 2), ('RWF', 0),
+    ('SAR', 2), ('SBD', 2), ('SCR', 2), ('SDG', 2), ('SEK', 2), ('SGD', 2), ('SHP', 2), ('SLE', 2),
+    ('SLL', 2), ('SOS', 2), ('SRD', 2), ('SSP', 2), ('STN', 2), ('SVC', 2), ('SYP', 2), ('SZL', 2),
+    ('THB', 2), ('TJS', 2), ('TMT', 2), ('TND', 3), ('TOP', 2), ('TRY', 2), ('TTD', 2), ('TWD', 2),
+    ('TZS', 2), ('UAH', 2), ('UGX', 0), ('USD', 2), ('USN', 2), ('UYI', 0), ('UYU', 2), ('UYW', 4),
+    ('UZS', 2), ('VED', 2), ('VES', 2), ('VND', 0), ('VUV', 0), ('WST', 2), ('XAF', 0), ('XCD', 2),
+    ('XCG', 2), ('XOF', 0), ('XPF', 0), ('YER', 2), ('ZAR', 2), ('ZMW', 2), ('ZWG', 2), ('ZWL', 2);
+
+DO $$
+DECLARE
+    unknown TEXT;
+BEGIN
+    SELECT string_agg(DISTINCT format('%s %L', t.tbl, t.code), ', ')
+    INTO   unknown
+    FROM (
+        SELECT 'payments' AS tbl, currency_code AS code FROM payments
+        UNION ALL
+        SELECT 'invoices', currency_code FROM invoices
+        UNION ALL
+        SELECT 'expenses', currency_code FROM expenses
+    ) t
+    WHERE NOT EXISTS (SELECT 1 FROM currency_exponents e WHERE e.code = upper(trim(t.code)));
+
+    IF unknown IS NOT NULL THEN
+        RAISE EXCEPTION 'unsupported currency codes: %', unknown
+            USING HINT = 'correct or delete these rows, run migrate force 11, then migrate again';
+    END IF;
+END $$;
+
+UPDATE payments SET currency_code = upper(trim(currency_code)) WHERE currency_code <> upper(trim(currency_code));
+UPDATE invoices SET currency_code = upper(trim(currency_code)) WHERE currency_code <> upper(trim(currency_code));
+UPDATE expenses SET currency_code = upper(trim(currency_code)) WHERE currency_code <> upper(trim(currency_code));
+
+-- Scaling down to zero decimals truncates toward zero; the legacy conversion
+-- never produced fractional yen from whole-yen inputs.
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS payments_refunded_within_amount;
+
+UPDATE payments p
+SET    amount_cents   = trunc(p.amount_cents::numeric   * power(10::numeric, e.exponent - 2))::bigint,
+       refunded_cents = trunc(p.refunded_cents::numeric * power(10::numeric, e.exponent - 2))::bigint
+FROM   currency_exponents e
+WHERE  p.currency_code = e.code AND e.exponent <> 2;
+
+UPDATE payment_events pe
+SET    amount_cents = trunc(pe.amount_cents::numeric * power(10::numeric, e.exponent - 2))::bigint
+FROM   payments p
+JOIN   currency_exponents e ON e.code = p.currency_code
+WHERE  pe.payment_id = p.id AND e.exponent <> 2;
+
+UPDATE invoices i
+SET    amount_cents = trunc(i.amount_cents::numeric * power(10::numeric, e.exponent - 2))::bigint
+FROM   currency_exponents e
+WHERE  i.currency_code = e.code AND e.exponent <> 2;
+
+UPDATE expenses x
+SET    amount_cents = trunc(x.amount_cents::numeric * power(10::numeric, e.exponent - 2))::bigint
+FROM   currency_exponents e
+WHERE  x.currency_code = e.code AND e.exponent <> 2;
+
+ALTER TABLE payments
+    ADD CONSTRAINT payments_refunded_within_amount
+    CHECK (refunded_cents >= 0 AND refunded_cents <= amount_cents);
+
+DROP TABLE currency_exponents;
@@ -0,0 +1,13 @@
+-- fx_rates holds dated exchange rates: one unit of base_currency is worth
+-- rate units of quote_currency on rate_date. Rates are loaded by cmd/fx-load
+-- and looked up as "latest on or before" a report's rate date.
+
+CREATE TABLE IF NOT EXISTS fx_rates (
+    base_currency  TEXT           NOT NULL,
+    quote_currency TEXT           NOT NULL,
+    rate_date      DATE           NOT NULL,
+    rate           NUMERIC(38, 18) NOT NULL CHECK (rate > 0),
+    created_at     TIMESTAMPTZ    NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ    NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (base_currency, quote_currency, rate_date)
+);
@@ -0,0 +1,40 @@
+-- Outbound webhooks. webhook_endpoints holds the registered receivers and
+-- their signing secrets; event_types lists the subscribed WebhookEventType
+-- numbers. Deleted endpoints are kept (deleted_at) so their delivery history
+-- stays readable.
+--
+-- webhook_deliveries has one row per event and endpoint, written in the same
+-- transaction as the change that raised the event. status is the
+-- WebhookDeliveryStatus number; PENDING (1) rows are picked up by the
+-- dispatcher once next_attempt_at has passed.
+
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    url         TEXT        NOT NULL,
+    event_types INTEGER[]   NOT NULL,
+    description TEXT        NOT NULL DEFAULT '',
+    secret      TEXT        NOT NULL,
+    deleted_at  TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    endpoint_id      UUID        NOT NULL REFERENCES webhook_endpoints (id),
+    event_id         UUID        NOT NULL,
+    event_type       INTEGER     NOT NULL,
+    payload          JSONB       NOT NULL,
+    status           INTEGER     NOT NULL,
+    attempts         INTEGER     NOT NULL DEFAULT 0,
+    last_status_code INTEGER     NOT NULL DEFAULT 0,
+    last_error       TEXT        NOT NULL DEFAULT '',
+    next_attempt_at  TIMESTAMPTZ,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due_idx
+    ON webhook_deliveries (next_attempt_at) WHERE status = 1;
+CREATE INDEX IF NOT EXISTS webhook_deliveries_endpoint_idx
+    ON webhook_deliveries (endpoint_id, created_at DESC);
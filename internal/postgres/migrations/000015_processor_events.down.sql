@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS payments_processor_ref_idx;
+DROP TABLE IF EXISTS processor_events;
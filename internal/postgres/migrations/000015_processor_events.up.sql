@@ -0,0 +1,19 @@
+-- processor_events records every signed event received from the payment
+-- provider, keyed by the provider's event id so redeliveries are ignored.
+-- status is one of applied, rejected (error says why) or unhandled (unknown
+-- type, stored for later). Payment events find their payment by
+-- processor_ref.
+
+CREATE TABLE IF NOT EXISTS processor_events (
+    id           TEXT PRIMARY KEY,
+    type         TEXT        NOT NULL,
+    payload      JSONB       NOT NULL,
+    status       TEXT        NOT NULL,
+    error        TEXT        NOT NULL DEFAULT '',
+    received_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    processed_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS processor_events_status_idx ON processor_events (status, received_at);
+
+CREATE INDEX IF NOT EXISTS payments_processor_ref_idx ON payments (processor_ref);
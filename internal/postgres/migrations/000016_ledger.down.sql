@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS ledger_entries;
+DROP FUNCTION IF EXISTS ledger_journal_balanced();
+DROP FUNCTION IF EXISTS ledger_entries_append_only();
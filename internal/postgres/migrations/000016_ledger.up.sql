@@ -0,0 +1,63 @@
+-- Double-entry ledger. Every money movement is written as a journal: two or
+-- more entries sharing journal_id, each either a debit or a credit in minor
+-- units, whose debits equal its credits per currency. Entries are written in
+-- the same transaction as the business change (see internal/ledger) and
+-- start empty: rows that predate this migration are not backfilled.
+--
+-- The table is append-only; corrections are posted as new journals.
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    journal_id    UUID        NOT NULL,
+    kind          TEXT        NOT NULL,
+    account       TEXT        NOT NULL,
+    currency_code TEXT        NOT NULL,
+    debit_minor   BIGINT      NOT NULL DEFAULT 0,
+    credit_minor  BIGINT      NOT NULL DEFAULT 0,
+    payment_id    UUID REFERENCES payments (id),
+    invoice_id    UUID REFERENCES invoices (id),
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT ledger_entries_one_sided CHECK (
+        (debit_minor > 0 AND credit_minor = 0) OR (credit_minor > 0 AND debit_minor = 0)
+    )
+);
+
+CREATE INDEX IF NOT EXISTS ledger_entries_account_idx ON ledger_entries (account, currency_code, created_at);
+CREATE INDEX IF NOT EXISTS ledger_entries_journal_idx ON ledger_entries (journal_id);
+
+CREATE OR REPLACE FUNCTION ledger_entries_append_only() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    RAISE EXCEPTION 'ledger_entries is append-only (% rejected)', TG_OP;
+END;
+$$;
+
+CREATE TRIGGER ledger_entries_no_update
+    BEFORE UPDATE OR DELETE ON ledger_entries
+    FOR EACH ROW EXECUTE FUNCTION ledger_entries_append_only();
+
+CREATE TRIGGER ledger_entries_no_truncate
+    BEFORE TRUNCATE ON ledger_entries
+    FOR EACH STATEMENT EXECUTE FUNCTION ledger_entries_append_only();
+
+-- Balance is checked at commit so a journal's lines may be inserted one at a
+-- time.
+CREATE OR REPLACE FUNCTION ledger_journal_balanced() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM ledger_entries
+        WHERE journal_id = NEW.journal_id
+        GROUP BY currency_code
+        HAVING SUM(debit_minor) <> SUM(credit_minor)
+    ) THEN
+        RAISE EXCEPTION 'ledger journal % is unbalanced', NEW.journal_id;
+    END IF;
+    RETURN NULL;
+END;
+$$;
+
+CREATE CONSTRAINT TRIGGER ledger_entries_balanced
+    AFTER INSERT ON ledger_entries
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION ledger_journal_balanced();
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS invoice_line_items;
+
+ALTER TABLE invoices
+    DROP COLUMN IF EXISTS tax_cents,
+    DROP COLUMN IF EXISTS discount_rate_bps,
+    DROP COLUMN IF EXISTS discount_cents,
+    DROP COLUMN IF EXISTS subtotal_cents;
@@ -0,0 +1,34 @@
+-- Invoices are built from line items. The server computes each line's
+-- subtotal, discount share, tax and total, and the invoice's amount_cents is
+-- their total. A rate discount keeps its rate in discount_rate_bps; a fixed
+-- discount leaves it NULL and is recovered from discount_cents.
+
+ALTER TABLE invoices
+    ADD COLUMN IF NOT EXISTS subtotal_cents    BIGINT,
+    ADD COLUMN IF NOT EXISTS discount_cents    BIGINT  NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS discount_rate_bps INTEGER,
+    ADD COLUMN IF NOT EXISTS tax_cents         BIGINT  NOT NULL DEFAULT 0;
+
+UPDATE invoices SET subtotal_cents = amount_cents WHERE subtotal_cents IS NULL;
+
+ALTER TABLE invoices ALTER COLUMN subtotal_cents SET NOT NULL;
+
+CREATE TABLE IF NOT EXISTS invoice_line_items (
+    invoice_id       UUID    NOT NULL REFERENCES invoices (id) ON DELETE CASCADE,
+    position         INTEGER NOT NULL,
+    description      TEXT    NOT NULL,
+    quantity         BIGINT  NOT NULL CHECK (quantity > 0),
+    unit_price_cents BIGINT  NOT NULL CHECK (unit_price_cents >= 0),
+    tax_rate_bps     INTEGER NOT NULL DEFAULT 0 CHECK (tax_rate_bps BETWEEN 0 AND 10000),
+    subtotal_cents   BIGINT  NOT NULL,
+    discount_cents   BIGINT  NOT NULL DEFAULT 0,
+    tax_cents        BIGINT  NOT NULL DEFAULT 0,
+    total_cents      BIGINT  NOT NULL,
+    PRIMARY KEY (invoice_id, position)
+);
+
+-- Existing invoices become a single untaxed line for their amount.
+INSERT INTO invoice_line_items (invoice_id, position, description, quantity, unit_price_cents, subtotal_cents, total_cents)
+SELECT id, 1, invoice_name, 1, amount_cents, amount_cents, amount_cents
+FROM invoices
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS invoice_reminders;
+
+DROP INDEX IF EXISTS invoices_unpaid_due_date_idx;
+
+ALTER TABLE invoices
+    DROP COLUMN IF EXISTS reminders_sent,
+    DROP COLUMN IF EXISTS overdue_at,
+    DROP COLUMN IF EXISTS due_date;
@@ -0,0 +1,21 @@
+-- Invoices may carry a due date. The dunning job sets overdue_at once an
+-- unpaid invoice passes it and counts the reminders sent in reminders_sent;
+-- each reminder is also recorded in invoice_reminders. Both columns are
+-- updated under a row lock so replicas running the job never double-send.
+
+ALTER TABLE invoices
+    ADD COLUMN IF NOT EXISTS due_date       DATE,
+    ADD COLUMN IF NOT EXISTS overdue_at     TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS reminders_sent INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS invoices_unpaid_due_date_idx
+    ON invoices (due_date)
+    WHERE NOT paid AND cancelled_at IS NULL AND due_date IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS invoice_reminders (
+    invoice_id     UUID        NOT NULL REFERENCES invoices (id) ON DELETE CASCADE,
+    reminder       INTEGER     NOT NULL,
+    days_after_due INTEGER     NOT NULL,
+    sent_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (invoice_id, reminder)
+);
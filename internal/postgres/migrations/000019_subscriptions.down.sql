@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS invoices_subscription_period_idx;
+
+ALTER TABLE invoices
+    DROP COLUMN IF EXISTS billing_period_start,
+    DROP COLUMN IF EXISTS subscription_id;
+
+DROP TABLE IF EXISTS subscriptions;
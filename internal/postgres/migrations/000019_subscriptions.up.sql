@@ -0,0 +1,36 @@
+-- Subscriptions bill price_cents (plus tax) every period. billing_interval
+-- and status hold rpc.subscription.v1 enum numbers. The scheduler claims
+-- ACTIVE (status 1) rows due on or before today with FOR UPDATE SKIP LOCKED,
+-- issues the period's invoice and advances next_billing_date in the same
+-- transaction.
+
+CREATE TABLE IF NOT EXISTS subscriptions (
+    id                UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name              TEXT        NOT NULL,
+    price_cents       BIGINT      NOT NULL CHECK (price_cents > 0),
+    currency_code     TEXT        NOT NULL,
+    tax_rate_bps      INTEGER     NOT NULL DEFAULT 0 CHECK (tax_rate_bps BETWEEN 0 AND 10000),
+    billing_interval  INTEGER     NOT NULL,
+    anchor_day        INTEGER     NOT NULL,
+    start_date        DATE        NOT NULL,
+    card_token        TEXT,
+    days_until_due    INTEGER     NOT NULL DEFAULT 0 CHECK (days_until_due >= 0),
+    status            INTEGER     NOT NULL,
+    next_billing_date DATE        NOT NULL,
+    latest_invoice_id UUID REFERENCES invoices (id),
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    cancelled_at      TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS subscriptions_due_idx ON subscriptions (next_billing_date) WHERE status = 1;
+CREATE INDEX IF NOT EXISTS subscriptions_created_at_idx ON subscriptions (created_at DESC);
+
+-- Each subscription period is invoiced at most once.
+ALTER TABLE invoices
+    ADD COLUMN IF NOT EXISTS subscription_id      UUID REFERENCES subscriptions (id),
+    ADD COLUMN IF NOT EXISTS billing_period_start DATE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS invoices_subscription_period_idx
+    ON invoices (subscription_id, billing_period_start)
+    WHERE subscription_id IS NOT NULL;
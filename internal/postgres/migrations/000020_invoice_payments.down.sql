@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS invoice_payments;
+
+ALTER TABLE invoices
+    DROP COLUMN IF EXISTS amount_paid_cents;
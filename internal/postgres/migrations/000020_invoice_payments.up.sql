@@ -0,0 +1,22 @@
+-- Amount applied to each invoice so far; the invoice is paid once it
+-- reaches amount_cents.
+ALTER TABLE invoices
+    ADD COLUMN IF NOT EXISTS amount_paid_cents BIGINT NOT NULL DEFAULT 0;
+
+UPDATE invoices SET amount_paid_cents = amount_cents WHERE paid;
+
+-- Links payments to the invoices they pay. A payment may cover several
+-- invoices and an invoice may be paid in instalments.
+CREATE TABLE IF NOT EXISTS invoice_payments (
+    payment_id   UUID        NOT NULL REFERENCES payments (id),
+    invoice_id   UUID        NOT NULL REFERENCES invoices (id),
+    amount_cents BIGINT      NOT NULL CHECK (amount_cents > 0),
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (payment_id, invoice_id)
+);
+
+CREATE INDEX IF NOT EXISTS invoice_payments_invoice_idx ON invoice_payments (invoice_id);
+
+INSERT INTO invoice_payments (payment_id, invoice_id, amount_cents, created_at)
+SELECT id, invoice_id, amount_cents, created_at FROM payments WHERE invoice_id IS NOT NULL
+ON CONFLICT DO NOTHING;
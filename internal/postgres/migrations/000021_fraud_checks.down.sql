@@ -0,0 +1,5 @@
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS fraud_reasons,
+    DROP COLUMN IF EXISTS fraud_decision;
+
+DROP TABLE IF EXISTS fraud_checks;
@@ -0,0 +1,19 @@
+-- Every MakePayment attempt screened by the fraud rules, including denied
+-- ones, so the velocity rules can count them.
+CREATE TABLE IF NOT EXISTS fraud_checks (
+    id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    card_token TEXT        NOT NULL,
+    client_ip  TEXT,
+    -- rpc.payment.v1.FraudDecision number.
+    decision   INTEGER     NOT NULL,
+    reasons    TEXT[]      NOT NULL DEFAULT '{}',
+    payment_id UUID REFERENCES payments (id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS fraud_checks_token_idx ON fraud_checks (card_token, created_at);
+CREATE INDEX IF NOT EXISTS fraud_checks_ip_idx ON fraud_checks (client_ip, created_at) WHERE client_ip IS NOT NULL;
+
+ALTER TABLE payments
+    ADD COLUMN IF NOT EXISTS fraud_decision INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS fraud_reasons  TEXT[]  NOT NULL DEFAULT '{}';
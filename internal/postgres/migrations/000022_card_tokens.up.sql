@@ -0,0 +1,13 @@
+-- Cards held by the vault. The card number is only stored sealed with the
+-- configured vault key; the CVC is never stored.
+CREATE TABLE IF NOT EXISTS card_tokens (
+    token       TEXT PRIMARY KEY,
+    -- AES-256-GCM nonce followed by the sealed card number.
+    pan_sealed  BYTEA       NOT NULL,
+    last4       TEXT        NOT NULL,
+    exp_month   INTEGER     NOT NULL,
+    exp_year    INTEGER     NOT NULL,
+    -- First instant after the expiry month; the token is rejected from then on.
+    expires_at  TIMESTAMPTZ NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
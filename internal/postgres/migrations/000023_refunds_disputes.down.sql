@@ -0,0 +1,6 @@
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS payments_disputed_within_amount;
+ALTER TABLE payments DROP COLUMN IF EXISTS disputed_cents;
+
+DROP TABLE IF EXISTS dispute_evidence;
+DROP TABLE IF EXISTS disputes;
+DROP TABLE IF EXISTS refunds;
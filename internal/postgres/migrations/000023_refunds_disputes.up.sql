@@ -0,0 +1,51 @@
+-- Refunds are recorded one row per refund. Refunds made before this table
+-- existed are only reflected in payments.refunded_cents.
+CREATE TABLE IF NOT EXISTS refunds (
+    id            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    payment_id    UUID        NOT NULL REFERENCES payments (id),
+    amount_cents  BIGINT      NOT NULL CHECK (amount_cents > 0),
+    currency_code TEXT        NOT NULL,
+    -- rpc.payment.v1.RefundReason number.
+    reason        INTEGER     NOT NULL DEFAULT 0,
+    note          TEXT        NOT NULL DEFAULT '',
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS refunds_payment_idx ON refunds (payment_id, created_at);
+
+-- Disputes (chargeback cases) against a payment. Evidence can be added while
+-- a dispute is OPEN and before its deadline.
+CREATE TABLE IF NOT EXISTS disputes (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    payment_id      UUID        NOT NULL REFERENCES payments (id),
+    amount_cents    BIGINT      NOT NULL CHECK (amount_cents > 0),
+    currency_code   TEXT        NOT NULL,
+    reason          TEXT        NOT NULL DEFAULT '',
+    -- rpc.payment.v1.DisputeStatus number.
+    status          INTEGER     NOT NULL,
+    evidence_due_at TIMESTAMPTZ NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at     TIMESTAMPTZ
+);
+
+-- At most one OPEN dispute per payment.
+CREATE UNIQUE INDEX IF NOT EXISTS disputes_open_payment_idx ON disputes (payment_id) WHERE status = 1;
+CREATE INDEX IF NOT EXISTS disputes_created_at_idx ON disputes (created_at);
+
+CREATE TABLE IF NOT EXISTS dispute_evidence (
+    id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    dispute_id UUID        NOT NULL REFERENCES disputes (id),
+    note       TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS dispute_evidence_dispute_idx ON dispute_evidence (dispute_id, created_at);
+
+-- Amount lost to disputes. Refunds and lost disputes together never exceed
+-- the captured amount.
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS disputed_cents BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE payments
+    ADD CONSTRAINT payments_disputed_within_amount
+    CHECK (disputed_cents >= 0 AND refunded_cents + disputed_cents <= amount_cents);
@@ -0,0 +1,18 @@
+-- Revoked access tokens, by jti. Rows are pruned once the token has expired.
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    jti        TEXT PRIMARY KEY,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS revoked_tokens_expires_at_idx ON revoked_tokens (expires_at);
+
+-- Subjects whose tokens issued at or before revoked_before are revoked
+-- ("log out everywhere"). Pruned once every such token has expired.
+CREATE TABLE IF NOT EXISTS revoked_subjects (
+    subject        TEXT PRIMARY KEY,
+    revoked_before TIMESTAMPTZ NOT NULL,
+    expires_at     TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS revoked_subjects_expires_at_idx ON revoked_subjects (expires_at);
@@ -0,0 +1,3 @@
+-- Roles are emitted as the "roles" claim of access tokens. Changes take
+-- effect at the user's next login or refresh.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS roles TEXT[] NOT NULL DEFAULT '{user}';
@@ -0,0 +1,12 @@
+-- Subscription invoices waiting to be paid with the subscription's card. A
+-- row is written in the transaction that issues the invoice and removed once
+-- the payment succeeds or is given up, so a payment that failed for a reason
+-- other than a decline, or was never attempted, is retried.
+CREATE TABLE IF NOT EXISTS subscription_autopay (
+    invoice_id      UUID PRIMARY KEY REFERENCES invoices (id),
+    subscription_id UUID        NOT NULL REFERENCES subscriptions (id),
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS subscription_autopay_next_attempt_idx ON subscription_autopay (next_attempt_at);
@@ -0,0 +1,16 @@
+-- The type of the card behind a subscription's card_token, an
+-- rpc.payment.v1.CardType number. Existing subscriptions take the type of the
+-- latest payment made with the same token; those without one keep 0
+-- (unspecified), so their invoices are left open for dunning until the
+-- subscription is recreated with a card type.
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS card_type INTEGER NOT NULL DEFAULT 0;
+
+UPDATE subscriptions s
+SET card_type = p.card_type
+FROM (
+    SELECT DISTINCT ON (card_token) card_token, card_type
+    FROM payments
+    WHERE card_type <> 0
+    ORDER BY card_token, created_at DESC
+) p
+WHERE s.card_token = p.card_token AND s.card_type = 0;
@@ -0,0 +1,112 @@
+//go:build integration
+// +build integration
+
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// migrateInScratchSchema returns a migrator bound to a fresh schema on a
+// single connection, so the test never touches the real tables.
+func migrateInScratchSchema(t *testing.T) (*migrate.Migrate, *sql.Conn) {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("Skipping test: TEST_DATABASE_URL not set")
+	}
+
+	ctx := context.Background()
+	db, err := sql.Open("pgx", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	schema := fmt.Sprintf("migrate_test_%d", time.Now().UnixNano())
+	_, err = conn.ExecContext(ctx, "CREATE SCHEMA "+schema)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = conn.ExecContext(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+		conn.Close()
+	})
+	_, err = conn.ExecContext(ctx, "SET search_path TO "+schema+", public")
+	require.NoError(t, err)
+
+	driver, err := postgres.WithConnection(ctx, conn, &postgres.Config{})
+	require.NoError(t, err)
+	src, err := iofs.New(migrationFiles, ".")
+	require.NoError(t, err)
+	m, err := migrate.NewWithInstance("iofs", src, "grpcbuf", driver)
+	require.NoError(t, err)
+	return m, conn
+}
+
+func TestMinorUnitsNormalizesLegacyCurrencyCodes(t *testing.T) {
+	m, conn := migrateInScratchSchema(t)
+	ctx := context.Background()
+	require.NoError(t, m.Migrate(11))
+
+	// Legacy rows stored units*100 regardless of currency.
+	_, err := conn.ExecContext(ctx, `
+		INSERT INTO payments (card_type, name, card_token, amount_cents, currency_code, status)
+		VALUES (1, 'yen', 'tok', 50000, 'jpy', 4), (1, 'dollar', 'tok', 1234, ' usd', 4);
+		INSERT INTO invoices (invoice_name, amount_cents, currency_code) VALUES ('inv', 500, 'eur ');
+		INSERT INTO expenses (user_id, amount_cents, currency_code) VALUES (gen_random_uuid(), 1000, 'Kwd');`)
+	require.NoError(t, err)
+
+	require.NoError(t, m.Migrate(12))
+
+	rows := map[string]struct {
+		query string
+		code  string
+		minor int64
+	}{
+		"jpy payment": {"SELECT currency_code, amount_cents FROM payments WHERE name = 'yen'", "JPY", 500},
+		"usd payment": {"SELECT currency_code, amount_cents FROM payments WHERE name = 'dollar'", "USD", 1234},
+		"eur invoice": {"SELECT currency_code, amount_cents FROM invoices", "EUR", 500},
+		"kwd expense": {"SELECT currency_code, amount_cents FROM expenses", "KWD", 10000},
+	}
+	for name, want := range rows {
+		var code string
+		var minor int64
+		require.NoError(t, conn.QueryRowContext(ctx, want.query).Scan(&code, &minor), name)
+		assert.Equal(t, want.code, code, name)
+		assert.Equal(t, want.minor, minor, name)
+	}
+}
+
+func TestMinorUnitsRefusesUnknownCurrencyCodes(t *testing.T) {
+	m, conn := migrateInScratchSchema(t)
+	ctx := context.Background()
+	require.NoError(t, m.Migrate(11))
+
+	_, err := conn.ExecContext(ctx, `
+		INSERT INTO payments (card_type, name, card_token, amount_cents, currency_code, status)
+		VALUES (1, 'yen', 'tok', 50000, 'jpy', 4);
+		INSERT INTO expenses (user_id, amount_cents, currency_code) VALUES (gen_random_uuid(), 1000, 'btc');`)
+	require.NoError(t, err)
+
+	err = m.Migrate(12)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "btc")
+
+	// Nothing was rewritten, so the rows can be fixed and the migration rerun.
+	var code string
+	var minor int64
+	require.NoError(t, conn.QueryRowContext(ctx, "SELECT currency_code, amount_cents FROM payments").Scan(&code, &minor))
+	assert.Equal(t, "jpy", code)
+	assert.Equal(t, int64(50000), minor)
+}
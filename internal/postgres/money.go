@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"github.com/grpc-buf/internal/money"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// minorUnits validates a client-supplied amount and converts it to minor
+// units for the *_cents columns, which hold minor units of currency_code
+// (cents for USD, yen for JPY, fils for KWD). Invalid or overly precise
+// amounts return codes.InvalidArgument naming field. The currency code of m
+// is normalized in place first, so call minorUnits before comparing it: the
+// stored codes are upper case.
+func minorUnits(field string, m *moneypb.Money) (int64, error) {
+	if m != nil {
+		m.CurrencyCode, _ = money.NormalizeCurrency(m.GetCurrencyCode())
+	}
+	v, err := money.ToMinor(m)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "%s: %v", field, err)
+	}
+	return v, nil
+}
+
+// storedMinor converts an amount read back from the database to minor units.
+// Such amounts were built by money.FromMinor, so the conversion is exact.
+func storedMinor(m *moneypb.Money) int64 {
+	v, _ := money.ToMinor(m)
+	return v
+}
@@ -0,0 +1,513 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grpc-buf/internal/fraud"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	webhookv1 "github.com/grpc-buf/internal/gen/proto/webhook"
+	"github.com/grpc-buf/internal/ledger"
+	"github.com/grpc-buf/internal/money"
+	"github.com/grpc-buf/internal/processor"
+	"github.com/jackc/pgx/v5"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/genproto/googleapis/type/postaladdress"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// paymentTransitions lists the legal PaymentStatus transitions. States absent
+// from the map (VOIDED, REFUNDED, FAILED) are terminal.
+var paymentTransitions = map[paymentv1.PaymentStatus][]paymentv1.PaymentStatus{
+	paymentv1.PaymentStatus_PAYMENT_STATUS_AUTHORIZED: {
+		paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED,
+		paymentv1.PaymentStatus_PAYMENT_STATUS_VOIDED,
+	},
+	paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED: {
+		paymentv1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED,
+		paymentv1.PaymentStatus_PAYMENT_STATUS_REFUNDED,
+	},
+	paymentv1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED: {
+		paymentv1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED,
+		paymentv1.PaymentStatus_PAYMENT_STATUS_REFUNDED,
+	},
+}
+
+// canTransition reports whether a payment may move from one status to another.
+func canTransition(from, to paymentv1.PaymentStatus) bool {
+	return slices.Contains(paymentTransitions[from], to)
+}
+
+// paymentColumns selects a payment for scanPayment. Only the last four
+// characters of card_token ever leave the database.
+const paymentColumns = `id, status, amount_cents, refunded_cents, currency_code, created_at, updated_at,
+        RIGHT(card_token, 4), card_type, COALESCE(invoice_id::text, ''), billing_address, fraud_decision, fraud_reasons,
+        disputed_cents`
+
+// newPayment carries the columns written when a payment row is created.
+type newPayment struct {
+	cardToken string
+	cardType  paymentv1.CardType
+	name      string
+	// billingAddress is stored as protojson; nil stores NULL.
+	billingAddress *postaladdress.PostalAddress
+	amountCents    int64
+	currency       string
+	// allocations are the invoices the payment pays; their amounts sum to
+	// amountCents. A single allocation is also stored as invoice_id.
+	allocations []allocation
+	capture     bool
+	// processorRef is the processor's authorization reference.
+	processorRef string
+	// fraud is the fraud screening result.
+	fraud fraud.Result
+}
+
+// allocation is the part of a payment applied to one invoice.
+type allocation struct {
+	invoiceID   string
+	amountCents int64
+}
+
+// chargePayment authorizes p with the payment processor, captures it when
+// p.capture is set, and then records it with insertPayment. Processor errors
+// are returned unchanged so declines reach the client with their status and
+// details. If tx does not commit, the authorization is voided, or the
+// capture refunded.
+func (s *Store) chargePayment(ctx context.Context, tx *processorTx, p newPayment) (string, paymentv1.PaymentStatus, error) {
+	ref, err := s.proc.Authorize(ctx, processor.Charge{CardToken: p.cardToken, AmountCents: p.amountCents, Currency: p.currency})
+	if err != nil {
+		slog.Warn("payment authorization failed", "error", err, "amount_cents", p.amountCents, "currency", p.currency)
+		return "", 0, err
+	}
+	captured := false
+	tx.onRollback(func(ctx context.Context) {
+		if captured {
+			s.refundCapture(ctx, ref, p.amountCents)
+		} else {
+			s.releaseAuthorization(ctx, ref)
+		}
+	})
+	if p.capture {
+		if err := s.proc.Capture(ctx, ref, p.amountCents); err != nil {
+			slog.Warn("payment capture failed", "error", err, "ref", ref)
+			return "", 0, err
+		}
+		captured = true
+	}
+	p.processorRef = ref
+	id, st, err := insertPayment(ctx, tx, p)
+	if err != nil {
+		slog.Error("Error storing payment", "error", err, "ref", ref)
+		return "", 0, status.Error(codes.Internal, "failed to store payment")
+	}
+	if p.capture {
+		if err := enqueuePaymentSucceeded(ctx, tx, id); err != nil {
+			slog.Error("Error queueing payment webhook", "error", err, "id", id)
+			return "", 0, status.Error(codes.Internal, "failed to store payment")
+		}
+	}
+	return id, st, nil
+}
+
+// enqueuePaymentSucceeded queues the payment.succeeded webhook for a payment
+// captured in tx.
+func enqueuePaymentSucceeded(ctx context.Context, tx pgx.Tx, id string) error {
+	p, err := scanPayment(tx.QueryRow(ctx, `SELECT `+paymentColumns+` FROM payments WHERE id=$1`, id))
+	if err != nil {
+		return fmt.Errorf("load captured payment: %w", err)
+	}
+	return enqueueWebhook(ctx, tx, webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_PAYMENT_SUCCEEDED, p)
+}
+
+// releaseAuthorization voids ref on a best-effort basis so a hold that will
+// not be recorded does not stay on the card.
+func (s *Store) releaseAuthorization(ctx context.Context, ref string) {
+	if err := s.proc.Void(ctx, ref); err != nil {
+		slog.Error("payment authorization release failed", "error", err, "ref", ref)
+	}
+}
+
+// refundCapture refunds amountCents captured on ref on a best-effort basis so
+// a charge that will not be recorded is returned to the card.
+func (s *Store) refundCapture(ctx context.Context, ref string, amountCents int64) {
+	if err := s.proc.Refund(ctx, ref, amountCents); err != nil {
+		slog.Error("unrecorded capture refund failed", "error", err, "ref", ref, "amount_cents", amountCents)
+	}
+}
+
+// insertPayment writes an AUTHORIZED payment row, and captures it when
+// p.capture is set, recording each transition in payment_events and the
+// capture in the ledger. It returns the new payment id and its final status.
+func insertPayment(ctx context.Context, tx pgx.Tx, p newPayment) (string, paymentv1.PaymentStatus, error) {
+	st := paymentv1.PaymentStatus_PAYMENT_STATUS_AUTHORIZED
+	if p.capture {
+		st = paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED
+	}
+	var address any
+	if p.billingAddress != nil {
+		b, err := protojson.Marshal(p.billingAddress)
+		if err != nil {
+			return "", 0, fmt.Errorf("encode billing address: %w", err)
+		}
+		address = b
+	}
+	var invoiceID *string
+	if len(p.allocations) == 1 {
+		invoiceID = &p.allocations[0].invoiceID
+	}
+	var id string
+	err := tx.QueryRow(ctx,
+		`INSERT INTO payments (card_token, card_type, name, billing_address, amount_cents, currency_code, invoice_id, status, processor_ref,
+                               fraud_decision, fraud_reasons)
+         VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''), $10, COALESCE($11::text[], '{}'))
+         RETURNING id`,
+		p.cardToken, int(p.cardType), p.name, address, p.amountCents, p.currency, invoiceID, int(st), p.processorRef,
+		int(p.fraud.Decision), p.fraud.Reasons,
+	).Scan(&id)
+	if err != nil {
+		return "", 0, fmt.Errorf("insert payment: %w", err)
+	}
+	for _, a := range p.allocations {
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO invoice_payments (payment_id, invoice_id, amount_cents) VALUES ($1, $2, $3)`,
+			id, a.invoiceID, a.amountCents); err != nil {
+			return "", 0, fmt.Errorf("insert invoice payment: %w", err)
+		}
+	}
+	if err := recordPaymentEvent(ctx, tx, id, paymentv1.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED,
+		paymentv1.PaymentStatus_PAYMENT_STATUS_AUTHORIZED, p.amountCents); err != nil {
+		return "", 0, err
+	}
+	if p.capture {
+		if err := recordPaymentEvent(ctx, tx, id, paymentv1.PaymentStatus_PAYMENT_STATUS_AUTHORIZED,
+			paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED, p.amountCents); err != nil {
+			return "", 0, err
+		}
+		if err := postCapture(ctx, tx, id, p); err != nil {
+			return "", 0, err
+		}
+	}
+	return id, st, nil
+}
+
+// postCapture posts the capture of payment id to the ledger: one journal per
+// allocation, so each invoice's receivable is settled by its own share, or a
+// single sale when the payment pays no invoice.
+func postCapture(ctx context.Context, tx pgx.Tx, id string, p newPayment) error {
+	if len(p.allocations) == 0 {
+		return postJournal(ctx, tx, ledger.PaymentCaptured(id, "", p.currency, p.amountCents))
+	}
+	for _, a := range p.allocations {
+		if err := postJournal(ctx, tx, ledger.PaymentCaptured(id, a.invoiceID, p.currency, a.amountCents)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordPaymentEvent appends a transition to payment_events. An UNSPECIFIED
+// from status is stored as NULL and marks the creating event.
+func recordPaymentEvent(ctx context.Context, tx pgx.Tx, paymentID string, from, to paymentv1.PaymentStatus, amountCents int64) error {
+	var fromVal *int
+	if from != paymentv1.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED {
+		v := int(from)
+		fromVal = &v
+	}
+	_, err := tx.Exec(ctx,
+		`INSERT INTO payment_events (payment_id, from_status, to_status, amount_cents)
+         VALUES ($1, $2, $3, $4)`,
+		paymentID, fromVal, int(to), amountCents)
+	if err != nil {
+		return fmt.Errorf("record payment event: %w", err)
+	}
+	return nil
+}
+
+// CapturePayment settles an authorized payment for its full amount.
+func (s *Store) CapturePayment(ctx context.Context, req *connect.Request[paymentv1.CapturePaymentRequest]) (*connect.Response[paymentv1.Payment], error) {
+	return s.transitionPayment(ctx, req.Msg.GetId(), "capture", func(p *paymentv1.Payment) (paymentv1.PaymentStatus, int64, error) {
+		return paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED, storedMinor(p.GetAmount()), nil
+	})
+}
+
+// VoidPayment releases an authorized payment without settling it.
+func (s *Store) VoidPayment(ctx context.Context, req *connect.Request[paymentv1.VoidPaymentRequest]) (*connect.Response[paymentv1.Payment], error) {
+	return s.transitionPayment(ctx, req.Msg.GetId(), "void", func(p *paymentv1.Payment) (paymentv1.PaymentStatus, int64, error) {
+		return paymentv1.PaymentStatus_PAYMENT_STATUS_VOIDED, storedMinor(p.GetAmount()), nil
+	})
+}
+
+// RefundPayment returns req.amount (or everything still refundable when
+// unset) from a captured payment and records it as a Refund with no reason.
+// The payment becomes REFUNDED once nothing is left to refund and
+// PARTIALLY_REFUNDED before that.
+func (s *Store) RefundPayment(ctx context.Context, req *connect.Request[paymentv1.RefundPaymentRequest]) (*connect.Response[paymentv1.Payment], error) {
+	id := strings.TrimSpace(req.Msg.GetId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	tx, err := s.beginProcessorTx(ctx)
+	if err != nil {
+		slog.Error("payment transition begin failed", "error", err, "op", "refund", "id", id)
+		return nil, status.Error(codes.Internal, "failed to update payment")
+	}
+	defer rollback(ctx, tx)
+
+	_, updated, err := s.refundPayment(ctx, tx, id, req.Msg.GetAmount(), paymentv1.RefundReason_REFUND_REASON_UNSPECIFIED, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("payment transition commit failed", "error", err, "op", "refund", "id", id)
+		return nil, status.Error(codes.Internal, "failed to update payment")
+	}
+
+	slog.Info("Payment refunded", "id", id, "status", updated.GetStatus().String())
+	return connect.NewResponse(updated), nil
+}
+
+// refundTransition returns the status and amount for refunding amount (or
+// everything still refundable when nil) from p. Funds already refunded or
+// lost to disputes cannot be refunded again.
+func refundTransition(p *paymentv1.Payment, amount *moneypb.Money) (paymentv1.PaymentStatus, int64, error) {
+	captured := storedMinor(p.GetAmount())
+	refundable := captured - storedMinor(p.GetRefundedAmount()) - storedMinor(p.GetDisputedAmount())
+	refund := refundable
+	if amount != nil {
+		var err error
+		if refund, err = minorUnits("refund amount", amount); err != nil {
+			return 0, 0, err
+		}
+		if amount.GetCurrencyCode() != p.GetAmount().GetCurrencyCode() {
+			return 0, 0, status.Error(codes.InvalidArgument, "refund currency must match the payment currency")
+		}
+		if refund <= 0 {
+			return 0, 0, status.Error(codes.InvalidArgument, "refund amount must be positive")
+		}
+	}
+	if refund > refundable {
+		return 0, 0, status.Error(codes.FailedPrecondition, "refund exceeds the refundable amount")
+	}
+	if refund == refundable {
+		return paymentv1.PaymentStatus_PAYMENT_STATUS_REFUNDED, refund, nil
+	}
+	return paymentv1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED, refund, nil
+}
+
+// transitionPayment locks the payment, asks next for the target status and the
+// amount moved by the transition, rejects illegal transitions with
+// codes.FailedPrecondition, and applies the change together with its
+// payment_events row, its ledger journal and, for captures, the
+// payment.succeeded webhook in one transaction.
+func (s *Store) transitionPayment(ctx context.Context, id, op string, next func(*paymentv1.Payment) (paymentv1.PaymentStatus, int64, error)) (*connect.Response[paymentv1.Payment], error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	tx, err := s.beginProcessorTx(ctx)
+	if err != nil {
+		slog.Error("payment transition begin failed", "error", err, "op", op, "id", id)
+		return nil, status.Error(codes.Internal, "failed to update payment")
+	}
+	defer rollback(ctx, tx)
+
+	cur, updated, err := lockedTransition(ctx, tx, id, op, next, s.applyTransition(tx))
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("payment transition commit failed", "error", err, "op", op, "id", id)
+		return nil, status.Error(codes.Internal, "failed to update payment")
+	}
+
+	slog.Info("Payment transitioned", "id", id, "from", cur.GetStatus().String(), "to", updated.GetStatus().String())
+	return connect.NewResponse(updated), nil
+}
+
+// lockedTransition performs the transitionPayment steps inside tx and returns
+// the payment before and after. apply makes the processor call backing the
+// transition after every other write, so only the commit can still fail once
+// the processor has acted; a nil apply records a transition the processor has
+// already made, such as one reported by a provider event.
+func lockedTransition(
+	ctx context.Context, tx pgx.Tx, id, op string,
+	next func(*paymentv1.Payment) (paymentv1.PaymentStatus, int64, error),
+	apply func(ctx context.Context, id string, to paymentv1.PaymentStatus, amountCents int64) error,
+) (*paymentv1.Payment, *paymentv1.Payment, error) {
+	cur, err := scanPayment(tx.QueryRow(ctx,
+		`SELECT `+paymentColumns+` FROM payments WHERE id=$1 FOR UPDATE`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, status.Error(codes.NotFound, "payment not found")
+		}
+		slog.Error("payment transition load failed", "error", err, "op", op, "id", id)
+		return nil, nil, status.Error(codes.Internal, "failed to update payment")
+	}
+
+	to, amountCents, err := next(cur)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !canTransition(cur.GetStatus(), to) {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "cannot %s a payment in status %s", op, cur.GetStatus())
+	}
+
+	var refundedDelta int64
+	if to == paymentv1.PaymentStatus_PAYMENT_STATUS_REFUNDED || to == paymentv1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED {
+		refundedDelta = amountCents
+	}
+	updated, err := scanPayment(tx.QueryRow(ctx,
+		`UPDATE payments SET status=$2, refunded_cents=refunded_cents+$3, updated_at=NOW()
+         WHERE id=$1
+         RETURNING `+paymentColumns,
+		id, int(to), refundedDelta))
+	if err != nil {
+		slog.Error("payment transition update failed", "error", err, "op", op, "id", id)
+		return nil, nil, status.Error(codes.Internal, "failed to update payment")
+	}
+	if err := recordPaymentEvent(ctx, tx, id, cur.GetStatus(), to, amountCents); err != nil {
+		slog.Error("payment transition event failed", "error", err, "op", op, "id", id)
+		return nil, nil, status.Error(codes.Internal, "failed to update payment")
+	}
+	if j, ok := transitionJournal(updated, to, amountCents); ok {
+		if err := postJournal(ctx, tx, j); err != nil {
+			slog.Error("payment transition ledger failed", "error", err, "op", op, "id", id)
+			return nil, nil, status.Error(codes.Internal, "failed to update payment")
+		}
+	}
+	if to == paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED {
+		if err := enqueueWebhook(ctx, tx, webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_PAYMENT_SUCCEEDED, updated); err != nil {
+			slog.Error("payment transition webhook failed", "error", err, "op", op, "id", id)
+			return nil, nil, status.Error(codes.Internal, "failed to update payment")
+		}
+	}
+	if apply != nil {
+		if err := apply(ctx, id, to, amountCents); err != nil {
+			return nil, nil, err
+		}
+	}
+	return cur, updated, nil
+}
+
+// transitionJournal returns the ledger journal for moving p to status to
+// with amountCents, and false for transitions that move no money (voids).
+func transitionJournal(p *paymentv1.Payment, to paymentv1.PaymentStatus, amountCents int64) (ledger.Journal, bool) {
+	currency := p.GetAmount().GetCurrencyCode()
+	switch to {
+	case paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED:
+		return ledger.PaymentCaptured(p.GetId(), p.GetInvoiceId(), currency, amountCents), true
+	case paymentv1.PaymentStatus_PAYMENT_STATUS_REFUNDED, paymentv1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED:
+		return ledger.PaymentRefunded(p.GetId(), p.GetInvoiceId(), currency, amountCents), true
+	}
+	return ledger.Journal{}, false
+}
+
+// applyTransition returns the apply step of lockedTransition for tx: the
+// processor call that backs a transition to status to. Payments recorded
+// before a processor was configured have no reference and are passed an
+// empty one. A capture is refunded if tx does not commit; voids and refunds
+// cannot be undone, so their failure to commit is logged for reconciliation.
+func (s *Store) applyTransition(tx *processorTx) func(ctx context.Context, id string, to paymentv1.PaymentStatus, amountCents int64) error {
+	return func(ctx context.Context, id string, to paymentv1.PaymentStatus, amountCents int64) error {
+		var ref string
+		if err := tx.QueryRow(ctx, `SELECT COALESCE(processor_ref, '') FROM payments WHERE id=$1`, id).Scan(&ref); err != nil {
+			slog.Error("payment processor ref load failed", "error", err, "id", id)
+			return status.Error(codes.Internal, "failed to update payment")
+		}
+		var err error
+		switch to {
+		case paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED:
+			if err = s.proc.Capture(ctx, ref, amountCents); err == nil {
+				tx.onRollback(func(ctx context.Context) { s.refundCapture(ctx, ref, amountCents) })
+			}
+		case paymentv1.PaymentStatus_PAYMENT_STATUS_VOIDED:
+			err = s.proc.Void(ctx, ref)
+		case paymentv1.PaymentStatus_PAYMENT_STATUS_REFUNDED, paymentv1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED:
+			err = s.proc.Refund(ctx, ref, amountCents)
+		}
+		if err != nil {
+			slog.Warn("payment processor call failed", "error", err, "id", id, "to", to.String())
+			return err
+		}
+		if to != paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED {
+			tx.onRollback(func(context.Context) {
+				slog.Error("payment processor call not recorded", "id", id, "ref", ref, "to", to.String(), "amount_cents", amountCents)
+			})
+		}
+		return nil
+	}
+}
+
+// scanPayment reads a row selected with paymentColumns.
+func scanPayment(row pgx.Row) (*paymentv1.Payment, error) {
+	var (
+		id, currency, last4, invoiceID string
+		st, cardType                   int32
+		amountCents, refundedCents     int64
+		createdAt, updatedAt           time.Time
+		address                        []byte
+		fraudDecision                  int32
+		fraudReasons                   []string
+		disputedCents                  int64
+	)
+	if err := row.Scan(&id, &st, &amountCents, &refundedCents, &currency, &createdAt, &updatedAt,
+		&last4, &cardType, &invoiceID, &address, &fraudDecision, &fraudReasons,
+		&disputedCents); err != nil {
+		return nil, err
+	}
+	amount, err := money.FromMinor(amountCents, currency)
+	if err != nil {
+		return nil, err
+	}
+	refunded, err := money.FromMinor(refundedCents, currency)
+	if err != nil {
+		return nil, err
+	}
+	disputed, err := money.FromMinor(disputedCents, currency)
+	if err != nil {
+		return nil, err
+	}
+	var netCents int64
+	if isSettled(paymentv1.PaymentStatus(st)) {
+		netCents = amountCents - refundedCents - disputedCents
+	}
+	net, err := money.FromMinor(netCents, currency)
+	if err != nil {
+		return nil, err
+	}
+	var billing *postaladdress.PostalAddress
+	if address != nil {
+		billing = &postaladdress.PostalAddress{}
+		if err := protojson.Unmarshal(address, billing); err != nil {
+			return nil, fmt.Errorf("decode billing address: %w", err)
+		}
+	}
+	return &paymentv1.Payment{
+		Id:             id,
+		Status:         paymentv1.PaymentStatus(st),
+		Amount:         amount,
+		RefundedAmount: refunded,
+		CreateTime:     timestamppb.New(createdAt),
+		UpdateTime:     timestamppb.New(updatedAt),
+		CardTokenLast4: last4,
+		CardType:       paymentv1.CardType(cardType),
+		InvoiceId:      invoiceID,
+		BillingAddress: billing,
+		FraudDecision:  paymentv1.FraudDecision(fraudDecision),
+		FraudReasons:   fraudReasons,
+		DisputedAmount: disputed,
+		NetAmount:      net,
+	}, nil
+}
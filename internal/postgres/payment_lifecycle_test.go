@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"testing"
+
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"github.com/grpc-buf/internal/ledger"
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+func TestCanTransition(t *testing.T) {
+	const (
+		authorized = paymentv1.PaymentStatus_PAYMENT_STATUS_AUTHORIZED
+		captured   = paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED
+		voided     = paymentv1.PaymentStatus_PAYMENT_STATUS_VOIDED
+		refunded   = paymentv1.PaymentStatus_PAYMENT_STATUS_REFUNDED
+		partial    = paymentv1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED
+	)
+	cases := []struct {
+		from, to paymentv1.PaymentStatus
+		ok       bool
+	}{
+		{authorized, captured, true},
+		{authorized, voided, true},
+		{authorized, refunded, false},
+		{captured, refunded, true},
+		{captured, partial, true},
+		{captured, voided, false},
+		{partial, partial, true},
+		{partial, refunded, true},
+		{partial, captured, false},
+		{voided, captured, false},
+		{refunded, partial, false},
+		{captured, captured, false},
+	}
+	for _, c := range cases {
+		if got := canTransition(c.from, c.to); got != c.ok {
+			t.Errorf("canTransition(%s, %s) = %v, want %v", c.from, c.to, got, c.ok)
+		}
+	}
+}
+
+func TestTransitionJournal(t *testing.T) {
+	p := &paymentv1.Payment{Id: "p1", InvoiceId: "i1", Amount: &money.Money{CurrencyCode: "EUR", Units: 10}}
+	cases := []struct {
+		to      paymentv1.PaymentStatus
+		ok      bool
+		kind    string
+		debit   string
+		credit  string
+		payment string
+	}{
+		{paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED, true, ledger.KindPaymentCaptured, ledger.AccountCash, ledger.AccountReceivable, "p1"},
+		{paymentv1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED, true, ledger.KindPaymentRefunded, ledger.AccountRefunds, ledger.AccountCash, "p1"},
+		{paymentv1.PaymentStatus_PAYMENT_STATUS_REFUNDED, true, ledger.KindPaymentRefunded, ledger.AccountRefunds, ledger.AccountCash, "p1"},
+		{paymentv1.PaymentStatus_PAYMENT_STATUS_VOIDED, false, "", "", "", ""},
+	}
+	for _, c := range cases {
+		j, ok := transitionJournal(p, c.to, 250)
+		if ok != c.ok {
+			t.Fatalf("transitionJournal(%s) ok = %v, want %v", c.to, ok, c.ok)
+		}
+		if !ok {
+			continue
+		}
+		if err := j.Validate(); err != nil {
+			t.Fatalf("transitionJournal(%s) invalid: %v", c.to, err)
+		}
+		if j.Kind != c.kind || j.PaymentID != c.payment || j.Currency != "EUR" {
+			t.Errorf("transitionJournal(%s) = %+v", c.to, j)
+		}
+		if j.Lines[0].Account != c.debit || j.Lines[0].Debit != 250 || j.Lines[1].Account != c.credit || j.Lines[1].Credit != 250 {
+			t.Errorf("transitionJournal(%s) lines = %+v", c.to, j.Lines)
+		}
+	}
+}
+
+func TestRefundTransitionExcludesLostDisputes(t *testing.T) {
+	usd := func(units int64) *money.Money { return &money.Money{CurrencyCode: "USD", Units: units} }
+	p := &paymentv1.Payment{
+		Status:         paymentv1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED,
+		Amount:         usd(100),
+		RefundedAmount: usd(20),
+		DisputedAmount: usd(30),
+	}
+
+	to, cents, err := refundTransition(p, nil)
+	if err != nil || to != paymentv1.PaymentStatus_PAYMENT_STATUS_REFUNDED || cents != 5000 {
+		t.Fatalf("full refund = %s, %d, %v; want REFUNDED, 5000", to, cents, err)
+	}
+	to, cents, err = refundTransition(p, usd(10))
+	if err != nil || to != paymentv1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED || cents != 1000 {
+		t.Fatalf("partial refund = %s, %d, %v; want PARTIALLY_REFUNDED, 1000", to, cents, err)
+	}
+	if _, _, err := refundTransition(p, usd(51)); err == nil {
+		t.Fatal("refund beyond captured less refunds and disputes succeeded")
+	}
+	if _, _, err := refundTransition(p, &money.Money{CurrencyCode: "EUR", Units: 1}); err == nil {
+		t.Fatal("refund in another currency succeeded")
+	}
+	if _, cents, err := refundTransition(p, &money.Money{CurrencyCode: " usd ", Units: 10}); err != nil || cents != 1000 {
+		t.Fatalf("refund in lower-case currency = %d, %v; want 1000", cents, err)
+	}
+}
@@ -2,62 +2,307 @@ package postgres
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"strconv"
 	"strings"
 
 	"connectrpc.com/connect"
+	"github.com/grpc-buf/internal/fraud"
 	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
-	"google.golang.org/genproto/googleapis/type/money"
+	"github.com/grpc-buf/internal/money"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/genproto/googleapis/type/postaladdress"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
-// MakePayment validates the payment request and records it as a debit-card
-// payment. Low-level database errors are logged server-side and surfaced to
+// MakePayment validates the payment request, authorizes it with the payment
+// processor, and records it with its card type and billing address in
+// AUTHORIZED status, or CAPTURED when the request asks for capture. Processor
+// declines are returned with the processor's status and details.
+// Low-level database errors are logged server-side and surfaced to
 // the client as a generic Internal status to avoid leaking driver detail.
+// Requests carrying an idempotency key (header or field) are executed at most
+// once per key; see idempotent.
 func (s *Store) MakePayment(ctx context.Context, req *connect.Request[paymentv1.PaymentRequest]) (*connect.Response[paymentv1.PaymentResponse], error) {
 	name := req.Msg.GetName()
 	amount := req.Msg.GetAmount()
 	cardToken := strings.TrimSpace(req.Msg.GetCardToken())
-	address := req.Msg.GetAddressLines()
+	cardType := req.Msg.GetCard()
+	address := billingAddress(req.Msg)
 
-	if !VerifyCardToken(cardToken) || !positiveMoney(amount) || len(address) == 0 {
+	if !validCardType(cardType) {
+		return nil, status.Error(codes.InvalidArgument, "card type is required")
+	}
+	amountCents, err := minorUnits("amount", amount)
+	if err != nil {
+		return nil, err
+	}
+	if cardToken == "" || amountCents <= 0 || address == nil {
 		return nil, status.Error(codes.FailedPrecondition, "field validation failed")
 	}
+	if err := s.VerifyCardToken(ctx, cardToken); err != nil {
+		return nil, err
+	}
+	key, err := resolveIdempotencyKey(req.Header(), req.Msg.GetIdempotencyKey())
+	if err != nil {
+		return nil, err
+	}
 
-	amountCents := amount.GetUnits()*100 + int64(amount.GetNanos())/nanosPerCent
 	currency := amount.GetCurrencyCode()
-	_, err := s.db.Exec(ctx,
-		`INSERT INTO payments (card_token, card_type, name, address, amount_cents, currency_code)
-         VALUES ($1, $2, $3, $4, $5, $6)`,
-		cardToken, int(paymentv1.CardType_CARD_TYPE_DEBIT), name, address[0], amountCents, currency)
+
+	tx, err := s.beginProcessorTx(ctx)
+	if err != nil {
+		slog.Error("Error starting payment transaction", "error", err)
+		return nil, status.Error(codes.Internal, "failed to store payment")
+	}
+	defer rollback(ctx, tx)
+
+	msg, replayed, err := idempotent(ctx, tx, s.idempotencyTTL(), "MakePayment", key, req.Msg, &paymentv1.PaymentResponse{},
+		func() (*paymentv1.PaymentResponse, error) {
+			risk, checkID, err := s.screenPayment(ctx, fraud.Attempt{
+				CardToken: cardToken,
+				ClientIP:  s.fraud.ClientIP(req),
+				Name:      name,
+				Amount:    amountCents,
+				Currency:  currency,
+			})
+			if err != nil {
+				return nil, err
+			}
+			id, st, err := s.chargePayment(ctx, tx, newPayment{
+				cardToken:      cardToken,
+				cardType:       cardType,
+				name:           name,
+				billingAddress: address,
+				amountCents:    amountCents,
+				currency:       currency,
+				capture:        req.Msg.GetCapture(),
+				fraud:          risk,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if err := linkFraudCheck(ctx, tx, checkID, id); err != nil {
+				return nil, err
+			}
+			return &paymentv1.PaymentResponse{Id: id, Status: st, FraudDecision: risk.Decision, FraudReasons: risk.Reasons}, nil
+		})
 	if err != nil {
-		slog.Error("Error storing payment", "error", err)
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("Error committing payment", "error", err)
 		return nil, status.Error(codes.Internal, "failed to store payment")
 	}
 
-	slog.Info("Received payment", "name", name, "amount_cents", amountCents, "currency", currency)
+	if replayed {
+		slog.Info("Replayed payment", "id", msg.GetId(), "amount_cents", amountCents, "currency", currency)
+	} else {
+		slog.Info("Received payment", "id", msg.GetId(), "status", msg.GetStatus().String(), "amount_cents", amountCents, "currency", currency)
+	}
 	slog.Info("Header value", "value", req.Header().Get("Some-Header"))
 
-	response := connect.NewResponse(&paymentv1.PaymentResponse{
-		Status: paymentv1.PaymentStatus_PAYMENT_STATUS_PAID,
-	})
+	response := connect.NewResponse(msg)
 	response.Header().Set("Some-Other-Header", "hello!")
+	if replayed {
+		response.Header().Set(IdempotentReplayedHeader, "true")
+	}
 	return response, nil
 }
 
-// VerifyCardToken performs a minimal sanity check on a card token. An empty
-// token is treated as missing.
-func VerifyCardToken(token string) bool {
-	return token != ""
+// GetPayment returns a single payment by id. Returns codes.NotFound when the
+// payment does not exist.
+func (s *Store) GetPayment(ctx context.Context, req *connect.Request[paymentv1.GetPaymentRequest]) (*connect.Response[paymentv1.Payment], error) {
+	id := strings.TrimSpace(req.Msg.GetId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	p, err := scanPayment(s.db.QueryRow(ctx,
+		`SELECT `+paymentColumns+` FROM payments WHERE id=$1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "payment not found")
+		}
+		slog.Error("get payment query failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to get payment")
+	}
+	return connect.NewResponse(p), nil
+}
+
+// ListPayments returns a page of payments ordered by creation time (newest
+// first), optionally filtered by status, currency and a [start_time,
+// end_time) creation window. Pagination uses the same opaque "o:<offset>"
+// tokens as ListExpenses.
+func (s *Store) ListPayments(ctx context.Context, req *connect.Request[paymentv1.ListPaymentsRequest]) (*connect.Response[paymentv1.ListPaymentsResponse], error) {
+	where, args, err := paymentFilter(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.Msg.GetPageSize()
+	if pageSize <= 0 || pageSize > 1000 {
+		pageSize = 50
+	}
+
+	offset := 0
+	if req.Msg.GetPageToken() != "" {
+		if n, err := fmt.Sscanf(req.Msg.GetPageToken(), "o:%d", &offset); n != 1 || err != nil {
+			offset = 0
+		}
+	}
+
+	args = append(args, pageSize, offset)
+	rows, err := s.db.Query(ctx,
+		`SELECT `+paymentColumns+` FROM payments `+where+`
+         ORDER BY created_at DESC LIMIT $`+strconv.Itoa(len(args)-1)+` OFFSET $`+strconv.Itoa(len(args)), args...)
+	if err != nil {
+		slog.Error("list payments query failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list payments")
+	}
+	defer rows.Close()
+
+	resp := &paymentv1.ListPaymentsResponse{}
+	for rows.Next() {
+		p, err := scanPayment(rows)
+		if err != nil {
+			slog.Error("list payments scan failed", "error", err)
+			return nil, status.Error(codes.Internal, "failed to list payments")
+		}
+		resp.Payments = append(resp.Payments, p)
+	}
+	if err := rows.Err(); err != nil {
+		slog.Error("list payments iteration failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list payments")
+	}
+	if len(resp.Payments) == int(pageSize) {
+		resp.NextPageToken = fmt.Sprintf("o:%d", offset+int(pageSize))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// settled matches payments whose funds were captured: captured, partially
+// refunded, refunded and legacy PAID payments.
+var settled = fmt.Sprintf("status IN (%d, %d, %d, %d)",
+	paymentv1.PaymentStatus_PAYMENT_STATUS_PAID, //nolint:staticcheck // SA1019: legacy rows still count as settled
+	paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED,
+	paymentv1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED,
+	paymentv1.PaymentStatus_PAYMENT_STATUS_REFUNDED)
+
+// isSettled is the Go form of settled.
+func isSettled(st paymentv1.PaymentStatus) bool {
+	switch st {
+	case paymentv1.PaymentStatus_PAYMENT_STATUS_PAID, //nolint:staticcheck // SA1019: legacy rows still count as settled
+		paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED,
+		paymentv1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED,
+		paymentv1.PaymentStatus_PAYMENT_STATUS_REFUNDED:
+		return true
+	}
+	return false
 }
 
-func positiveMoney(m *money.Money) bool {
-	if m == nil {
-		return false
+// SummarizePayments totals the net settled amount (amount minus refunds and
+// lost disputes) of captured, partially refunded, refunded and legacy PAID
+// payments created in the optional [start_time, end_time) window, converted
+// into target_currency.
+func (s *Store) SummarizePayments(ctx context.Context, req *connect.Request[paymentv1.SummarizePaymentsRequest]) (*connect.Response[paymentv1.SummarizePaymentsResponse], error) {
+	target, on, err := reportTarget(req.Msg.GetTargetCurrency(), req.Msg.GetRateDate())
+	if err != nil {
+		return nil, err
+	}
+	where, args, err := paymentFilter(&paymentv1.ListPaymentsRequest{StartTime: req.Msg.GetStartTime(), EndTime: req.Msg.GetEndTime()})
+	if err != nil {
+		return nil, err
+	}
+	if where == "" {
+		where = "WHERE " + settled
+	} else {
+		where += " AND " + settled
+	}
+
+	totals, err := s.queryCurrencyTotals(ctx,
+		`SELECT currency_code, SUM(amount_cents - refunded_cents - disputed_cents)::bigint
+         FROM payments `+where+`
+         GROUP BY currency_code ORDER BY currency_code`, args...)
+	if err != nil {
+		slog.Error("summarize payments query failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to summarize payments")
+	}
+	total, breakdown, err := s.convertTotals(ctx, totals, target, on)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&paymentv1.SummarizePaymentsResponse{Total: total, ByCurrency: breakdown}), nil
+}
+
+// paymentFilter builds the WHERE clause and positional arguments for the
+// ListPayments filters. It returns an empty clause when no filter is set.
+func paymentFilter(req *paymentv1.ListPaymentsRequest) (string, []any, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if st := req.GetStatus(); st != paymentv1.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED {
+		if _, ok := paymentv1.PaymentStatus_name[int32(st)]; !ok {
+			return "", nil, status.Error(codes.InvalidArgument, "unknown payment status filter")
+		}
+		add("status=$%d", int(st))
+	}
+	if c := strings.TrimSpace(req.GetCurrencyCode()); c != "" {
+		code, ok := money.NormalizeCurrency(c)
+		if !ok {
+			return "", nil, status.Error(codes.InvalidArgument, "currency_code must be a supported ISO 4217 code")
+		}
+		add("currency_code=$%d", code)
+	}
+	start, end := req.GetStartTime(), req.GetEndTime()
+	if start != nil && end != nil && !start.AsTime().Before(end.AsTime()) {
+		return "", nil, status.Error(codes.InvalidArgument, "start_time must be before end_time")
+	}
+	if start != nil {
+		add("created_at >= $%d", start.AsTime())
+	}
+	if end != nil {
+		add("created_at < $%d", end.AsTime())
+	}
+
+	if len(conds) == 0 {
+		return "", nil, nil
+	}
+	return "WHERE " + strings.Join(conds, " AND "), args, nil
+}
+
+// validCardType reports whether t is a known, specified card type.
+func validCardType(t paymentv1.CardType) bool {
+	_, known := paymentv1.CardType_name[int32(t)]
+	return known && t != paymentv1.CardType_CARD_TYPE_UNSPECIFIED
+}
+
+// billingAddress returns the request's billing address with blank address
+// lines removed, falling back to the deprecated address_lines field. It
+// returns nil when no address line remains.
+func billingAddress(req *paymentv1.PaymentRequest) *postaladdress.PostalAddress {
+	addr := &postaladdress.PostalAddress{AddressLines: req.GetAddressLines()} //nolint:staticcheck // SA1019: deprecated field kept as a fallback
+	if req.GetBillingAddress() != nil {
+		addr = proto.Clone(req.GetBillingAddress()).(*postaladdress.PostalAddress)
+	}
+	var lines []string
+	for _, l := range addr.GetAddressLines() {
+		if l = strings.TrimSpace(l); l != "" {
+			lines = append(lines, l)
+		}
 	}
-	if m.GetUnits() < 0 || m.GetNanos() < 0 {
-		return false
+	if len(lines) == 0 {
+		return nil
 	}
-	return m.GetUnits() > 0 || m.GetNanos() > 0
+	addr.AddressLines = lines
+	return addr
 }
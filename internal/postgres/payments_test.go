@@ -1,12 +1,135 @@
 package postgres
 
-import "testing"
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
 
-func TestVerifyCardToken(t *testing.T) {
-	if VerifyCardToken("") {
-		t.Fatalf("expected false for empty card token")
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"google.golang.org/genproto/googleapis/type/postaladdress"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestVerifyCardTokenRequiresToken(t *testing.T) {
+	// An empty token is rejected before the vault is consulted.
+	err := (&Store{}).VerifyCardToken(context.Background(), "")
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for empty card token, got %v", err)
+	}
+}
+
+func TestPaymentFilter(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(24 * time.Hour)
+	tests := []struct {
+		name      string
+		req       *paymentv1.ListPaymentsRequest
+		wantWhere string
+		wantArgs  int
+		wantCode  codes.Code
+	}{
+		{name: "no filters", req: &paymentv1.ListPaymentsRequest{}},
+		{
+			name:      "status and currency",
+			req:       &paymentv1.ListPaymentsRequest{Status: paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED, CurrencyCode: "usd"},
+			wantWhere: "WHERE status=$1 AND currency_code=$2",
+			wantArgs:  2,
+		},
+		{
+			name:      "time range",
+			req:       &paymentv1.ListPaymentsRequest{StartTime: timestamppb.New(t0), EndTime: timestamppb.New(t1)},
+			wantWhere: "WHERE created_at >= $1 AND created_at < $2",
+			wantArgs:  2,
+		},
+		{name: "bad currency", req: &paymentv1.ListPaymentsRequest{CurrencyCode: "DOLLARS"}, wantCode: codes.InvalidArgument},
+		{name: "unknown status", req: &paymentv1.ListPaymentsRequest{Status: 99}, wantCode: codes.InvalidArgument},
+		{
+			name:     "inverted range",
+			req:      &paymentv1.ListPaymentsRequest{StartTime: timestamppb.New(t1), EndTime: timestamppb.New(t0)},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			where, args, err := paymentFilter(tt.req)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("code = %v, want %v", got, tt.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			if where != tt.wantWhere || len(args) != tt.wantArgs {
+				t.Fatalf("paymentFilter = %q, %v; want %q with %d args", where, args, tt.wantWhere, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestValidCardType(t *testing.T) {
+	tests := []struct {
+		card paymentv1.CardType
+		want bool
+	}{
+		{card: paymentv1.CardType_CARD_TYPE_UNSPECIFIED, want: false},
+		{card: paymentv1.CardType_CARD_TYPE_DEBIT, want: true},
+		{card: paymentv1.CardType_CARD_TYPE_REWARD, want: true},
+		{card: paymentv1.CardType(42), want: false},
+	}
+	for _, tt := range tests {
+		if got := validCardType(tt.card); got != tt.want {
+			t.Errorf("validCardType(%v) = %v, want %v", tt.card, got, tt.want)
+		}
+	}
+}
+
+func TestBillingAddress(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *paymentv1.PaymentRequest
+		wantLines []string
+		wantCity  string
+	}{
+		{name: "missing", req: &paymentv1.PaymentRequest{}},
+		{name: "blank lines only", req: &paymentv1.PaymentRequest{AddressLines: []string{" ", ""}}},
+		{
+			name:      "legacy address lines",
+			req:       &paymentv1.PaymentRequest{AddressLines: []string{"1 Main St", " Apt 2 "}},
+			wantLines: []string{"1 Main St", "Apt 2"},
+		},
+		{
+			name: "postal address wins",
+			req: &paymentv1.PaymentRequest{
+				AddressLines: []string{"ignored"},
+				BillingAddress: &postaladdress.PostalAddress{
+					RegionCode:   "AU",
+					Locality:     "Sydney",
+					AddressLines: []string{"1 George St", ""},
+				},
+			},
+			wantLines: []string{"1 George St"},
+			wantCity:  "Sydney",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := billingAddress(tt.req)
+			if tt.wantLines == nil {
+				if got != nil {
+					t.Fatalf("billingAddress = %v, want nil", got)
+				}
+				return
+			}
+			if !slices.Equal(got.GetAddressLines(), tt.wantLines) || got.GetLocality() != tt.wantCity {
+				t.Fatalf("billingAddress = %v, want lines %v city %q", got, tt.wantLines, tt.wantCity)
+			}
+		})
 	}
-	if !VerifyCardToken("tok_1234") {
-		t.Fatalf("expected true for non-empty card token")
+	req := &paymentv1.PaymentRequest{BillingAddress: &postaladdress.PostalAddress{AddressLines: []string{" x "}}}
+	billingAddress(req)
+	if req.GetBillingAddress().GetAddressLines()[0] != " x " {
+		t.Fatalf("billingAddress mutated the request")
 	}
 }
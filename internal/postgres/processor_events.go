@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"github.com/grpc-buf/internal/money"
+	"github.com/grpc-buf/internal/processor"
+	"github.com/jackc/pgx/v5"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errUnhandledEvent marks provider events of an unknown type.
+var errUnhandledEvent = errors.New("unhandled provider event type")
+
+// ApplyProcessorEvent records a provider event and applies it to the matching
+// payment or invoice in one transaction. Events already received return
+// processor.EventDuplicate without side effects. Events that cannot be
+// applied, such as one for an unknown payment, are stored as
+// processor.EventRejected with the reason; unknown types are stored as
+// processor.EventUnhandled. Only infrastructure failures return an error, so
+// the provider redelivers just those.
+func (s *Store) ApplyProcessorEvent(ctx context.Context, ev processor.Event) (processor.EventStatus, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return "", fmt.Errorf("begin processor event: %w", err)
+	}
+	defer rollback(ctx, tx)
+
+	// A concurrent redelivery blocks on the primary key until this
+	// transaction ends and then inserts nothing.
+	tag, err := tx.Exec(ctx,
+		`INSERT INTO processor_events (id, type, payload, status)
+         VALUES ($1, $2, $3, 'received')
+         ON CONFLICT (id) DO NOTHING`,
+		ev.ID, ev.Type, ev.Raw)
+	if err != nil {
+		return "", fmt.Errorf("insert processor event: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return processor.EventDuplicate, nil
+	}
+
+	// The event is applied in a savepoint so a rejected event can still be
+	// recorded.
+	sp, err := tx.Begin(ctx)
+	if err != nil {
+		return "", fmt.Errorf("begin processor event savepoint: %w", err)
+	}
+	result, reason := processor.EventApplied, ""
+	switch err := applyProcessorEvent(ctx, sp, ev); {
+	case err == nil:
+		if err := sp.Commit(ctx); err != nil {
+			return "", fmt.Errorf("release processor event savepoint: %w", err)
+		}
+	case errors.Is(err, errUnhandledEvent):
+		result = processor.EventUnhandled
+	case isClientError(err):
+		result, reason = processor.EventRejected, status.Convert(err).Message()
+	default:
+		return "", err
+	}
+	if result != processor.EventApplied {
+		rollback(ctx, sp)
+	}
+
+	if _, err := tx.Exec(ctx,
+		`UPDATE processor_events SET status=$2, error=$3, processed_at=NOW() WHERE id=$1`,
+		ev.ID, string(result), reason); err != nil {
+		return "", fmt.Errorf("update processor event: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return "", fmt.Errorf("commit processor event: %w", err)
+	}
+	return result, nil
+}
+
+// isClientError reports whether err is a status error caused by the event
+// itself rather than by the database.
+func isClientError(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	switch st.Code() {
+	case codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition:
+		return true
+	}
+	return false
+}
+
+func applyProcessorEvent(ctx context.Context, tx pgx.Tx, ev processor.Event) error {
+	switch ev.Type {
+	case processor.EventPaymentSettled, processor.EventPaymentChargeback, processor.EventInvoicePaid:
+	default:
+		return errUnhandledEvent
+	}
+	var data processor.EventData
+	if len(ev.Data) > 0 {
+		if err := json.Unmarshal(ev.Data, &data); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid event data: %v", err)
+		}
+	}
+
+	if ev.Type == processor.EventInvoicePaid {
+		id := strings.TrimSpace(data.InvoiceID)
+		if id == "" {
+			return status.Error(codes.InvalidArgument, "invoice_id is required")
+		}
+		_, err := settleInvoice(ctx, tx, id)
+		return err
+	}
+
+	id, err := paymentIDByRef(ctx, tx, data.ProcessorRef)
+	if err != nil {
+		return err
+	}
+	if ev.Type == processor.EventPaymentSettled {
+		_, _, err := lockedTransition(ctx, tx, id, "settle", func(p *paymentv1.Payment) (paymentv1.PaymentStatus, int64, error) {
+			return paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED, storedMinor(p.GetAmount()), nil
+		}, nil)
+		return err
+	}
+
+	var amount *moneypb.Money
+	if data.Amount != 0 {
+		if amount, err = money.FromMinor(data.Amount, strings.ToUpper(strings.TrimSpace(data.Currency))); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid chargeback amount: %v", err)
+		}
+	}
+	// A chargeback is a dispute the provider has already decided.
+	return chargeBack(ctx, tx, id, amount)
+}
+
+// paymentIDByRef resolves the payment a processor reference belongs to.
+func paymentIDByRef(ctx context.Context, tx pgx.Tx, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return "", status.Error(codes.InvalidArgument, "processor_ref is required")
+	}
+	var id string
+	if err := tx.QueryRow(ctx, `SELECT id FROM payments WHERE processor_ref=$1`, ref).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", status.Error(codes.NotFound, "payment not found")
+		}
+		slog.Error("payment lookup by processor ref failed", "error", err, "ref", ref)
+		return "", status.Error(codes.Internal, "failed to load payment")
+	}
+	return id, nil
+}
@@ -0,0 +1,24 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grpc-buf/internal/processor"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInvoicePaidEventRejectsMalformedInvoiceID(t *testing.T) {
+	tx := &stubTx{queryErr: &pgconn.PgError{Code: "22P02", Message: `invalid input syntax for type uuid: "inv_42"`}}
+	ev := processor.Event{ID: "evt_1", Type: processor.EventInvoicePaid, Data: []byte(`{"invoice_id":"inv_42"}`)}
+
+	err := applyProcessorEvent(context.Background(), tx, ev)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("applyProcessorEvent = %v, want InvalidArgument", err)
+	}
+	if !isClientError(err) {
+		t.Fatal("a malformed invoice_id must reject the event, not fail the delivery")
+	}
+}
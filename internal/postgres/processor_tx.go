@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// processorTx is a transaction that records payment processor calls. The
+// calls are made before the transaction commits, so each one that can be
+// undone registers its undo with onRollback; the undos run, newest first,
+// when the transaction rolls back or fails to commit, so a charge that is
+// not recorded does not stay on the card.
+type processorTx struct {
+	pgx.Tx
+	undo []func(context.Context)
+}
+
+// beginProcessorTx starts a processorTx.
+func (s *Store) beginProcessorTx(ctx context.Context) (*processorTx, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &processorTx{Tx: tx}, nil
+}
+
+// onRollback registers undo to run if the transaction does not commit.
+func (t *processorTx) onRollback(undo func(context.Context)) {
+	t.undo = append(t.undo, undo)
+}
+
+// Commit commits the transaction and, when that fails, undoes the processor
+// calls it recorded.
+func (t *processorTx) Commit(ctx context.Context) error {
+	if err := t.Tx.Commit(ctx); err != nil {
+		t.compensate(ctx)
+		return err
+	}
+	t.undo = nil
+	return nil
+}
+
+// Rollback rolls the transaction back and undoes the processor calls it
+// recorded. After a successful Commit it only returns pgx.ErrTxClosed.
+func (t *processorTx) Rollback(ctx context.Context) error {
+	err := t.Tx.Rollback(ctx)
+	t.compensate(ctx)
+	return err
+}
+
+// compensate runs the registered undos once. They run even when ctx is
+// cancelled, which is often why the transaction failed.
+func (t *processorTx) compensate(ctx context.Context) {
+	ctx = context.WithoutCancel(ctx)
+	for i := len(t.undo) - 1; i >= 0; i-- {
+		t.undo[i](ctx)
+	}
+	t.undo = nil
+}
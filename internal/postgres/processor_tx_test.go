@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/grpc-buf/internal/processor"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stubTx is a pgx.Tx whose queries fail with queryErr and whose Commit
+// returns commitErr. Methods it does not override panic.
+type stubTx struct {
+	pgx.Tx
+	queryErr  error
+	commitErr error
+	closed    bool
+}
+
+type errRow struct{ err error }
+
+func (r errRow) Scan(...any) error { return r.err }
+
+func (t *stubTx) QueryRow(context.Context, string, ...any) pgx.Row { return errRow{t.queryErr} }
+
+func (t *stubTx) Commit(context.Context) error {
+	t.closed = true
+	return t.commitErr
+}
+
+func (t *stubTx) Rollback(context.Context) error {
+	if t.closed {
+		return pgx.ErrTxClosed
+	}
+	t.closed = true
+	return nil
+}
+
+// recordingProcessor approves every call and records it.
+type recordingProcessor struct{ calls []string }
+
+func (p *recordingProcessor) Authorize(_ context.Context, c processor.Charge) (string, error) {
+	p.calls = append(p.calls, fmt.Sprintf("authorize %d", c.AmountCents))
+	return "ref_1", nil
+}
+
+func (p *recordingProcessor) Capture(_ context.Context, ref string, amountCents int64) error {
+	p.calls = append(p.calls, fmt.Sprintf("capture %s %d", ref, amountCents))
+	return nil
+}
+
+func (p *recordingProcessor) Void(_ context.Context, ref string) error {
+	p.calls = append(p.calls, "void "+ref)
+	return nil
+}
+
+func (p *recordingProcessor) Refund(_ context.Context, ref string, amountCents int64) error {
+	p.calls = append(p.calls, fmt.Sprintf("refund %s %d", ref, amountCents))
+	return nil
+}
+
+func TestProcessorTxUndoesOnlyUncommittedCalls(t *testing.T) {
+	var undone int
+	undo := func(context.Context) { undone++ }
+
+	tx := &processorTx{Tx: &stubTx{}}
+	tx.onRollback(undo)
+	if err := tx.Commit(context.Background()); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	rollback(context.Background(), tx)
+	if undone != 0 {
+		t.Fatalf("undone %d calls after a commit", undone)
+	}
+
+	tx = &processorTx{Tx: &stubTx{commitErr: errors.New("connection reset")}}
+	tx.onRollback(undo)
+	if err := tx.Commit(context.Background()); err == nil {
+		t.Fatal("Commit succeeded")
+	}
+	rollback(context.Background(), tx)
+	if undone != 1 {
+		t.Fatalf("undone %d calls after a failed commit, want 1", undone)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tx = &processorTx{Tx: &stubTx{}}
+	tx.onRollback(func(ctx context.Context) {
+		if ctx.Err() != nil {
+			t.Error("undo ran with a cancelled context")
+		}
+		undone++
+	})
+	rollback(ctx, tx)
+	if undone != 2 {
+		t.Fatalf("undone %d calls after a rollback, want 2", undone)
+	}
+}
+
+func TestChargePaymentCompensatesUnrecordedCharge(t *testing.T) {
+	for capture, want := range map[bool][]string{
+		false: {"authorize 500", "void ref_1"},
+		true:  {"authorize 500", "capture ref_1 500", "refund ref_1 500"},
+	} {
+		proc := &recordingProcessor{}
+		s := &Store{proc: proc}
+		tx := &processorTx{Tx: &stubTx{queryErr: errors.New("insert failed")}}
+
+		_, _, err := s.chargePayment(context.Background(), tx, newPayment{
+			cardToken: "tok_visa", amountCents: 500, currency: "USD", capture: capture,
+		})
+		if status.Code(err) != codes.Internal {
+			t.Fatalf("capture=%v: chargePayment = %v, want Internal", capture, err)
+		}
+		rollback(context.Background(), tx)
+		if !slices.Equal(proc.calls, want) {
+			t.Errorf("capture=%v: processor calls = %q, want %q", capture, proc.calls, want)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// Pruner periodically deletes rows that have outlived their expiry.
+type Pruner struct {
+	// what names the rows in log lines, e.g. "idempotency keys".
+	what     string
+	prune    func(ctx context.Context, now time.Time) (int, error)
+	interval time.Duration
+}
+
+// newPruner returns a Pruner calling prune every interval, or every def when
+// interval is unset or invalid.
+func newPruner(what string, prune func(ctx context.Context, now time.Time) (int, error), interval string, def time.Duration) *Pruner {
+	p := &Pruner{what: what, prune: prune, interval: def}
+	if d, err := time.ParseDuration(strings.TrimSpace(interval)); err == nil && d > 0 {
+		p.interval = d
+	}
+	return p
+}
+
+// Run prunes every interval until ctx is cancelled.
+func (p *Pruner) Run(ctx context.Context) {
+	t := time.NewTicker(p.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		n, err := p.prune(ctx, time.Now())
+		if err != nil {
+			slog.Error("prune failed", "error", err, "rows", p.what)
+			continue
+		}
+		if n > 0 {
+			slog.Info("Pruned "+p.what, "entries", n)
+		}
+	}
+}
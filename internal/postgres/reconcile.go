@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grpc-buf/internal/fx"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"github.com/grpc-buf/internal/money"
+	"github.com/grpc-buf/internal/reconcile"
+	"github.com/jackc/pgx/v5"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxSettlementBytes caps the settlement file ReconcileSettlement accepts.
+const maxSettlementBytes = 32 << 20
+
+// ReconcileSettlement parses the settlement CSV with the configured column
+// mapping, matches it against settled payments created between start_date
+// and end_date (defaulting to the file's span) and returns the items with a
+// rendered report.
+func (s *Store) ReconcileSettlement(ctx context.Context, req *connect.Request[paymentv1.ReconcileSettlementRequest]) (*connect.Response[paymentv1.ReconcileSettlementResponse], error) {
+	data := req.Msg.GetSettlementCsv()
+	if len(data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "settlement_csv is required")
+	}
+	if len(data) > maxSettlementBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "settlement_csv must be at most %d bytes", maxSettlementBytes)
+	}
+	format := reconcile.FormatCSV
+	switch req.Msg.GetReportFormat() {
+	case paymentv1.ReportFormat_REPORT_FORMAT_UNSPECIFIED, paymentv1.ReportFormat_REPORT_FORMAT_CSV:
+	case paymentv1.ReportFormat_REPORT_FORMAT_JSON:
+		format = reconcile.FormatJSON
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown report_format")
+	}
+	entries, err := reconcile.ParseCSV(bytes.NewReader(data), s.recon)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	from, to := reconcile.Span(entries)
+	if d := req.Msg.GetStartDate(); d != nil {
+		if from, err = calendarDate("start_date", d); err != nil {
+			return nil, err
+		}
+	}
+	if d := req.Msg.GetEndDate(); d != nil {
+		if to, err = calendarDate("end_date", d); err != nil {
+			return nil, err
+		}
+	}
+	if to.Before(from) {
+		return nil, status.Error(codes.InvalidArgument, "end_date must not be before start_date")
+	}
+
+	report, err := s.Reconcile(ctx, entries, from, to)
+	if err != nil {
+		slog.Error("reconcile settlement failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to reconcile settlement")
+	}
+	var buf bytes.Buffer
+	if err := reconcile.Write(format, &buf, report); err != nil {
+		slog.Error("reconciliation report failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to reconcile settlement")
+	}
+
+	resp := &paymentv1.ReconcileSettlementResponse{
+		Items:             make([]*paymentv1.ReconciliationItem, 0, len(report.Items)),
+		Matched:           int32(report.Matched),
+		MissingOurs:       int32(report.MissingOurs),
+		MissingProvider:   int32(report.MissingProvider),
+		AmountMismatch:    int32(report.Mismatched),
+		Report:            buf.Bytes(),
+		ReportContentType: reconcile.ContentType(format),
+	}
+	for _, it := range report.Items {
+		item := &paymentv1.ReconciliationItem{Status: it.Status}
+		if e := it.Entry; e != nil {
+			item.Line = int32(e.Line)
+			item.Reference = e.Reference
+			item.ProviderAmount = minorMoney(e.Amount, e.Currency)
+			item.ProviderDate = protoDate(e.Date)
+		}
+		if p := it.Payment; p != nil {
+			item.PaymentId = p.ID
+			if item.Reference == "" {
+				item.Reference = p.Reference
+			}
+			item.OurAmount = minorMoney(p.Amount, p.Currency)
+			item.OurDate = protoDate(p.Date)
+		}
+		resp.Items = append(resp.Items, item)
+	}
+	slog.Info("Settlement reconciled", "rows", len(entries), "matched", report.Matched,
+		"missing_ours", report.MissingOurs, "missing_provider", report.MissingProvider, "amount_mismatch", report.Mismatched)
+	return connect.NewResponse(resp), nil
+}
+
+// Reconcile matches entries against the settled payments created on the days
+// from through to (UTC), plus any settled payment whose processor_ref an
+// entry names.
+func (s *Store) Reconcile(ctx context.Context, entries []reconcile.Entry, from, to time.Time) (reconcile.Report, error) {
+	refs := reconcile.References(entries)
+	if refs == nil {
+		refs = []string{}
+	}
+	rows, err := s.db.Query(ctx,
+		`SELECT id, COALESCE(processor_ref, ''), amount_cents, currency_code, created_at
+         FROM payments
+         WHERE `+settled+` AND ((created_at >= $1 AND created_at < $2) OR processor_ref = ANY($3))
+         ORDER BY created_at, id`,
+		fx.Day(from), fx.Day(to).AddDate(0, 0, 1), refs)
+	if err != nil {
+		return reconcile.Report{}, fmt.Errorf("query settled payments: %w", err)
+	}
+	payments, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (reconcile.Payment, error) {
+		var p reconcile.Payment
+		err := row.Scan(&p.ID, &p.Reference, &p.Amount, &p.Currency, &p.Date)
+		p.Date = fx.Day(p.Date)
+		return p, err
+	})
+	if err != nil {
+		return reconcile.Report{}, fmt.Errorf("scan settled payments: %w", err)
+	}
+	return reconcile.Match(entries, payments), nil
+}
+
+// minorMoney converts stored minor units to Money, or nil for an unknown
+// currency.
+func minorMoney(minor int64, currency string) *moneypb.Money {
+	m, err := money.FromMinor(minor, currency)
+	if err != nil {
+		return nil
+	}
+	return m
+}
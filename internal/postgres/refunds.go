@@ -0,0 +1,191 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"github.com/grpc-buf/internal/money"
+	"github.com/jackc/pgx/v5"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxRefundNote bounds Refund.note, in characters.
+const maxRefundNote = 1000
+
+const refundColumns = `id, payment_id, amount_cents, currency_code, reason, note, created_at`
+
+// CreateRefund refunds req.amount (or everything still refundable when
+// unset) of a captured payment and records the refund with its reason and
+// note. Requests carrying an idempotency key (header or field) are executed
+// at most once per key; see idempotent.
+func (s *Store) CreateRefund(ctx context.Context, req *connect.Request[paymentv1.CreateRefundRequest]) (*connect.Response[paymentv1.Refund], error) {
+	paymentID := strings.TrimSpace(req.Msg.GetPaymentId())
+	if paymentID == "" {
+		return nil, status.Error(codes.InvalidArgument, "payment_id is required")
+	}
+	reason := req.Msg.GetReason()
+	if _, ok := paymentv1.RefundReason_name[int32(reason)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown refund reason")
+	}
+	note := strings.TrimSpace(req.Msg.GetNote())
+	if utf8.RuneCountInString(note) > maxRefundNote {
+		return nil, status.Errorf(codes.InvalidArgument, "note must be at most %d characters", maxRefundNote)
+	}
+	key, err := resolveIdempotencyKey(req.Header(), req.Msg.GetIdempotencyKey())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.beginProcessorTx(ctx)
+	if err != nil {
+		slog.Error("create refund begin failed", "error", err, "payment_id", paymentID)
+		return nil, status.Error(codes.Internal, "failed to create refund")
+	}
+	defer rollback(ctx, tx)
+
+	r, replayed, err := idempotent(ctx, tx, s.idempotencyTTL(), "CreateRefund", key, req.Msg, &paymentv1.Refund{},
+		func() (*paymentv1.Refund, error) {
+			r, _, err := s.refundPayment(ctx, tx, paymentID, req.Msg.GetAmount(), reason, note)
+			return r, err
+		})
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("create refund commit failed", "error", err, "payment_id", paymentID)
+		return nil, status.Error(codes.Internal, "failed to create refund")
+	}
+
+	slog.Info("Refund created", "id", r.GetId(), "payment_id", paymentID, "replayed", replayed)
+	resp := connect.NewResponse(r)
+	if replayed {
+		resp.Header().Set(IdempotentReplayedHeader, "true")
+	}
+	return resp, nil
+}
+
+// refundPayment refunds amount (or everything still refundable when nil) of
+// payment id inside tx through the processor and records it in refunds. It
+// returns the refund and the updated payment.
+func (s *Store) refundPayment(ctx context.Context, tx *processorTx, id string, amount *moneypb.Money, reason paymentv1.RefundReason, note string) (*paymentv1.Refund, *paymentv1.Payment, error) {
+	cur, updated, err := lockedTransition(ctx, tx, id, "refund", func(p *paymentv1.Payment) (paymentv1.PaymentStatus, int64, error) {
+		return refundTransition(p, amount)
+	}, s.applyTransition(tx))
+	if err != nil {
+		return nil, nil, err
+	}
+	refunded := storedMinor(updated.GetRefundedAmount()) - storedMinor(cur.GetRefundedAmount())
+	r, err := scanRefund(tx.QueryRow(ctx,
+		`INSERT INTO refunds (payment_id, amount_cents, currency_code, reason, note)
+         VALUES ($1, $2, $3, $4, $5)
+         RETURNING `+refundColumns,
+		id, refunded, updated.GetAmount().GetCurrencyCode(), int(reason), note))
+	if err != nil {
+		slog.Error("insert refund failed", "error", err, "payment_id", id)
+		return nil, nil, status.Error(codes.Internal, "failed to update payment")
+	}
+	return r, updated, nil
+}
+
+// GetRefund returns a single refund by id. Returns codes.NotFound when the
+// refund does not exist.
+func (s *Store) GetRefund(ctx context.Context, req *connect.Request[paymentv1.GetRefundRequest]) (*connect.Response[paymentv1.Refund], error) {
+	id := strings.TrimSpace(req.Msg.GetId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	r, err := scanRefund(s.db.QueryRow(ctx, `SELECT `+refundColumns+` FROM refunds WHERE id=$1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "refund not found")
+		}
+		slog.Error("get refund query failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to get refund")
+	}
+	return connect.NewResponse(r), nil
+}
+
+// ListRefunds returns a page of refunds ordered by creation time (newest
+// first), optionally for one payment. Pagination uses the same opaque
+// "o:<offset>" tokens as ListExpenses.
+func (s *Store) ListRefunds(ctx context.Context, req *connect.Request[paymentv1.ListRefundsRequest]) (*connect.Response[paymentv1.ListRefundsResponse], error) {
+	pageSize := req.Msg.GetPageSize()
+	if pageSize <= 0 || pageSize > 1000 {
+		pageSize = 50
+	}
+
+	offset := 0
+	if req.Msg.GetPageToken() != "" {
+		if n, err := fmt.Sscanf(req.Msg.GetPageToken(), "o:%d", &offset); n != 1 || err != nil {
+			offset = 0
+		}
+	}
+
+	where, args := "", []any{pageSize, offset}
+	if id := strings.TrimSpace(req.Msg.GetPaymentId()); id != "" {
+		where = "WHERE payment_id=$3"
+		args = append(args, id)
+	}
+
+	rows, err := s.db.Query(ctx,
+		`SELECT `+refundColumns+` FROM refunds `+where+`
+         ORDER BY created_at DESC LIMIT $1 OFFSET $2`, args...)
+	if err != nil {
+		slog.Error("list refunds query failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list refunds")
+	}
+	defer rows.Close()
+
+	resp := &paymentv1.ListRefundsResponse{}
+	for rows.Next() {
+		r, err := scanRefund(rows)
+		if err != nil {
+			slog.Error("list refunds scan failed", "error", err)
+			return nil, status.Error(codes.Internal, "failed to list refunds")
+		}
+		resp.Refunds = append(resp.Refunds, r)
+	}
+	if err := rows.Err(); err != nil {
+		slog.Error("list refunds iteration failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list refunds")
+	}
+	if len(resp.Refunds) == int(pageSize) {
+		resp.NextPageToken = fmt.Sprintf("o:%d", offset+int(pageSize))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// scanRefund reads a row selected with refundColumns.
+func scanRefund(row pgx.Row) (*paymentv1.Refund, error) {
+	var (
+		id, paymentID, currency, note string
+		amountCents                   int64
+		reason                        int32
+		createdAt                     time.Time
+	)
+	if err := row.Scan(&id, &paymentID, &amountCents, &currency, &reason, &note, &createdAt); err != nil {
+		return nil, err
+	}
+	amount, err := money.FromMinor(amountCents, currency)
+	if err != nil {
+		return nil, err
+	}
+	return &paymentv1.Refund{
+		Id:         id,
+		PaymentId:  paymentID,
+		Amount:     amount,
+		Reason:     paymentv1.RefundReason(reason),
+		Note:       note,
+		CreateTime: timestamppb.New(createdAt),
+	}, nil
+}
@@ -0,0 +1,448 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grpc-buf/internal/fx"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	subscriptionv1 "github.com/grpc-buf/internal/gen/proto/subscription"
+	"github.com/grpc-buf/internal/money"
+	"github.com/grpc-buf/internal/subscription"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// subscriptionColumns selects a subscription for scanSubscription. Like
+// payments, only the last four characters of the card token are returned.
+const subscriptionColumns = `id, name, price_cents, currency_code, tax_rate_bps, billing_interval, anchor_day, start_date,
+    COALESCE(RIGHT(card_token, 4), ''), card_type, days_until_due, status, next_billing_date, latest_invoice_id, created_at, updated_at, cancelled_at`
+
+// CreateSubscription validates and stores an ACTIVE subscription whose first
+// invoice is due on the first anchor day on or after its start date.
+func (s *Store) CreateSubscription(ctx context.Context, req *connect.Request[subscriptionv1.CreateSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error) {
+	sub := req.Msg.GetSubscription()
+	if sub == nil {
+		return nil, status.Error(codes.InvalidArgument, "subscription is required")
+	}
+	name := strings.TrimSpace(sub.GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	priceMinor, err := minorUnits("price", sub.GetPrice())
+	if err != nil {
+		return nil, err
+	}
+	if priceMinor <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "price must be positive")
+	}
+	if r := sub.GetTaxRateBps(); r < 0 || r > 10000 {
+		return nil, status.Error(codes.InvalidArgument, "tax_rate_bps must be between 0 and 10000")
+	}
+	interval := sub.GetInterval()
+	if _, ok := subscriptionv1.BillingInterval_name[int32(interval)]; !ok || interval == subscriptionv1.BillingInterval_BILLING_INTERVAL_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "interval is required")
+	}
+	start := fx.Day(time.Now())
+	if d := sub.GetStartDate(); d != nil {
+		if start, err = calendarDate("start_date", d); err != nil {
+			return nil, err
+		}
+	}
+	anchor := int(sub.GetAnchorDay())
+	if anchor == 0 {
+		anchor = subscription.DefaultAnchor(interval, start)
+	}
+	if err := subscription.ValidateAnchor(interval, anchor); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "anchor_day is out of range for the interval")
+	}
+	if sub.GetDaysUntilDue() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "days_until_due must not be negative")
+	}
+	var (
+		cardToken *string
+		cardType  paymentv1.CardType
+	)
+	if t := strings.TrimSpace(sub.GetCardToken()); t != "" {
+		if cardType = sub.GetCard(); !validCardType(cardType) {
+			return nil, status.Error(codes.InvalidArgument, "card type is required with card_token")
+		}
+		if err := s.VerifyCardToken(ctx, t); err != nil {
+			return nil, err
+		}
+		cardToken = &t
+	}
+
+	created, err := scanSubscription(s.db.QueryRow(ctx,
+		`INSERT INTO subscriptions (name, price_cents, currency_code, tax_rate_bps, billing_interval, anchor_day,
+                                    start_date, card_token, card_type, days_until_due, status, next_billing_date)
+         VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+         RETURNING `+subscriptionColumns,
+		name, priceMinor, sub.GetPrice().GetCurrencyCode(), sub.GetTaxRateBps(), int(interval), anchor,
+		start, cardToken, int(cardType), sub.GetDaysUntilDue(), int(subscriptionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE),
+		subscription.FirstBilling(interval, anchor, start)))
+	if err != nil {
+		slog.Error("create subscription query failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create subscription")
+	}
+	return connect.NewResponse(created), nil
+}
+
+// GetSubscription fetches a single subscription by id. Returns
+// codes.NotFound when the row does not exist.
+func (s *Store) GetSubscription(ctx context.Context, req *connect.Request[subscriptionv1.GetSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error) {
+	id := strings.TrimSpace(req.Msg.GetId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	sub, err := scanSubscription(s.db.QueryRow(ctx,
+		`SELECT `+subscriptionColumns+` FROM subscriptions WHERE id=$1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "subscription not found")
+		}
+		slog.Error("get subscription query failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to get subscription")
+	}
+	return connect.NewResponse(sub), nil
+}
+
+// ListSubscriptions returns a page of subscriptions ordered by creation time
+// (newest first), optionally filtered by status. Pagination uses the same
+// opaque "o:<offset>" tokens as ListExpenses.
+func (s *Store) ListSubscriptions(ctx context.Context, req *connect.Request[subscriptionv1.ListSubscriptionsRequest]) (*connect.Response[subscriptionv1.ListSubscriptionsResponse], error) {
+	pageSize := req.Msg.GetPageSize()
+	if pageSize <= 0 || pageSize > 1000 {
+		pageSize = 50
+	}
+
+	offset := 0
+	if req.Msg.GetPageToken() != "" {
+		if n, err := fmt.Sscanf(req.Msg.GetPageToken(), "o:%d", &offset); n != 1 || err != nil {
+			offset = 0
+		}
+	}
+
+	args := []any{pageSize, offset}
+	where := ""
+	if st := req.Msg.GetStatus(); st != subscriptionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED {
+		if _, ok := subscriptionv1.SubscriptionStatus_name[int32(st)]; !ok {
+			return nil, status.Error(codes.InvalidArgument, "unknown subscription status filter")
+		}
+		args = append(args, int(st))
+		where = "WHERE status=$3"
+	}
+
+	rows, err := s.db.Query(ctx,
+		`SELECT `+subscriptionColumns+` FROM subscriptions `+where+`
+         ORDER BY created_at DESC LIMIT $1 OFFSET $2`, args...)
+	if err != nil {
+		slog.Error("list subscriptions query failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list subscriptions")
+	}
+	defer rows.Close()
+
+	resp := &subscriptionv1.ListSubscriptionsResponse{}
+	for rows.Next() {
+		sub, err := scanSubscription(rows)
+		if err != nil {
+			slog.Error("list subscriptions scan failed", "error", err)
+			return nil, status.Error(codes.Internal, "failed to list subscriptions")
+		}
+		resp.Subscriptions = append(resp.Subscriptions, sub)
+	}
+	if err := rows.Err(); err != nil {
+		slog.Error("list subscriptions iteration failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list subscriptions")
+	}
+	if len(resp.Subscriptions) == int(pageSize) {
+		resp.NextPageToken = fmt.Sprintf("o:%d", offset+int(pageSize))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// PauseSubscription stops invoicing an ACTIVE subscription.
+func (s *Store) PauseSubscription(ctx context.Context, req *connect.Request[subscriptionv1.PauseSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error) {
+	sub, err := s.changeSubscription(ctx, req.Msg.GetId(), "pause", func(sub *subscriptionv1.Subscription) error {
+		if sub.GetStatus() != subscriptionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE {
+			return status.Error(codes.FailedPrecondition, "only active subscriptions can be paused")
+		}
+		sub.Status = subscriptionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_PAUSED
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(sub), nil
+}
+
+// ResumeSubscription reactivates a PAUSED subscription. A next billing date
+// that passed while paused moves to the first period boundary on or after
+// today, so paused periods are not billed.
+func (s *Store) ResumeSubscription(ctx context.Context, req *connect.Request[subscriptionv1.ResumeSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error) {
+	sub, err := s.changeSubscription(ctx, req.Msg.GetId(), "resume", func(sub *subscriptionv1.Subscription) error {
+		if sub.GetStatus() != subscriptionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_PAUSED {
+			return status.Error(codes.FailedPrecondition, "only paused subscriptions can be resumed")
+		}
+		next, err := calendarDate("next_billing_date", sub.GetNextBillingDate())
+		if err != nil {
+			return err
+		}
+		next = subscription.NextOnOrAfter(sub.GetInterval(), int(sub.GetAnchorDay()), next, time.Now())
+		sub.Status = subscriptionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE
+		sub.NextBillingDate = protoDate(next)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(sub), nil
+}
+
+// CancelSubscription ends an ACTIVE or PAUSED subscription.
+func (s *Store) CancelSubscription(ctx context.Context, req *connect.Request[subscriptionv1.CancelSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error) {
+	sub, err := s.changeSubscription(ctx, req.Msg.GetId(), "cancel", func(sub *subscriptionv1.Subscription) error {
+		if sub.GetStatus() == subscriptionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELLED {
+			return status.Error(codes.FailedPrecondition, "subscription is already cancelled")
+		}
+		sub.Status = subscriptionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELLED
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(sub), nil
+}
+
+// changeSubscription locks subscription id, lets change validate it and set
+// its new status and next billing date, and stores the result. Entering
+// CANCELLED stamps cancelled_at.
+func (s *Store) changeSubscription(ctx context.Context, id, op string, change func(*subscriptionv1.Subscription) error) (*subscriptionv1.Subscription, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.Error(op+" subscription begin failed", "error", err, "id", id)
+		return nil, status.Errorf(codes.Internal, "failed to %s subscription", op)
+	}
+	defer rollback(ctx, tx)
+
+	sub, err := scanSubscription(tx.QueryRow(ctx,
+		`SELECT `+subscriptionColumns+` FROM subscriptions WHERE id=$1 FOR UPDATE`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "subscription not found")
+		}
+		slog.Error(op+" subscription load failed", "error", err, "id", id)
+		return nil, status.Errorf(codes.Internal, "failed to %s subscription", op)
+	}
+	if err := change(sub); err != nil {
+		return nil, err
+	}
+	next, err := calendarDate("next_billing_date", sub.GetNextBillingDate())
+	if err != nil {
+		return nil, err
+	}
+	updated, err := scanSubscription(tx.QueryRow(ctx,
+		`UPDATE subscriptions
+         SET status=$2, next_billing_date=$3, updated_at=NOW(),
+             cancelled_at=CASE WHEN $2=$4 THEN NOW() ELSE cancelled_at END
+         WHERE id=$1
+         RETURNING `+subscriptionColumns,
+		id, int(sub.GetStatus()), next, int(subscriptionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELLED)))
+	if err != nil {
+		slog.Error(op+" subscription update failed", "error", err, "id", id)
+		return nil, status.Errorf(codes.Internal, "failed to %s subscription", op)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error(op+" subscription commit failed", "error", err, "id", id)
+		return nil, status.Errorf(codes.Internal, "failed to %s subscription", op)
+	}
+	return updated, nil
+}
+
+// InvoiceDueSubscriptions claims up to limit ACTIVE subscriptions due on or
+// before today, skipping rows locked by another replica, and in one
+// transaction issues the invoice for each one's current period and advances
+// it by one period. A subscription several periods behind is claimed again
+// by the next call.
+func (s *Store) InvoiceDueSubscriptions(ctx context.Context, today time.Time, limit int) ([]subscription.Invoiced, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback(ctx, tx)
+
+	rows, err := tx.Query(ctx,
+		`SELECT `+subscriptionColumns+` FROM subscriptions
+         WHERE status=$1 AND next_billing_date <= $2::date
+         ORDER BY next_billing_date
+         LIMIT $3
+         FOR UPDATE SKIP LOCKED`,
+		int(subscriptionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE), today, limit)
+	if err != nil {
+		return nil, fmt.Errorf("claim subscriptions: %w", err)
+	}
+	var due []*subscriptionv1.Subscription
+	for rows.Next() {
+		sub, err := scanSubscription(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan subscription: %w", err)
+		}
+		due = append(due, sub)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("claim subscriptions: %w", err)
+	}
+
+	out := make([]subscription.Invoiced, 0, len(due))
+	for _, sub := range due {
+		inv, next, err := invoiceSubscriptionPeriod(ctx, tx, sub)
+		if err != nil {
+			return nil, fmt.Errorf("invoice subscription %s: %w", sub.GetId(), err)
+		}
+		if _, err := tx.Exec(ctx,
+			`UPDATE subscriptions SET next_billing_date=$2, latest_invoice_id=$3, updated_at=NOW() WHERE id=$1`,
+			sub.GetId(), next, inv.GetId()); err != nil {
+			return nil, fmt.Errorf("advance subscription %s: %w", sub.GetId(), err)
+		}
+		if sub.GetCardToken() != "" {
+			if _, err := tx.Exec(ctx,
+				`INSERT INTO subscription_autopay (invoice_id, subscription_id) VALUES ($1, $2)`,
+				inv.GetId(), sub.GetId()); err != nil {
+				return nil, fmt.Errorf("record subscription payment %s: %w", sub.GetId(), err)
+			}
+		}
+		out = append(out, subscription.Invoiced{SubscriptionID: sub.GetId(), InvoiceID: inv.GetId()})
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClaimAutoPays implements subscription.Store. The card token is read from
+// the subscription at claim time, so a replaced card is used for retries.
+func (s *Store) ClaimAutoPays(ctx context.Context, now, retryAt time.Time, limit int) ([]subscription.AutoPay, error) {
+	rows, err := s.db.Query(ctx,
+		`UPDATE subscription_autopay a SET attempts=a.attempts+1, next_attempt_at=$2
+         FROM subscriptions s
+         WHERE s.id=a.subscription_id AND a.invoice_id IN (
+             SELECT invoice_id FROM subscription_autopay
+             WHERE next_attempt_at <= $1
+             ORDER BY next_attempt_at
+             LIMIT $3
+             FOR UPDATE SKIP LOCKED)
+         RETURNING a.subscription_id::text, a.invoice_id::text, COALESCE(s.card_token, ''), s.card_type, a.attempts`,
+		now, retryAt, limit)
+	if err != nil {
+		return nil, fmt.Errorf("claim subscription payments: %w", err)
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (subscription.AutoPay, error) {
+		var p subscription.AutoPay
+		var cardType int32
+		err := row.Scan(&p.SubscriptionID, &p.InvoiceID, &p.CardToken, &cardType, &p.Attempt)
+		p.CardType = paymentv1.CardType(cardType)
+		return p, err
+	})
+}
+
+// FinishAutoPay implements subscription.Store.
+func (s *Store) FinishAutoPay(ctx context.Context, invoiceID string) error {
+	if _, err := s.db.Exec(ctx, `DELETE FROM subscription_autopay WHERE invoice_id=$1`, invoiceID); err != nil {
+		return fmt.Errorf("finish subscription payment: %w", err)
+	}
+	return nil
+}
+
+// invoiceSubscriptionPeriod issues the invoice for the period of sub that
+// starts on its next billing date and returns it with the following billing
+// date.
+func invoiceSubscriptionPeriod(ctx context.Context, tx pgx.Tx, sub *subscriptionv1.Subscription) (*paymentv1.Invoice, time.Time, error) {
+	start, err := calendarDate("next_billing_date", sub.GetNextBillingDate())
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	next := subscription.Next(sub.GetInterval(), int(sub.GetAnchorDay()), start)
+	priced, err := priceInvoice(&paymentv1.Invoice{
+		LineItems: []*paymentv1.InvoiceLineItem{{
+			Description: fmt.Sprintf("%s (%s to %s)", sub.GetName(), start.Format(time.DateOnly), next.AddDate(0, 0, -1).Format(time.DateOnly)),
+			Quantity:    1,
+			UnitPrice:   sub.GetPrice(),
+			TaxRateBps:  sub.GetTaxRateBps(),
+		}},
+	})
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	id, due := sub.GetId(), start.AddDate(0, 0, int(sub.GetDaysUntilDue()))
+	inv, err := insertInvoice(ctx, tx, newInvoice{
+		name:           sub.GetName() + " " + start.Format(time.DateOnly),
+		priced:         priced,
+		dueDate:        &due,
+		subscriptionID: &id,
+		periodStart:    &start,
+	})
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return inv, next, nil
+}
+
+// scanSubscription reads a row selected with subscriptionColumns.
+func scanSubscription(row pgx.Row) (*subscriptionv1.Subscription, error) {
+	var (
+		id, name, currency, cardToken string
+		priceCents                    int64
+		taxRate, interval, anchor     int32
+		cardType, daysUntilDue, st    int32
+		startDate, nextBilling        time.Time
+		latestInvoiceID               *string
+		createdAt, updatedAt          time.Time
+		cancelledAt                   *time.Time
+	)
+	if err := row.Scan(&id, &name, &priceCents, &currency, &taxRate, &interval, &anchor, &startDate,
+		&cardToken, &cardType, &daysUntilDue, &st, &nextBilling, &latestInvoiceID, &createdAt, &updatedAt, &cancelledAt); err != nil {
+		return nil, err
+	}
+	price, err := money.FromMinor(priceCents, currency)
+	if err != nil {
+		return nil, err
+	}
+	sub := &subscriptionv1.Subscription{
+		Id:           id,
+		Name:         name,
+		Price:        price,
+		TaxRateBps:   taxRate,
+		Interval:     subscriptionv1.BillingInterval(interval),
+		AnchorDay:    anchor,
+		StartDate:    protoDate(startDate),
+		CardToken:    cardToken,
+		Card:         paymentv1.CardType(cardType),
+		DaysUntilDue: daysUntilDue,
+		Status:       subscriptionv1.SubscriptionStatus(st),
+		CreateTime:   timestamppb.New(createdAt),
+		UpdateTime:   timestamppb.New(updatedAt),
+	}
+	if sub.Status != subscriptionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELLED {
+		sub.NextBillingDate = protoDate(nextBilling)
+	}
+	if latestInvoiceID != nil {
+		sub.LatestInvoiceId = *latestInvoiceID
+	}
+	if cancelledAt != nil {
+		sub.CancelTime = timestamppb.New(*cancelledAt)
+	}
+	return sub, nil
+}
@@ -0,0 +1,316 @@
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/grpc-buf/internal/auth"
+	userv1 "github.com/grpc-buf/internal/gen/proto/registration"
+	"github.com/grpc-buf/internal/security"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultAccessTokenTTL applies when security.access_token_ttl is unset.
+	defaultAccessTokenTTL = 15 * time.Minute
+	// defaultRefreshTokenTTL applies when security.refresh_token_ttl is unset.
+	defaultRefreshTokenTTL = 30 * 24 * time.Hour
+	// refreshTokenPrefix marks refresh tokens so they are not mistaken for
+	// access tokens.
+	refreshTokenPrefix = "rt_"
+	// denyMargin keeps denylist entries a little past token expiry to cover
+	// the verifier's clock-skew leeway.
+	denyMargin = time.Minute
+	// DefaultRefreshTokenPruneInterval applies when
+	// security.refresh_token_prune_interval is unset.
+	DefaultRefreshTokenPruneInterval = time.Hour
+)
+
+// errInvalidRefreshToken is returned for every rejected refresh token so
+// callers cannot tell unknown, expired, revoked and replayed tokens apart.
+var errInvalidRefreshToken = status.Error(codes.Unauthenticated, "invalid refresh token")
+
+// rowQueryer is the single-row side shared by the pool and transactions.
+type rowQueryer interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// accessTokenTTL returns the configured access token lifetime, falling back
+// to 15 minutes when unset or unparsable (config.Validate rejects bad values
+// at startup).
+func (s *Store) accessTokenTTL() time.Duration {
+	return durationOr(s.sec.AccessTokenTTL, defaultAccessTokenTTL)
+}
+
+// refreshTokenTTL returns the configured refresh token lifetime, falling back
+// to 30 days.
+func (s *Store) refreshTokenTTL() time.Duration {
+	return durationOr(s.sec.RefreshTokenTTL, defaultRefreshTokenTTL)
+}
+
+func durationOr(v string, def time.Duration) time.Duration {
+	if v = strings.TrimSpace(v); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			return d
+		}
+	}
+	return def
+}
+
+// RefreshToken exchanges a refresh token for a new access token and a
+// replacement refresh token in the same family. A token that was already
+// exchanged is treated as stolen: every token in its family is revoked and the
+// call fails with codes.Unauthenticated.
+func (s *Store) RefreshToken(ctx context.Context, req *connect.Request[userv1.RefreshTokenRequest]) (*connect.Response[userv1.LoginResponse], error) {
+	token := strings.TrimSpace(req.Msg.GetRefreshToken())
+	if token == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh_token is required")
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.Error("refresh token begin failed", "error", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	defer rollback(ctx, tx)
+
+	var (
+		id, userID, family   string
+		expiresAt            time.Time
+		rotatedAt, revokedAt *time.Time
+	)
+	err = tx.QueryRow(ctx,
+		`SELECT id, user_id, family_id, expires_at, rotated_at, revoked_at
+         FROM refresh_tokens WHERE token_hash=$1 FOR UPDATE`,
+		hashRefreshToken(token)).Scan(&id, &userID, &family, &expiresAt, &rotatedAt, &revokedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errInvalidRefreshToken
+		}
+		slog.Error("refresh token lookup failed", "error", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	switch {
+	case revokedAt != nil:
+		return nil, errInvalidRefreshToken
+	case rotatedAt != nil:
+		if _, err := tx.Exec(ctx,
+			`UPDATE refresh_tokens SET revoked_at=NOW() WHERE family_id=$1 AND revoked_at IS NULL`, family); err != nil {
+			slog.Error("refresh token family revoke failed", "error", err, "family_id", family)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+		if err := tx.Commit(ctx); err != nil {
+			slog.Error("refresh token family revoke commit failed", "error", err, "family_id", family)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+		slog.Warn("Refresh token reused; family revoked", "user_id", userID, "family_id", family)
+		return nil, errInvalidRefreshToken
+	case !time.Now().Before(expiresAt):
+		return nil, errInvalidRefreshToken
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE refresh_tokens SET rotated_at=NOW() WHERE id=$1`, id); err != nil {
+		slog.Error("refresh token rotate failed", "error", err, "family_id", family)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	resp, err := s.issueSession(ctx, tx, userID, family)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("refresh token commit failed", "error", err, "family_id", family)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// LogoutUser revokes the access token the call was made with until it
+// expires and, when req.refresh_token is set, the caller's refresh token
+// family it belongs to.
+func (s *Store) LogoutUser(ctx context.Context, req *connect.Request[userv1.LogoutRequest]) (*connect.Response[userv1.LogoutResponse], error) {
+	caller, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if caller.TokenID == "" {
+		return nil, status.Error(codes.FailedPrecondition, "caller has no access token to revoke")
+	}
+	expires := caller.ExpiresAt
+	if expires.IsZero() {
+		expires = time.Now().Add(s.accessTokenTTL())
+	}
+
+	if token := strings.TrimSpace(req.Msg.GetRefreshToken()); token != "" {
+		if _, err := s.db.Exec(ctx,
+			`UPDATE refresh_tokens SET revoked_at=NOW()
+             WHERE revoked_at IS NULL AND family_id = (
+                 SELECT family_id FROM refresh_tokens WHERE token_hash=$1 AND user_id::text=$2)`,
+			hashRefreshToken(token), caller.Subject); err != nil {
+			slog.Error("logout refresh token revoke failed", "error", err)
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+	if err := s.deny.Deny(ctx, caller.TokenID, expires.Add(denyMargin)); err != nil {
+		slog.Error("logout token revoke failed", "error", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	slog.Info("User logged out", "user_id", caller.Subject)
+	return connect.NewResponse(&userv1.LogoutResponse{}), nil
+}
+
+// RevokeUserSessions revokes every refresh token of req.user_id and denies
+// every access token issued to the user so far until the longest-lived of
+// them has expired.
+func (s *Store) RevokeUserSessions(ctx context.Context, req *connect.Request[userv1.RevokeUserSessionsRequest]) (*connect.Response[userv1.RevokeUserSessionsResponse], error) {
+	if _, ok := auth.UserFromContext(ctx); !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	userID := strings.TrimSpace(req.Msg.GetUserId())
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	// Postgres keeps microseconds, the precision of the tokens' iat_us.
+	now := time.Now().Truncate(time.Microsecond)
+	tag, err := s.db.Exec(ctx,
+		`UPDATE refresh_tokens SET revoked_at=NOW() WHERE user_id::text=$1 AND revoked_at IS NULL`, userID)
+	if err != nil {
+		slog.Error("revoke user refresh tokens failed", "error", err, "user_id", userID)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	if err := s.deny.DenySubject(ctx, userID, now, now.Add(s.accessTokenTTL()+denyMargin)); err != nil {
+		slog.Error("revoke user access tokens failed", "error", err, "user_id", userID)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	slog.Info("User sessions revoked", "user_id", userID, "refresh_tokens", tag.RowsAffected())
+	return connect.NewResponse(&userv1.RevokeUserSessionsResponse{RevokedRefreshTokens: int32(tag.RowsAffected())}), nil
+}
+
+// issueSession signs an access token carrying userID's current roles and
+// stores a new refresh token in family, or in a new family when family is
+// empty.
+func (s *Store) issueSession(ctx context.Context, q rowQueryer, userID, family string) (*userv1.LoginResponse, error) {
+	var roles []string
+	if err := q.QueryRow(ctx, `SELECT roles FROM users WHERE id=$1`, userID).Scan(&roles); err != nil {
+		slog.Error("error loading user roles", "error", err, "user_id", userID)
+		return nil, status.Error(codes.Internal, "error generating authentication token")
+	}
+	now := time.Now().UTC()
+	access, accessExpires, err := s.signAccessToken(userID, roles, now)
+	if err != nil {
+		return nil, err
+	}
+	refresh, err := newRefreshToken()
+	if err != nil {
+		slog.Error("error generating refresh token", "error", err)
+		return nil, status.Error(codes.Internal, "error generating authentication token")
+	}
+	refreshExpires := now.Add(s.refreshTokenTTL())
+	if err := q.QueryRow(ctx,
+		`INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at)
+         VALUES ($1, COALESCE(NULLIF($2, '')::uuid, gen_random_uuid()), $3, $4)
+         RETURNING family_id`,
+		userID, family, hashRefreshToken(refresh), refreshExpires).Scan(&family); err != nil {
+		slog.Error("error storing refresh token", "error", err)
+		return nil, status.Error(codes.Internal, "error generating authentication token")
+	}
+	return &userv1.LoginResponse{
+		AccessToken:            access,
+		RefreshToken:           refresh,
+		AccessTokenExpireTime:  timestamppb.New(accessExpires),
+		RefreshTokenExpireTime: timestamppb.New(refreshExpires),
+	}, nil
+}
+
+// signAccessToken returns an HS256 access token for userID with roles issued
+// at now, and its expiry.
+func (s *Store) signAccessToken(userID string, roles []string, now time.Time) (string, time.Time, error) {
+	expirationTime := now.Add(s.accessTokenTTL())
+	jti, err := randomJTI()
+	if err != nil {
+		slog.Error("error generating JWT ID", "error", err)
+		return "", time.Time{}, status.Error(codes.Internal, "error generating authentication token")
+	}
+	issuer := strings.TrimSpace(s.sec.JWTIssuer)
+	if issuer == "" {
+		issuer = "grpc-buf"
+	}
+	aud := strings.TrimSpace(s.sec.JWTAudience)
+	var auds jwt.ClaimStrings
+	if aud != "" {
+		auds = jwt.ClaimStrings{aud}
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, security.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuer,
+			Subject:   userID,
+			Audience:  auds,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			ID:        jti,
+		},
+		Roles:          roles,
+		IssuedAtMicros: now.UnixMicro(),
+	})
+
+	// Determine signing key from configuration
+	signKey := strings.TrimSpace(s.sec.JWTSecret)
+	if signKey == "" {
+		slog.Error("JWT secret missing")
+		return "", time.Time{}, status.Error(codes.Internal, "authentication not configured")
+	}
+
+	tokenString, err := token.SignedString([]byte(signKey))
+	if err != nil {
+		slog.Error("error signing JWT token", "error", err)
+		return "", time.Time{}, status.Error(codes.Internal, "error generating authentication token")
+	}
+	return tokenString, expirationTime, nil
+}
+
+// newRefreshToken returns a random opaque refresh token.
+func newRefreshToken() (string, error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("newRefreshToken: %w", err)
+	}
+	return refreshTokenPrefix + base64.RawURLEncoding.EncodeToString(b[:]), nil
+}
+
+// hashRefreshToken returns the digest refresh tokens are stored and looked up
+// by. Tokens carry 256 random bits, so an unsalted hash is sufficient.
+func hashRefreshToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
+
+// PruneRefreshTokens deletes refresh tokens that expired at or before now and
+// returns how many were removed. An expired token is rejected whether or not
+// it was rotated, so its row is no longer needed to detect a replay.
+func (s *Store) PruneRefreshTokens(ctx context.Context, now time.Time) (int, error) {
+	tag, err := s.db.Exec(ctx, `DELETE FROM refresh_tokens WHERE expires_at <= $1`, now)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// NewRefreshTokenPruner returns a Pruner that deletes expired refresh tokens.
+// An invalid or unset interval falls back to
+// DefaultRefreshTokenPruneInterval; config.Validate rejects it at startup.
+func NewRefreshTokenPruner(store DataStore, interval string) *Pruner {
+	return newPruner("refresh tokens", store.PruneRefreshTokens, interval, DefaultRefreshTokenPruneInterval)
+}
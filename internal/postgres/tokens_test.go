@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-buf/internal/auth"
+	"github.com/grpc-buf/internal/config"
+	"github.com/grpc-buf/internal/security"
+)
+
+func TestNewRefreshToken(t *testing.T) {
+	a, err := newRefreshToken()
+	if err != nil {
+		t.Fatalf("newRefreshToken: %v", err)
+	}
+	b, err := newRefreshToken()
+	if err != nil {
+		t.Fatalf("newRefreshToken: %v", err)
+	}
+	if a == b || !strings.HasPrefix(a, refreshTokenPrefix) {
+		t.Fatalf("tokens %q and %q must be distinct and prefixed", a, b)
+	}
+	if !bytes.Equal(hashRefreshToken(a), hashRefreshToken(a)) || bytes.Equal(hashRefreshToken(a), hashRefreshToken(b)) {
+		t.Fatal("hashRefreshToken must be deterministic and distinguish tokens")
+	}
+}
+
+func TestSignAccessTokenUsesConfiguredTTL(t *testing.T) {
+	sec := config.SecurityConfig{JWTSecret: "test-secret", JWTIssuer: "grpc-buf", JWTAudience: "grpc-buf", AccessTokenTTL: "5m"}
+	s := &Store{sec: sec}
+	now := time.Now().UTC().Truncate(time.Second)
+
+	token, expires, err := s.signAccessToken("user-1", []string{"user", "finance"}, now)
+	if err != nil {
+		t.Fatalf("signAccessToken: %v", err)
+	}
+	if !expires.Equal(now.Add(5 * time.Minute)) {
+		t.Fatalf("expires = %v, want %v", expires, now.Add(5*time.Minute))
+	}
+	v, err := security.NewVerifierFromConfig(sec)
+	if err != nil {
+		t.Fatalf("NewVerifierFromConfig: %v", err)
+	}
+	claims, err := v.Verify(token)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if claims.Subject != "user-1" || claims.ID == "" || !slices.Equal(claims.Roles, []string{"user", "finance"}) {
+		t.Fatalf("claims = %+v", claims)
+	}
+
+	if got := (&Store{}).refreshTokenTTL(); got != defaultRefreshTokenTTL {
+		t.Fatalf("default refresh TTL = %v, want %v", got, defaultRefreshTokenTTL)
+	}
+}
+
+func TestIssuedTokenRolesReachCaller(t *testing.T) {
+	sec := config.SecurityConfig{JWTSecret: "test-secret"}
+	s := &Store{sec: sec}
+	now := time.Now()
+	token, _, err := s.signAccessToken("user-1", []string{"user", security.RoleAdmin}, now)
+	if err != nil {
+		t.Fatalf("signAccessToken: %v", err)
+	}
+	v, err := security.NewVerifierFromConfig(sec)
+	if err != nil {
+		t.Fatalf("NewVerifierFromConfig: %v", err)
+	}
+	claims, err := v.Verify(token)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if got := claims.IssueTime(); !got.Equal(now.Truncate(time.Microsecond)) {
+		t.Fatalf("issue time = %v, want %v", got, now.Truncate(time.Microsecond))
+	}
+	u := auth.UserFromClaims(claims)
+	if u.Subject != "user-1" || u.TokenID != claims.ID || !u.HasRole(security.RoleAdmin) {
+		t.Fatalf("caller = %+v", u)
+	}
+}
@@ -9,10 +9,8 @@ import (
 	"log/slog"
 	"net/mail"
 	"strings"
-	"time"
 
 	"connectrpc.com/connect"
-	"github.com/golang-jwt/jwt/v5"
 	userv1 "github.com/grpc-buf/internal/gen/proto/registration"
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v5"
@@ -31,6 +29,8 @@ func HashPassword(password string) (string, error) {
 	return string(hashedBytes), nil
 }
 
+// LoginUser verifies the user's password and returns an access token and the
+// first refresh token of a new token family.
 func (s *Store) LoginUser(ctx context.Context, req *connect.Request[userv1.LoginRequest]) (*connect.Response[userv1.LoginResponse], error) {
 	// Normalize and validate inputs
 	email := strings.ToLower(strings.TrimSpace(req.Msg.GetEmail()))
@@ -60,48 +60,11 @@ func (s *Store) LoginUser(ctx context.Context, req *connect.Request[userv1.Login
 		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
 	}
 
-	// Build JWT
-	now := time.Now().UTC()
-	expirationTime := now.Add(15 * time.Minute)
-	jti, err := randomJTI()
+	resp, err := s.issueSession(ctx, s.db, userID, "")
 	if err != nil {
-		slog.Error("error generating JWT ID", "error", err)
-		return nil, status.Error(codes.Internal, "error generating authentication token")
+		return nil, err
 	}
-	issuer := strings.TrimSpace(s.sec.JWTIssuer)
-	if issuer == "" {
-		issuer = "grpc-buf"
-	}
-	aud := strings.TrimSpace(s.sec.JWTAudience)
-	var auds jwt.ClaimStrings
-	if aud != "" {
-		auds = jwt.ClaimStrings{aud}
-	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
-		Issuer:    issuer,
-		Subject:   userID,
-		Audience:  auds,
-		IssuedAt:  jwt.NewNumericDate(now),
-		NotBefore: jwt.NewNumericDate(now),
-		ExpiresAt: jwt.NewNumericDate(expirationTime),
-		ID:        jti,
-	})
-
-	// Determine signing key from configuration
-	signKey := strings.TrimSpace(s.sec.JWTSecret)
-	if signKey == "" {
-		slog.Error("JWT secret missing")
-		return nil, status.Error(codes.Internal, "authentication not configured")
-	}
-
-	tokenString, err := token.SignedString([]byte(signKey))
-	if err != nil {
-		slog.Error("error signing JWT token", "error", err)
-		return nil, status.Error(codes.Internal, "error generating authentication token")
-	}
-
-	response := connect.NewResponse(&userv1.LoginResponse{AccessToken: tokenString})
-	return response, nil
+	return connect.NewResponse(resp), nil
 }
 
 func (s *Store) RegisterUser(ctx context.Context, req *connect.Request[userv1.RegisterRequest]) (*connect.Response[userv1.RegisterResponse], error) {
@@ -0,0 +1,444 @@
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	webhookv1 "github.com/grpc-buf/internal/gen/proto/webhook"
+	"github.com/grpc-buf/internal/webhook"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// webhookSecretPrefix marks generated endpoint signing secrets.
+const webhookSecretPrefix = "whsec_"
+
+const webhookEndpointColumns = `id, url, event_types, description, created_at`
+
+const webhookDeliveryColumns = `id, endpoint_id, event_id, event_type, status, attempts, last_status_code, last_error,
+        next_attempt_at, created_at, updated_at`
+
+// CreateWebhookEndpoint registers an endpoint with a freshly generated
+// signing secret, which is returned only in this response.
+func (s *Store) CreateWebhookEndpoint(ctx context.Context, req *connect.Request[webhookv1.CreateWebhookEndpointRequest]) (*connect.Response[webhookv1.WebhookEndpoint], error) {
+	ep := req.Msg.GetEndpoint()
+	if ep == nil {
+		return nil, status.Error(codes.InvalidArgument, "endpoint is required")
+	}
+	target, err := webhookURL(ep.GetUrl(), s.hooks.AllowPrivateTargets)
+	if err != nil {
+		return nil, err
+	}
+	types, err := webhookEventTypes(ep.GetEventTypes())
+	if err != nil {
+		return nil, err
+	}
+	secret, err := newWebhookSecret()
+	if err != nil {
+		slog.Error("webhook secret generation failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create webhook endpoint")
+	}
+
+	created, err := scanWebhookEndpoint(s.db.QueryRow(ctx,
+		`INSERT INTO webhook_endpoints (url, event_types, description, secret)
+         VALUES ($1, $2, $3, $4)
+         RETURNING `+webhookEndpointColumns,
+		target, types, strings.TrimSpace(ep.GetDescription()), secret))
+	if err != nil {
+		slog.Error("create webhook endpoint query failed", "error", err, "url", target)
+		return nil, status.Error(codes.Internal, "failed to create webhook endpoint")
+	}
+	created.Secret = secret
+	slog.Info("Webhook endpoint created", "id", created.GetId(), "url", target)
+	return connect.NewResponse(created), nil
+}
+
+// ListWebhookEndpoints returns a page of active endpoints, newest first,
+// without their secrets.
+func (s *Store) ListWebhookEndpoints(ctx context.Context, req *connect.Request[webhookv1.ListWebhookEndpointsRequest]) (*connect.Response[webhookv1.ListWebhookEndpointsResponse], error) {
+	pageSize := req.Msg.GetPageSize()
+	if pageSize <= 0 || pageSize > 1000 {
+		pageSize = 50
+	}
+	offset := 0
+	if req.Msg.GetPageToken() != "" {
+		if n, err := fmt.Sscanf(req.Msg.GetPageToken(), "o:%d", &offset); n != 1 || err != nil {
+			offset = 0
+		}
+	}
+
+	rows, err := s.db.Query(ctx,
+		`SELECT `+webhookEndpointColumns+` FROM webhook_endpoints
+         WHERE deleted_at IS NULL
+         ORDER BY created_at DESC LIMIT $1 OFFSET $2`, pageSize, offset)
+	if err != nil {
+		slog.Error("list webhook endpoints query failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list webhook endpoints")
+	}
+	defer rows.Close()
+
+	resp := &webhookv1.ListWebhookEndpointsResponse{}
+	for rows.Next() {
+		ep, err := scanWebhookEndpoint(rows)
+		if err != nil {
+			slog.Error("list webhook endpoints scan failed", "error", err)
+			return nil, status.Error(codes.Internal, "failed to list webhook endpoints")
+		}
+		resp.Endpoints = append(resp.Endpoints, ep)
+	}
+	if err := rows.Err(); err != nil {
+		slog.Error("list webhook endpoints iteration failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list webhook endpoints")
+	}
+	if len(resp.Endpoints) == int(pageSize) {
+		resp.NextPageToken = fmt.Sprintf("o:%d", offset+int(pageSize))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// DeleteWebhookEndpoint deactivates an endpoint and fails its pending
+// deliveries. The endpoint row is kept so its delivery history stays
+// listable. Returns codes.NotFound for unknown or already deleted endpoints.
+func (s *Store) DeleteWebhookEndpoint(ctx context.Context, req *connect.Request[webhookv1.DeleteWebhookEndpointRequest]) (*connect.Response[emptypb.Empty], error) {
+	id := strings.TrimSpace(req.Msg.GetId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.Error("delete webhook endpoint begin failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to delete webhook endpoint")
+	}
+	defer rollback(ctx, tx)
+
+	res, err := tx.Exec(ctx,
+		`UPDATE webhook_endpoints SET deleted_at=NOW(), updated_at=NOW()
+         WHERE id=$1 AND deleted_at IS NULL`, id)
+	if err != nil {
+		slog.Error("delete webhook endpoint query failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to delete webhook endpoint")
+	}
+	if res.RowsAffected() == 0 {
+		return nil, status.Error(codes.NotFound, "webhook endpoint not found")
+	}
+	if _, err := tx.Exec(ctx,
+		`UPDATE webhook_deliveries
+         SET status=$2, last_error='endpoint deleted', next_attempt_at=NULL, updated_at=NOW()
+         WHERE endpoint_id=$1 AND status=$3`,
+		id, int(webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED),
+		int(webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING)); err != nil {
+		slog.Error("delete webhook endpoint deliveries failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to delete webhook endpoint")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("delete webhook endpoint commit failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to delete webhook endpoint")
+	}
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// ListWebhookDeliveries returns a page of deliveries, newest first,
+// optionally filtered by endpoint and status.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, req *connect.Request[webhookv1.ListWebhookDeliveriesRequest]) (*connect.Response[webhookv1.ListWebhookDeliveriesResponse], error) {
+	var (
+		conds []string
+		args  []any
+	)
+	if id := strings.TrimSpace(req.Msg.GetEndpointId()); id != "" {
+		args = append(args, id)
+		conds = append(conds, fmt.Sprintf("endpoint_id=$%d", len(args)))
+	}
+	if st := req.Msg.GetStatus(); st != webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED {
+		if _, ok := webhookv1.WebhookDeliveryStatus_name[int32(st)]; !ok {
+			return nil, status.Error(codes.InvalidArgument, "unknown delivery status filter")
+		}
+		args = append(args, int(st))
+		conds = append(conds, fmt.Sprintf("status=$%d", len(args)))
+	}
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	pageSize := req.Msg.GetPageSize()
+	if pageSize <= 0 || pageSize > 1000 {
+		pageSize = 50
+	}
+	offset := 0
+	if req.Msg.GetPageToken() != "" {
+		if n, err := fmt.Sscanf(req.Msg.GetPageToken(), "o:%d", &offset); n != 1 || err != nil {
+			offset = 0
+		}
+	}
+
+	args = append(args, pageSize, offset)
+	rows, err := s.db.Query(ctx,
+		`SELECT `+webhookDeliveryColumns+` FROM webhook_deliveries `+where+`
+         ORDER BY created_at DESC LIMIT $`+strconv.Itoa(len(args)-1)+` OFFSET $`+strconv.Itoa(len(args)), args...)
+	if err != nil {
+		slog.Error("list webhook deliveries query failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list webhook deliveries")
+	}
+	defer rows.Close()
+
+	resp := &webhookv1.ListWebhookDeliveriesResponse{}
+	for rows.Next() {
+		d, err := scanWebhookDelivery(rows)
+		if err != nil {
+			slog.Error("list webhook deliveries scan failed", "error", err)
+			return nil, status.Error(codes.Internal, "failed to list webhook deliveries")
+		}
+		resp.Deliveries = append(resp.Deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		slog.Error("list webhook deliveries iteration failed", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list webhook deliveries")
+	}
+	if len(resp.Deliveries) == int(pageSize) {
+		resp.NextPageToken = fmt.Sprintf("o:%d", offset+int(pageSize))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ReplayWebhookDelivery makes a SUCCEEDED or FAILED delivery PENDING again,
+// due immediately and with its attempt count reset. Returns
+// codes.FailedPrecondition for pending deliveries and deleted endpoints.
+func (s *Store) ReplayWebhookDelivery(ctx context.Context, req *connect.Request[webhookv1.ReplayWebhookDeliveryRequest]) (*connect.Response[webhookv1.WebhookDelivery], error) {
+	id := strings.TrimSpace(req.Msg.GetId())
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		slog.Error("replay webhook delivery begin failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to replay webhook delivery")
+	}
+	defer rollback(ctx, tx)
+
+	var (
+		st      int32
+		deleted bool
+	)
+	err = tx.QueryRow(ctx,
+		`SELECT d.status, e.deleted_at IS NOT NULL
+         FROM webhook_deliveries d JOIN webhook_endpoints e ON e.id = d.endpoint_id
+         WHERE d.id=$1 FOR UPDATE OF d`, id).Scan(&st, &deleted)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "webhook delivery not found")
+		}
+		slog.Error("replay webhook delivery load failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to replay webhook delivery")
+	}
+	pending := webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	if webhookv1.WebhookDeliveryStatus(st) == pending {
+		return nil, status.Error(codes.FailedPrecondition, "webhook delivery is already pending")
+	}
+	if deleted {
+		return nil, status.Error(codes.FailedPrecondition, "webhook endpoint was deleted")
+	}
+
+	d, err := scanWebhookDelivery(tx.QueryRow(ctx,
+		`UPDATE webhook_deliveries
+         SET status=$2, attempts=0, next_attempt_at=NOW(), updated_at=NOW()
+         WHERE id=$1
+         RETURNING `+webhookDeliveryColumns, id, int(pending)))
+	if err != nil {
+		slog.Error("replay webhook delivery update failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to replay webhook delivery")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("replay webhook delivery commit failed", "error", err, "id", id)
+		return nil, status.Error(codes.Internal, "failed to replay webhook delivery")
+	}
+	slog.Info("Webhook delivery replayed", "id", id)
+	return connect.NewResponse(d), nil
+}
+
+// ClaimWebhookDeliveries implements webhook.Queue. Rows are locked with SKIP
+// LOCKED and leased by moving next_attempt_at forward, so dispatchers on
+// several replicas never attempt the same delivery concurrently.
+func (s *Store) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]webhook.Delivery, error) {
+	rows, err := s.db.Query(ctx,
+		`WITH due AS (
+             SELECT id FROM webhook_deliveries
+             WHERE status=$1 AND next_attempt_at <= NOW()
+             ORDER BY next_attempt_at
+             LIMIT $2
+             FOR UPDATE SKIP LOCKED
+         )
+         UPDATE webhook_deliveries d
+         SET next_attempt_at = NOW() + $3 * INTERVAL '1 millisecond', updated_at=NOW()
+         FROM due, webhook_endpoints e
+         WHERE d.id = due.id AND e.id = d.endpoint_id
+         RETURNING d.id, d.endpoint_id, d.event_id, d.event_type, d.payload, d.attempts, d.created_at, e.url, e.secret`,
+		int(webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING), limit, lease.Milliseconds())
+	if err != nil {
+		return nil, fmt.Errorf("claim webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var out []webhook.Delivery
+	for rows.Next() {
+		var (
+			d         webhook.Delivery
+			eventType int32
+		)
+		if err := rows.Scan(&d.ID, &d.EndpointID, &d.EventID, &eventType, &d.Payload, &d.Attempts,
+			&d.CreatedAt, &d.URL, &d.Secret); err != nil {
+			return nil, fmt.Errorf("scan webhook delivery: %w", err)
+		}
+		d.EventType = webhookv1.WebhookEventType(eventType)
+		out = append(out, d)
+	}
+	return out, rows.Err()
+}
+
+// RecordWebhookAttempt implements webhook.Queue.
+func (s *Store) RecordWebhookAttempt(ctx context.Context, id string, o webhook.Outcome) error {
+	st := webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	var next *time.Time
+	switch {
+	case o.Delivered:
+		st = webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case o.NextAttempt.IsZero():
+		st = webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	default:
+		next = &o.NextAttempt
+	}
+	_, err := s.db.Exec(ctx,
+		`UPDATE webhook_deliveries
+         SET status=$2, attempts=attempts+1, last_status_code=$3, last_error=$4, next_attempt_at=$5, updated_at=NOW()
+         WHERE id=$1 AND status=$6`,
+		id, int(st), o.StatusCode, o.Error, next,
+		int(webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING))
+	if err != nil {
+		return fmt.Errorf("record webhook attempt: %w", err)
+	}
+	return nil
+}
+
+// enqueueWebhook queues data as a t event for every active endpoint
+// subscribed to t. It runs inside the caller's transaction so an event is
+// only delivered if the change that raised it commits. All deliveries of one
+// event share an event id.
+func enqueueWebhook(ctx context.Context, tx pgx.Tx, t webhookv1.WebhookEventType, data proto.Message) error {
+	payload, err := protojson.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("encode webhook payload: %w", err)
+	}
+	_, err = tx.Exec(ctx,
+		`WITH event AS (SELECT gen_random_uuid() AS id)
+         INSERT INTO webhook_deliveries (endpoint_id, event_id, event_type, payload, status, next_attempt_at)
+         SELECT e.id, event.id, $1, $2, $3, NOW()
+         FROM webhook_endpoints e, event
+         WHERE e.deleted_at IS NULL AND $1 = ANY(e.event_types)`,
+		int(t), payload, int(webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING))
+	if err != nil {
+		return fmt.Errorf("enqueue %s webhook: %w", webhook.EventName(t), err)
+	}
+	return nil
+}
+
+// webhookURL validates an endpoint URL with webhook.CheckURL.
+func webhookURL(raw string, allowPrivate bool) (string, error) {
+	target, err := webhook.CheckURL(raw, allowPrivate)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	return target, nil
+}
+
+// webhookEventTypes validates and de-duplicates the subscribed event types.
+func webhookEventTypes(in []webhookv1.WebhookEventType) ([]int32, error) {
+	if len(in) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one event type is required")
+	}
+	var out []int32
+	for _, t := range in {
+		if _, ok := webhookv1.WebhookEventType_name[int32(t)]; !ok || t == webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown event type %d", t)
+		}
+		if !slices.Contains(out, int32(t)) {
+			out = append(out, int32(t))
+		}
+	}
+	return out, nil
+}
+
+// newWebhookSecret returns a random signing secret.
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return webhookSecretPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// scanWebhookEndpoint reads a row selected with webhookEndpointColumns.
+func scanWebhookEndpoint(row pgx.Row) (*webhookv1.WebhookEndpoint, error) {
+	var (
+		id, target, description string
+		types                   []int32
+		createdAt               time.Time
+	)
+	if err := row.Scan(&id, &target, &types, &description, &createdAt); err != nil {
+		return nil, err
+	}
+	ep := &webhookv1.WebhookEndpoint{
+		Id:          id,
+		Url:         target,
+		Description: description,
+		CreateTime:  timestamppb.New(createdAt),
+	}
+	for _, t := range types {
+		ep.EventTypes = append(ep.EventTypes, webhookv1.WebhookEventType(t))
+	}
+	return ep, nil
+}
+
+// scanWebhookDelivery reads a row selected with webhookDeliveryColumns.
+func scanWebhookDelivery(row pgx.Row) (*webhookv1.WebhookDelivery, error) {
+	var (
+		id, endpointID, eventID, lastError string
+		eventType, st, attempts, lastCode  int32
+		nextAttempt                        *time.Time
+		createdAt, updatedAt               time.Time
+	)
+	if err := row.Scan(&id, &endpointID, &eventID, &eventType, &st, &attempts, &lastCode, &lastError,
+		&nextAttempt, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	d := &webhookv1.WebhookDelivery{
+		Id:             id,
+		EndpointId:     endpointID,
+		EventId:        eventID,
+		EventType:      webhookv1.WebhookEventType(eventType),
+		Status:         webhookv1.WebhookDeliveryStatus(st),
+		Attempts:       attempts,
+		LastStatusCode: lastCode,
+		LastError:      lastError,
+		CreateTime:     timestamppb.New(createdAt),
+		UpdateTime:     timestamppb.New(updatedAt),
+	}
+	if nextAttempt != nil && d.Status == webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING {
+		d.NextAttemptTime = timestamppb.New(*nextAttempt)
+	}
+	return d, nil
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"slices"
+	"testing"
+
+	webhookv1 "github.com/grpc-buf/internal/gen/proto/webhook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWebhookURL(t *testing.T) {
+	for _, tt := range []struct {
+		raw          string
+		allowPrivate bool
+		ok           bool
+	}{
+		{raw: "https://hooks.example.com/grpc-buf", ok: true},
+		{raw: "https://203.0.113.7/hook", ok: true},
+		{raw: " http://localhost:9000/hook ", allowPrivate: true, ok: true},
+		{raw: "http://127.0.0.1:1/hook", allowPrivate: true, ok: true},
+		{raw: "http://hooks.example.com/grpc-buf"},
+		{raw: "https://localhost/hook"},
+		{raw: "https://127.0.0.1/hook"},
+		{raw: "https://10.0.0.5/hook"},
+		{raw: "https://169.254.169.254/latest/meta-data"},
+		{raw: "https://[::1]/hook"},
+		{raw: "https://[::ffff:192.168.1.1]/hook"},
+		{raw: "https://100.64.0.1/hook"},
+		{raw: "", allowPrivate: true},
+		{raw: "/relative", allowPrivate: true},
+		{raw: "ftp://example.com", allowPrivate: true},
+		{raw: "https://", allowPrivate: true},
+		{raw: "://bad", allowPrivate: true},
+	} {
+		_, err := webhookURL(tt.raw, tt.allowPrivate)
+		if tt.ok && err != nil {
+			t.Errorf("webhookURL(%q, %v) = %v, want ok", tt.raw, tt.allowPrivate, err)
+		}
+		if !tt.ok && status.Code(err) != codes.InvalidArgument {
+			t.Errorf("webhookURL(%q, %v) = %v, want InvalidArgument", tt.raw, tt.allowPrivate, err)
+		}
+	}
+}
+
+func TestWebhookEventTypes(t *testing.T) {
+	paid := webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_INVOICE_PAID
+	succeeded := webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_PAYMENT_SUCCEEDED
+
+	got, err := webhookEventTypes([]webhookv1.WebhookEventType{paid, succeeded, paid})
+	if err != nil || !slices.Equal(got, []int32{int32(paid), int32(succeeded)}) {
+		t.Fatalf("webhookEventTypes = %v, %v; want de-duplicated types", got, err)
+	}
+	for _, in := range [][]webhookv1.WebhookEventType{
+		nil,
+		{webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED},
+		{paid, webhookv1.WebhookEventType(99)},
+	} {
+		if _, err := webhookEventTypes(in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("webhookEventTypes(%v) = %v, want InvalidArgument", in, err)
+		}
+	}
+}
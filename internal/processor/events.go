@@ -0,0 +1,71 @@
+package processor
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// Event types delivered by the provider's webhook. Other types are stored
+// with EventUnhandled so they can be handled later.
+const (
+	// EventPaymentSettled reports that an authorized payment was captured
+	// asynchronously (AUTHORIZED -> CAPTURED).
+	EventPaymentSettled = "payment.settled"
+	// EventPaymentChargeback reports funds clawed back from a captured
+	// payment; it records a lost dispute for the amount and reopens the
+	// invoices the payment paid, without calling the processor.
+	EventPaymentChargeback = "payment.chargeback"
+	// EventInvoicePaid reports an invoice settled with the provider out of
+	// band.
+	EventInvoicePaid = "invoice.paid"
+)
+
+// EventStatus records what happened to a received provider event.
+type EventStatus string
+
+const (
+	// EventApplied means the event changed the matching payment or invoice.
+	EventApplied EventStatus = "applied"
+	// EventRejected means the event was valid but could not be applied, e.g.
+	// it referenced an unknown payment or an illegal transition.
+	EventRejected EventStatus = "rejected"
+	// EventUnhandled means the event type is not known; it was stored only.
+	EventUnhandled EventStatus = "unhandled"
+	// EventDuplicate means an event with the same id was already received.
+	EventDuplicate EventStatus = "duplicate"
+)
+
+// Event is a provider webhook event.
+type Event struct {
+	ID   string          `json:"id"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+	// Raw is the request body the event was parsed from.
+	Raw []byte `json:"-"`
+}
+
+// EventData is the data of the built-in event types. Payments are matched by
+// the processor reference returned from Authorize; invoices by id.
+type EventData struct {
+	ProcessorRef string `json:"processor_ref"`
+	InvoiceID    string `json:"invoice_id"`
+	// Amount is in minor units of Currency; zero means the full amount.
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// ParseEvent decodes a webhook body. The event id and type are required;
+// data is decoded later, only for known types.
+func ParseEvent(body []byte) (Event, error) {
+	var ev Event
+	if err := json.Unmarshal(body, &ev); err != nil {
+		return Event{}, err
+	}
+	ev.ID, ev.Type = strings.TrimSpace(ev.ID), strings.TrimSpace(ev.Type)
+	if ev.ID == "" || ev.Type == "" {
+		return Event{}, errors.New("event id and type are required")
+	}
+	ev.Raw = body
+	return ev, nil
+}
@@ -0,0 +1,104 @@
+package processor
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Magic card tokens recognised by the fake processor. Any other token is
+// authorized.
+const (
+	// TokenDecline is declined with codes.FailedPrecondition and reason
+	// CARD_DECLINED.
+	TokenDecline = "tok_decline"
+	// TokenInsufficientFunds is declined with codes.FailedPrecondition and
+	// reason INSUFFICIENT_FUNDS.
+	TokenInsufficientFunds = "tok_insufficient_funds"
+	// TokenUnavailable fails with codes.Unavailable, as if the gateway were
+	// down.
+	TokenUnavailable = "tok_processor_unavailable"
+)
+
+// Fake is a deterministic in-process PaymentProcessor for development and
+// tests. Outcomes depend only on the card token; every call waits for the
+// configured latency first.
+type Fake struct {
+	latency time.Duration
+}
+
+// NewFake returns a Fake that delays each call by latency.
+func NewFake(latency time.Duration) *Fake {
+	return &Fake{latency: latency}
+}
+
+// Authorize declines the magic tokens and approves everything else.
+func (f *Fake) Authorize(ctx context.Context, c Charge) (string, error) {
+	if err := f.wait(ctx); err != nil {
+		return "", err
+	}
+	switch c.CardToken {
+	case TokenDecline:
+		return "", declined("card declined", ReasonCardDeclined)
+	case TokenInsufficientFunds:
+		return "", declined("insufficient funds", ReasonInsufficientFunds)
+	case TokenUnavailable:
+		return "", status.Error(codes.Unavailable, "payment processor unavailable")
+	}
+	return newRef()
+}
+
+// Capture always succeeds.
+func (f *Fake) Capture(ctx context.Context, _ string, _ int64) error {
+	return f.wait(ctx)
+}
+
+// Void always succeeds.
+func (f *Fake) Void(ctx context.Context, _ string) error {
+	return f.wait(ctx)
+}
+
+// Refund always succeeds.
+func (f *Fake) Refund(ctx context.Context, _ string, _ int64) error {
+	return f.wait(ctx)
+}
+
+// wait sleeps for the configured latency, returning early with
+// codes.DeadlineExceeded or codes.Canceled when ctx ends.
+func (f *Fake) wait(ctx context.Context) error {
+	if f.latency <= 0 {
+		return nil
+	}
+	t := time.NewTimer(f.latency)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func declined(msg, reason string) error {
+	st, err := status.New(codes.FailedPrecondition, msg).WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: ErrorDomain,
+	})
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, msg)
+	}
+	return st.Err()
+}
+
+func newRef() (string, error) {
+	var b [12]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", status.Error(codes.Internal, "failed to generate processor reference")
+	}
+	return "fake_" + hex.EncodeToString(b[:]), nil
+}
@@ -0,0 +1,82 @@
+package processor
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-buf/internal/config"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFakeAuthorize(t *testing.T) {
+	tests := []struct {
+		name       string
+		token      string
+		wantCode   codes.Code
+		wantReason string
+	}{
+		{name: "approved", token: "tok_visa", wantCode: codes.OK},
+		{name: "declined", token: TokenDecline, wantCode: codes.FailedPrecondition, wantReason: ReasonCardDeclined},
+		{name: "insufficient funds", token: TokenInsufficientFunds, wantCode: codes.FailedPrecondition, wantReason: ReasonInsufficientFunds},
+		{name: "unavailable", token: TokenUnavailable, wantCode: codes.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, err := NewFake(0).Authorize(context.Background(), Charge{CardToken: tt.token, AmountCents: 1000, Currency: "USD"})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("code = %v, want %v (err %v)", got, tt.wantCode, err)
+			}
+			if tt.wantCode == codes.OK {
+				if !strings.HasPrefix(ref, "fake_") {
+					t.Fatalf("ref = %q, want fake_ prefix", ref)
+				}
+				return
+			}
+			if tt.wantReason == "" {
+				return
+			}
+			var reason string
+			for _, d := range status.Convert(err).Details() {
+				if info, ok := d.(*errdetails.ErrorInfo); ok {
+					reason = info.GetReason()
+				}
+			}
+			if reason != tt.wantReason {
+				t.Fatalf("reason = %q, want %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestFakeLatencyHonorsContext(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := NewFake(time.Minute).Capture(ctx, "fake_ref", 100)
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("code = %v, want DeadlineExceeded", status.Code(err))
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Fatalf("wait ignored context cancellation")
+	}
+}
+
+func TestNew(t *testing.T) {
+	p, err := New(config.ProcessorConfig{Provider: "fake", FakeLatency: "1ms"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if f, ok := p.(*Fake); !ok || f.latency != time.Millisecond {
+		t.Fatalf("New returned %#v", p)
+	}
+	if _, err := New(config.ProcessorConfig{Provider: "acme"}); err == nil {
+		t.Fatalf("expected error for unknown provider")
+	}
+	if _, err := New(config.ProcessorConfig{FakeLatency: "soon"}); err == nil {
+		t.Fatalf("expected error for bad latency")
+	}
+}
@@ -0,0 +1,64 @@
+// Package processor abstracts the card payment gateway the datastore charges
+// tokenized cards through.
+package processor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/grpc-buf/internal/config"
+)
+
+// ErrorDomain is the google.rpc.ErrorInfo domain used for processor failures.
+const ErrorDomain = "processor.grpc-buf"
+
+// ErrorInfo reasons attached to declined charges.
+const (
+	ReasonCardDeclined      = "CARD_DECLINED"
+	ReasonInsufficientFunds = "INSUFFICIENT_FUNDS"
+)
+
+// Charge describes an authorization request. AmountCents is in the minor
+// unit of Currency.
+type Charge struct {
+	CardToken   string
+	AmountCents int64
+	Currency    string
+}
+
+// PaymentProcessor charges card tokens and moves the resulting authorization
+// through its lifecycle. Implementations return google.golang.org/grpc/status
+// errors: codes.FailedPrecondition with an ErrorInfo reason for declines and
+// codes.Unavailable when the gateway cannot be reached.
+type PaymentProcessor interface {
+	// Authorize places a hold for the charge and returns the processor's
+	// reference for it.
+	Authorize(ctx context.Context, c Charge) (string, error)
+	// Capture settles amountCents of an authorization.
+	Capture(ctx context.Context, ref string, amountCents int64) error
+	// Void releases an authorization that has not been captured.
+	Void(ctx context.Context, ref string) error
+	// Refund returns amountCents of a captured authorization.
+	Refund(ctx context.Context, ref string, amountCents int64) error
+}
+
+// New returns the processor selected by cfg.Provider. An empty provider
+// selects the fake.
+func New(cfg config.ProcessorConfig) (PaymentProcessor, error) {
+	switch p := strings.ToLower(strings.TrimSpace(cfg.Provider)); p {
+	case "", "fake":
+		var latency time.Duration
+		if v := strings.TrimSpace(cfg.FakeLatency); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil || d < 0 {
+				return nil, fmt.Errorf("invalid fake processor latency %q", v)
+			}
+			latency = d
+		}
+		return NewFake(latency), nil
+	default:
+		return nil, fmt.Errorf("unknown payment processor %q", p)
+	}
+}
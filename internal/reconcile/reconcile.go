@@ -0,0 +1,270 @@
+// Package reconcile matches payments against the processor's settlement file
+// and renders the result as a CSV or JSON report.
+//
+// A settlement row matches the payment with the same processor reference.
+// Rows left over are matched one-to-one with payments of the same amount,
+// currency and date, unless both carry a reference. A reference match that
+// disagrees on amount or currency is an amount mismatch; whatever remains is
+// missing on our side (rows) or on the provider's (payments).
+package reconcile
+
+import (
+	"cmp"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-buf/internal/config"
+	"github.com/grpc-buf/internal/fx"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"github.com/grpc-buf/internal/money"
+)
+
+// Entry is one settlement row.
+type Entry struct {
+	// Line is the row's line in the file, counting the header as line 1.
+	Line      int
+	Reference string
+	// Amount is in the minor unit of Currency.
+	Amount   int64
+	Currency string
+	// Date is the settlement day, at midnight UTC.
+	Date time.Time
+}
+
+// Payment is one of our settled payments.
+type Payment struct {
+	ID string
+	// Reference is the payment's processor_ref; may be empty.
+	Reference string
+	// Amount is in the minor unit of Currency.
+	Amount   int64
+	Currency string
+	// Date is the day the payment was created, at midnight UTC.
+	Date time.Time
+}
+
+// Item pairs a settlement row with the payment it matched. Entry is nil for
+// payments missing on the provider's side and Payment is nil for rows missing
+// on ours.
+type Item struct {
+	Status  paymentv1.ReconciliationStatus
+	Entry   *Entry
+	Payment *Payment
+}
+
+// Report is the result of Match.
+type Report struct {
+	Items                                             []Item
+	Matched, MissingOurs, MissingProvider, Mismatched int
+}
+
+// ParseCSV reads settlement rows using the column mapping in cfg. Column
+// names are matched case-insensitively; amounts are decimals in the row's
+// currency unless cfg.AmountMinorUnits is set.
+func ParseCSV(r io.Reader, cfg config.ReconciliationConfig) ([]Entry, error) {
+	cols := cfg.Columns
+	names := map[string]string{
+		"amount":   cmp.Or(strings.TrimSpace(cols.Amount), "amount"),
+		"currency": cmp.Or(strings.TrimSpace(cols.Currency), "currency"),
+		"date":     cmp.Or(strings.TrimSpace(cols.Date), "date"),
+	}
+	layout := cmp.Or(strings.TrimSpace(cfg.DateLayout), time.DateOnly)
+
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+	index := map[string]int{}
+	for i, h := range header {
+		index[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))] = i
+	}
+	col := map[string]int{}
+	for field, name := range names {
+		i, ok := index[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("csv header is missing the %s column %q", field, name)
+		}
+		col[field] = i
+	}
+	// The reference column is optional unless configured explicitly.
+	refCol := -1
+	refName := cmp.Or(strings.TrimSpace(cols.Reference), "reference")
+	if i, ok := index[strings.ToLower(refName)]; ok {
+		refCol = i
+	} else if strings.TrimSpace(cols.Reference) != "" {
+		return nil, fmt.Errorf("csv header is missing the reference column %q", refName)
+	}
+
+	var entries []Entry
+	for line := 2; ; line++ {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read csv line %d: %w", line, err)
+		}
+		field := func(i int) string {
+			if i < 0 || i >= len(rec) {
+				return ""
+			}
+			return strings.TrimSpace(rec[i])
+		}
+		e := Entry{Line: line, Reference: field(refCol)}
+		currency, ok := money.NormalizeCurrency(field(col["currency"]))
+		if !ok {
+			return nil, fmt.Errorf("csv line %d: unsupported currency %q", line, field(col["currency"]))
+		}
+		e.Currency = currency
+		if e.Amount, err = parseAmount(field(col["amount"]), currency, cfg.AmountMinorUnits); err != nil {
+			return nil, fmt.Errorf("csv line %d: %w", line, err)
+		}
+		d, err := time.Parse(layout, field(col["date"]))
+		if err != nil {
+			return nil, fmt.Errorf("csv line %d: invalid date %q", line, field(col["date"]))
+		}
+		e.Date = fx.Day(d)
+		entries = append(entries, e)
+	}
+}
+
+func parseAmount(s, currency string, minorUnits bool) (int64, error) {
+	if minorUnits {
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid amount %q", s)
+		}
+		return v, nil
+	}
+	v, err := money.ParseMinor(s, currency)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	return v, nil
+}
+
+// Span returns the earliest and latest settlement dates of entries; both are
+// zero when entries is empty.
+func Span(entries []Entry) (first, last time.Time) {
+	for i, e := range entries {
+		if i == 0 || e.Date.Before(first) {
+			first = e.Date
+		}
+		if i == 0 || e.Date.After(last) {
+			last = e.Date
+		}
+	}
+	return first, last
+}
+
+// References returns the distinct non-empty references of entries.
+func References(entries []Entry) []string {
+	var refs []string
+	seen := map[string]bool{}
+	for _, e := range entries {
+		if e.Reference != "" && !seen[e.Reference] {
+			seen[e.Reference] = true
+			refs = append(refs, e.Reference)
+		}
+	}
+	return refs
+}
+
+type matchKey struct {
+	amount   int64
+	currency string
+	date     time.Time
+}
+
+// Match pairs entries with payments. Items come in file order, followed by
+// the payments missing on the provider's side by date and id.
+func Match(entries []Entry, payments []Payment) Report {
+	claimed := make([]bool, len(payments))
+	byRef := map[string]int{}
+	for i, p := range payments {
+		if p.Reference != "" {
+			byRef[p.Reference] = i
+		}
+	}
+	items := make([]Item, len(entries))
+	for i := range entries {
+		e := &entries[i]
+		items[i] = Item{Entry: e, Status: paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_MISSING_OURS}
+		j, ok := byRef[e.Reference]
+		if e.Reference == "" || !ok || claimed[j] {
+			continue
+		}
+		claimed[j] = true
+		items[i].Payment = &payments[j]
+		items[i].Status = paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_MATCHED
+		if payments[j].Amount != e.Amount || payments[j].Currency != e.Currency {
+			items[i].Status = paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_AMOUNT_MISMATCH
+		}
+	}
+
+	byKey := map[matchKey][]int{}
+	for i, p := range payments {
+		if !claimed[i] {
+			k := matchKey{p.Amount, p.Currency, p.Date}
+			byKey[k] = append(byKey[k], i)
+		}
+	}
+	for i := range items {
+		if items[i].Payment != nil {
+			continue
+		}
+		e := items[i].Entry
+		k := matchKey{e.Amount, e.Currency, e.Date}
+		candidates := byKey[k]
+		for n, j := range candidates {
+			// Two different references are two different charges.
+			if e.Reference != "" && payments[j].Reference != "" {
+				continue
+			}
+			claimed[j] = true
+			items[i].Payment = &payments[j]
+			items[i].Status = paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_MATCHED
+			byKey[k] = slices.Delete(candidates, n, n+1)
+			break
+		}
+	}
+
+	var missing []Item
+	for i := range payments {
+		if !claimed[i] {
+			missing = append(missing, Item{Payment: &payments[i], Status: paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_MISSING_PROVIDER})
+		}
+	}
+	slices.SortStableFunc(missing, func(a, b Item) int {
+		return cmp.Or(a.Payment.Date.Compare(b.Payment.Date), strings.Compare(a.Payment.ID, b.Payment.ID))
+	})
+
+	r := Report{Items: append(items, missing...)}
+	for _, it := range r.Items {
+		switch it.Status {
+		case paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_MATCHED:
+			r.Matched++
+		case paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_MISSING_OURS:
+			r.MissingOurs++
+		case paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_MISSING_PROVIDER:
+			r.MissingProvider++
+		case paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_AMOUNT_MISMATCH:
+			r.Mismatched++
+		}
+	}
+	return r
+}
+
+// StatusName returns the report name of s, e.g. "missing_ours".
+func StatusName(s paymentv1.ReconciliationStatus) string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "RECONCILIATION_STATUS_"))
+}
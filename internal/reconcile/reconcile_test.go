@@ -0,0 +1,164 @@
+package reconcile
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-buf/internal/config"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+)
+
+func day(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+func TestParseCSV(t *testing.T) {
+	cfg := config.ReconciliationConfig{
+		Columns:    config.SettlementColumns{Reference: "Charge ID", Amount: "Gross", Currency: "Ccy", Date: "Settled"},
+		DateLayout: "02/01/2006",
+	}
+	in := "Settled,Charge ID,Gross,Ccy,Fee\n" +
+		"01/10/2026,ch_1,12.50,usd,0.30\n" +
+		"02/10/2026,,1500,JPY,0\n"
+	got, err := ParseCSV(strings.NewReader(in), cfg)
+	if err != nil {
+		t.Fatalf("ParseCSV: %v", err)
+	}
+	want := []Entry{
+		{Line: 2, Reference: "ch_1", Amount: 1250, Currency: "USD", Date: day("2026-10-01")},
+		{Line: 3, Amount: 1500, Currency: "JPY", Date: day("2026-10-02")},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("entry %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	cfg.AmountMinorUnits = true
+	got, err = ParseCSV(strings.NewReader("Settled,Charge ID,Gross,Ccy\n01/10/2026,ch_1,1250,USD\n"), cfg)
+	if err != nil || got[0].Amount != 1250 {
+		t.Fatalf("minor units: %+v, %v", got, err)
+	}
+
+	// The default mapping does not require a reference column.
+	if _, err := ParseCSV(strings.NewReader("date,amount,currency\n2026-10-01,1.00,USD\n"), config.ReconciliationConfig{}); err != nil {
+		t.Fatalf("default mapping: %v", err)
+	}
+
+	for name, in := range map[string]string{
+		"missing column": "Settled,Charge ID,Gross\n",
+		"bad amount":     "Settled,Charge ID,Gross,Ccy\n01/10/2026,ch_1,12.5.0,USD\n",
+		"bad currency":   "Settled,Charge ID,Gross,Ccy\n01/10/2026,ch_1,12.50,XXX\n",
+		"bad date":       "Settled,Charge ID,Gross,Ccy\n2026-10-01,ch_1,12.50,USD\n",
+	} {
+		cfg.AmountMinorUnits = false
+		if _, err := ParseCSV(strings.NewReader(in), cfg); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	d1, d2 := day("2026-10-01"), day("2026-10-02")
+	entries := []Entry{
+		{Line: 2, Reference: "ch_1", Amount: 1000, Currency: "USD", Date: d1},
+		{Line: 3, Reference: "ch_2", Amount: 999, Currency: "USD", Date: d1},
+		{Line: 4, Amount: 500, Currency: "EUR", Date: d2},
+		{Line: 5, Reference: "ch_x", Amount: 700, Currency: "USD", Date: d2},
+		{Line: 6, Reference: "ch_9", Amount: 800, Currency: "USD", Date: d2},
+	}
+	payments := []Payment{
+		{ID: "p1", Reference: "ch_1", Amount: 1000, Currency: "USD", Date: d1},
+		{ID: "p2", Reference: "ch_2", Amount: 1000, Currency: "USD", Date: d1},
+		{ID: "p3", Amount: 500, Currency: "EUR", Date: d2},
+		// Same amount and day as line 5 but another charge.
+		{ID: "p4", Reference: "ch_4", Amount: 700, Currency: "USD", Date: d2},
+		// No reference recorded, so line 6 matches it by amount and day.
+		{ID: "p5", Amount: 800, Currency: "USD", Date: d2},
+		{ID: "p6", Amount: 100, Currency: "USD", Date: d1},
+	}
+	r := Match(entries, payments)
+
+	type pair struct {
+		status  paymentv1.ReconciliationStatus
+		line    int
+		payment string
+	}
+	var got []pair
+	for _, it := range r.Items {
+		p := pair{status: it.Status}
+		if it.Entry != nil {
+			p.line = it.Entry.Line
+		}
+		if it.Payment != nil {
+			p.payment = it.Payment.ID
+		}
+		got = append(got, p)
+	}
+	want := []pair{
+		{paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_MATCHED, 2, "p1"},
+		{paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_AMOUNT_MISMATCH, 3, "p2"},
+		{paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_MATCHED, 4, "p3"},
+		{paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_MISSING_OURS, 5, ""},
+		{paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_MATCHED, 6, "p5"},
+		{paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_MISSING_PROVIDER, 0, "p6"},
+		{paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_MISSING_PROVIDER, 0, "p4"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("items = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("item %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if r.Matched != 3 || r.Mismatched != 1 || r.MissingOurs != 1 || r.MissingProvider != 2 {
+		t.Fatalf("counts = %d/%d/%d/%d", r.Matched, r.Mismatched, r.MissingOurs, r.MissingProvider)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	d := day("2026-10-01")
+	r := Match(
+		[]Entry{{Line: 2, Reference: "ch_1", Amount: 1250, Currency: "USD", Date: d}},
+		[]Payment{{ID: "p1", Reference: "ch_1", Amount: 1250, Currency: "USD", Date: d}, {ID: "p2", Amount: 5, Currency: "USD", Date: d}},
+	)
+
+	var buf bytes.Buffer
+	if err := Write("csv", &buf, r); err != nil {
+		t.Fatalf("WriteCSV: %v", err)
+	}
+	want := "status,line,reference,payment_id,provider_amount,provider_currency,provider_date,our_amount,our_currency,our_date\n" +
+		"matched,2,ch_1,p1,12.50,USD,2026-10-01,12.50,USD,2026-10-01\n" +
+		"missing_provider,,,p2,,,,0.05,USD,2026-10-01\n"
+	if buf.String() != want {
+		t.Fatalf("csv =\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	if err := Write("JSON", &buf, r); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+	var out struct {
+		Matched         int              `json:"matched"`
+		MissingProvider int              `json:"missing_provider"`
+		Items           []map[string]any `json:"items"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("decode json report: %v", err)
+	}
+	if out.Matched != 1 || out.MissingProvider != 1 || len(out.Items) != 2 || out.Items[1]["our_amount"] != "0.05" {
+		t.Fatalf("json report = %s", buf.String())
+	}
+
+	if err := Write("xml", &buf, r); err == nil {
+		t.Fatalf("expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,130 @@
+package reconcile
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-buf/internal/money"
+)
+
+// Report formats accepted by Write.
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+// ContentType returns the MIME type of a report in format.
+func ContentType(format string) string {
+	if strings.EqualFold(format, FormatJSON) {
+		return "application/json"
+	}
+	return "text/csv"
+}
+
+// Write renders r in format "csv" or "json".
+func Write(format string, w io.Writer, r Report) error {
+	switch strings.ToLower(format) {
+	case FormatCSV:
+		return WriteCSV(w, r)
+	case FormatJSON:
+		return WriteJSON(w, r)
+	default:
+		return fmt.Errorf("unknown report format %q", format)
+	}
+}
+
+var csvHeader = []string{
+	"status", "line", "reference", "payment_id",
+	"provider_amount", "provider_currency", "provider_date",
+	"our_amount", "our_currency", "our_date",
+}
+
+// WriteCSV writes one row per item under a header row. Amounts are decimals
+// and the side an item is missing from is left blank.
+func WriteCSV(w io.Writer, r Report) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, it := range r.Items {
+		row := it.row()
+		rec := []string{
+			row.Status, "", row.Reference, row.PaymentID,
+			row.ProviderAmount, row.ProviderCurrency, row.ProviderDate,
+			row.OurAmount, row.OurCurrency, row.OurDate,
+		}
+		if row.Line > 0 {
+			rec[1] = strconv.Itoa(row.Line)
+		}
+		if err := cw.Write(rec); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the summary counts and the items as one JSON object.
+func WriteJSON(w io.Writer, r Report) error {
+	out := struct {
+		Matched         int       `json:"matched"`
+		MissingOurs     int       `json:"missing_ours"`
+		MissingProvider int       `json:"missing_provider"`
+		AmountMismatch  int       `json:"amount_mismatch"`
+		Items           []itemRow `json:"items"`
+	}{r.Matched, r.MissingOurs, r.MissingProvider, r.Mismatched, make([]itemRow, 0, len(r.Items))}
+	for _, it := range r.Items {
+		out.Items = append(out.Items, it.row())
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// itemRow is the flattened form of an Item shared by both report formats.
+type itemRow struct {
+	Status           string `json:"status"`
+	Line             int    `json:"line,omitempty"`
+	Reference        string `json:"reference,omitempty"`
+	PaymentID        string `json:"payment_id,omitempty"`
+	ProviderAmount   string `json:"provider_amount,omitempty"`
+	ProviderCurrency string `json:"provider_currency,omitempty"`
+	ProviderDate     string `json:"provider_date,omitempty"`
+	OurAmount        string `json:"our_amount,omitempty"`
+	OurCurrency      string `json:"our_currency,omitempty"`
+	OurDate          string `json:"our_date,omitempty"`
+}
+
+func (it Item) row() itemRow {
+	row := itemRow{Status: StatusName(it.Status)}
+	if e := it.Entry; e != nil {
+		row.Line = e.Line
+		row.Reference = e.Reference
+		row.ProviderAmount = formatAmount(e.Amount, e.Currency)
+		row.ProviderCurrency = e.Currency
+		row.ProviderDate = e.Date.Format(time.DateOnly)
+	}
+	if p := it.Payment; p != nil {
+		row.PaymentID = p.ID
+		if row.Reference == "" {
+			row.Reference = p.Reference
+		}
+		row.OurAmount = formatAmount(p.Amount, p.Currency)
+		row.OurCurrency = p.Currency
+		row.OurDate = p.Date.Format(time.DateOnly)
+	}
+	return row
+}
+
+func formatAmount(minor int64, currency string) string {
+	s, err := money.FormatMinor(minor, currency)
+	if err != nil {
+		return strconv.FormatInt(minor, 10)
+	}
+	return s
+}
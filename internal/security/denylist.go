@@ -0,0 +1,158 @@
+package security
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Denylist records revoked access tokens until they would have expired
+// anyway. JWTAuthInterceptor consults it after a token verifies.
+type Denylist interface {
+	// Deny revokes the token with the given jti until expiresAt.
+	Deny(ctx context.Context, jti string, expiresAt time.Time) error
+	// DenySubject revokes every token for subject issued at or before
+	// before, as reported by Claims.IssueTime. The entry is kept until
+	// expiresAt, by which time every such token has expired.
+	DenySubject(ctx context.Context, subject string, before, expiresAt time.Time) error
+	// Denied reports whether the token with claims has been revoked.
+	Denied(ctx context.Context, claims *Claims) (bool, error)
+	// Prune drops entries that expired at or before now and returns how many
+	// it dropped.
+	Prune(ctx context.Context, now time.Time) (int, error)
+}
+
+// MemoryDenylist is a Denylist held in process memory. Revocations are lost
+// on restart and not shared between replicas.
+type MemoryDenylist struct {
+	mu       sync.Mutex
+	now      func() time.Time
+	tokens   map[string]time.Time
+	subjects map[string]subjectRevocation
+}
+
+type subjectRevocation struct {
+	before, expiresAt time.Time
+}
+
+// NewMemoryDenylist returns an empty MemoryDenylist.
+func NewMemoryDenylist() *MemoryDenylist {
+	return &MemoryDenylist{
+		now:      time.Now,
+		tokens:   map[string]time.Time{},
+		subjects: map[string]subjectRevocation{},
+	}
+}
+
+// Deny implements Denylist.
+func (m *MemoryDenylist) Deny(_ context.Context, jti string, expiresAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if cur, ok := m.tokens[jti]; !ok || expiresAt.After(cur) {
+		m.tokens[jti] = expiresAt
+	}
+	return nil
+}
+
+// DenySubject implements Denylist.
+func (m *MemoryDenylist) DenySubject(_ context.Context, subject string, before, expiresAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cur := m.subjects[subject]
+	if before.After(cur.before) {
+		cur.before = before
+	}
+	if expiresAt.After(cur.expiresAt) {
+		cur.expiresAt = expiresAt
+	}
+	m.subjects[subject] = cur
+	return nil
+}
+
+// Denied implements Denylist.
+func (m *MemoryDenylist) Denied(_ context.Context, claims *Claims) (bool, error) {
+	now := m.now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if exp, ok := m.tokens[claims.ID]; ok && claims.ID != "" && now.Before(exp) {
+		return true, nil
+	}
+	if r, ok := m.subjects[claims.Subject]; ok && now.Before(r.expiresAt) {
+		return !claims.IssueTime().After(r.before), nil
+	}
+	return false, nil
+}
+
+// Prune implements Denylist.
+func (m *MemoryDenylist) Prune(_ context.Context, now time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := 0
+	for jti, exp := range m.tokens {
+		if !now.Before(exp) {
+			delete(m.tokens, jti)
+			n++
+		}
+	}
+	for sub, r := range m.subjects {
+		if !now.Before(r.expiresAt) {
+			delete(m.subjects, sub)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// DefaultPruneInterval applies when security.denylist.prune_interval is
+// unset.
+const DefaultPruneInterval = 5 * time.Minute
+
+// Pruner periodically drops expired Denylist entries.
+type Pruner struct {
+	list     Denylist
+	interval time.Duration
+}
+
+// NewPruner returns a Pruner for list running every interval. An invalid or
+// unset interval falls back to DefaultPruneInterval; config.Validate rejects
+// it at startup.
+func NewPruner(list Denylist, interval string) *Pruner {
+	p := &Pruner{list: list, interval: DefaultPruneInterval}
+	if d, err := time.ParseDuration(strings.TrimSpace(interval)); err == nil && d > 0 {
+		p.interval = d
+	}
+	return p
+}
+
+// Run prunes every interval until ctx is cancelled.
+func (p *Pruner) Run(ctx context.Context) {
+	t := time.NewTicker(p.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		n, err := p.list.Prune(ctx, time.Now())
+		if err != nil {
+			slog.Error("token denylist prune failed", "error", err)
+			continue
+		}
+		if n > 0 {
+			slog.Info("Pruned token denylist", "entries", n)
+		}
+	}
+}
+
+// BearerToken returns the token of an "Authorization: Bearer <token>" header
+// value, or "" when h is not a bearer credential.
+func BearerToken(h string) string {
+	const p = "Bearer "
+	if strings.HasPrefix(h, p) {
+		return strings.TrimSpace(h[len(p):])
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+package security
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestMemoryDenylist(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	d := NewMemoryDenylist()
+	d.now = func() time.Time { return now }
+
+	claims := func(jti string, iat time.Time) *Claims {
+		return &Claims{
+			RegisteredClaims: jwt.RegisteredClaims{ID: jti, Subject: "u1", IssuedAt: jwt.NewNumericDate(iat)},
+			IssuedAtMicros:   iat.UnixMicro(),
+		}
+	}
+	denied := func(c *Claims) bool {
+		t.Helper()
+		ok, err := d.Denied(ctx, c)
+		if err != nil {
+			t.Fatalf("Denied: %v", err)
+		}
+		return ok
+	}
+
+	if denied(claims("a", now)) {
+		t.Fatal("empty denylist denied a token")
+	}
+	_ = d.Deny(ctx, "a", now.Add(time.Minute))
+	if !denied(claims("a", now)) || denied(claims("b", now)) {
+		t.Fatal("Deny must revoke exactly the given jti")
+	}
+
+	cutoff := now.Add(950 * time.Millisecond)
+	_ = d.DenySubject(ctx, "u1", cutoff, now.Add(15*time.Minute))
+	if !denied(claims("b", now.Add(-time.Minute))) {
+		t.Fatal("token issued before the subject cutoff was not denied")
+	}
+	if !denied(claims("b", now.Add(900*time.Millisecond))) {
+		t.Fatal("token issued earlier in the cutoff second was not denied")
+	}
+	if !denied(claims("b", cutoff)) {
+		t.Fatal("token issued at the subject cutoff was not denied")
+	}
+	if denied(claims("c", now.Add(990*time.Millisecond))) {
+		t.Fatal("token issued later in the cutoff second was denied")
+	}
+	if denied(claims("c", now.Add(time.Second))) {
+		t.Fatal("token issued after the subject cutoff was denied")
+	}
+	legacy := claims("c", now.Add(990*time.Millisecond))
+	legacy.IssuedAtMicros = 0
+	if !denied(legacy) {
+		t.Fatal("token without iat_us issued in the cutoff second was not denied")
+	}
+
+	if n, _ := d.Prune(ctx, now.Add(time.Minute)); n != 1 {
+		t.Fatalf("Prune at +1m dropped %d entries, want 1", n)
+	}
+	now = now.Add(time.Minute)
+	if denied(claims("a", now.Add(time.Second))) {
+		t.Fatal("pruned jti is still denied")
+	}
+	if n, _ := d.Prune(ctx, now.Add(time.Hour)); n != 1 {
+		t.Fatalf("Prune at +1h dropped %d entries, want 1", n)
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	if got := BearerToken("Bearer abc "); got != "abc" {
+		t.Fatalf("BearerToken = %q", got)
+	}
+	if got := BearerToken("Basic abc"); got != "" {
+		t.Fatalf("BearerToken(Basic) = %q", got)
+	}
+}
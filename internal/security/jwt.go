@@ -19,6 +19,34 @@ var (
 	ErrInvalidIssuer   = errors.New("invalid issuer")
 )
 
+// RoleAdmin is the role allowed to act on other users, e.g. to read their
+// expenses.
+const RoleAdmin = "admin"
+
+// Claims are the claims of access tokens issued by this service.
+type Claims struct {
+	jwt.RegisteredClaims
+	// Roles are the roles granted to the subject at issue time.
+	Roles []string `json:"roles,omitempty"`
+	// IssuedAtMicros is the issue time in Unix microseconds. iat only has
+	// whole seconds, too coarse to tell a token issued just before a session
+	// revocation from one issued just after it.
+	IssuedAtMicros int64 `json:"iat_us,omitempty"`
+}
+
+// IssueTime returns when the token was issued: IssuedAtMicros when set,
+// otherwise iat, or the zero time when it has neither so subject
+// revocations cover it.
+func (c *Claims) IssueTime() time.Time {
+	switch {
+	case c.IssuedAtMicros != 0:
+		return time.UnixMicro(c.IssuedAtMicros)
+	case c.IssuedAt != nil:
+		return c.IssuedAt.Time
+	}
+	return time.Time{}
+}
+
 type Verifier struct {
 	SignKey   []byte
 	VerifyAll [][]byte
@@ -72,17 +100,20 @@ func NewVerifierFromConfig(sec config.SecurityConfig) (*Verifier, error) {
 	return v, nil
 }
 
-func (v *Verifier) Verify(tokenString string) (*jwt.RegisteredClaims, error) {
+// Verify checks the token's signature, validity window, issuer and audience
+// and returns its claims. Callers attach them to the request context with
+// auth.WithUser.
+func (v *Verifier) Verify(tokenString string) (*Claims, error) {
 	if tokenString == "" {
 		return nil, ErrInvalidToken
 	}
 	var (
-		claims    *jwt.RegisteredClaims
+		claims    *Claims
 		parsed    *jwt.Token
 		parseErrs []error
 	)
 	for _, key := range v.VerifyAll {
-		c := &jwt.RegisteredClaims{}
+		c := &Claims{}
 		tok, err := jwt.ParseWithClaims(tokenString, c, func(t *jwt.Token) (interface{}, error) {
 			if t.Method.Alg() != jwt.SigningMethodHS256.Alg() {
 				return nil, fmt.Errorf("unexpected signing method: %s", t.Method.Alg())
@@ -125,6 +156,3 @@ func (v *Verifier) Verify(tokenString string) (*jwt.RegisteredClaims, error) {
 	}
 	return claims, nil
 }
-
-// This package only verifies tokens and returns claims; callers can thread
-// claims via their own context if needed.
@@ -0,0 +1,97 @@
+package security
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grpc-buf/internal/config"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Policy is the role-based access policy of security.authorization: which
+// permissions each role grants and which permission each procedure requires.
+type Policy struct {
+	grants     map[string]map[string]bool
+	procedures map[string]string
+}
+
+// NewPolicyFromConfig builds a Policy from cfg, which config.Validate has
+// already checked for shape.
+func NewPolicyFromConfig(cfg config.AuthorizationConfig) *Policy {
+	p := &Policy{grants: map[string]map[string]bool{}, procedures: map[string]string{}}
+	for role, perms := range cfg.Roles {
+		set := map[string]bool{}
+		for _, perm := range perms {
+			set[strings.TrimSpace(perm)] = true
+		}
+		p.grants[strings.TrimSpace(role)] = set
+	}
+	for proc, perm := range cfg.Procedures {
+		p.procedures[proc] = strings.TrimSpace(perm)
+	}
+	return p
+}
+
+// Permission returns the permission procedure requires and whether it
+// requires one. An entry for the procedure takes precedence over one for its
+// whole service.
+func (p *Policy) Permission(procedure string) (string, bool) {
+	if perm, ok := p.procedures[procedure]; ok {
+		return perm, true
+	}
+	if i := strings.LastIndex(procedure, "/"); i > 0 {
+		perm, ok := p.procedures[procedure[:i]+"/*"]
+		return perm, ok
+	}
+	return "", false
+}
+
+// Allows reports whether any of roles grants perm. Unknown roles grant
+// nothing.
+func (p *Policy) Allows(roles []string, perm string) bool {
+	for _, r := range roles {
+		if g := p.grants[r]; g[perm] || g[config.PermissionAll] {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the policy against the registered services: every
+// procedure in the policy must be a method, or every method, of one of
+// services, so a typo cannot silently leave a procedure unprotected, and
+// every method that requires authentication (its name has none of
+// skipSuffixes) must resolve to a permission.
+func (p *Policy) Validate(services []protoreflect.ServiceDescriptor, skipSuffixes []string) error {
+	known := map[string]bool{}
+	var uncovered []string
+	for _, sd := range services {
+		known["/"+string(sd.FullName())+"/*"] = true
+		methods := sd.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			proc := "/" + string(sd.FullName()) + "/" + string(methods.Get(i).Name())
+			known[proc] = true
+			if _, ok := p.Permission(proc); !ok && !hasAnySuffix(proc, skipSuffixes) {
+				uncovered = append(uncovered, proc)
+			}
+		}
+	}
+	for proc := range p.procedures {
+		if !known[proc] {
+			return fmt.Errorf("security.authorization.procedures: %q matches no registered procedure", proc)
+		}
+	}
+	if len(uncovered) > 0 {
+		return fmt.Errorf("security.authorization.procedures: no permission for %s", strings.Join(uncovered, ", "))
+	}
+	return nil
+}
+
+func hasAnySuffix(s string, suffixes []string) bool {
+	for _, suf := range suffixes {
+		if strings.HasSuffix(s, suf) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+package security
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/grpc-buf/internal/config"
+	userv1 "github.com/grpc-buf/internal/gen/proto/registration"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestPolicy(t *testing.T) {
+	p := NewPolicyFromConfig(config.AuthorizationConfig{
+		Roles: map[string][]string{
+			"finance": {"ledger.read"},
+			"admin":   {config.PermissionAll},
+		},
+		Procedures: map[string]string{
+			"/rpc.user.v1.UserService/*":                  "users.manage",
+			"/rpc.user.v1.UserService/RevokeUserSessions": "sessions.revoke",
+		},
+	})
+
+	if perm, ok := p.Permission("/rpc.user.v1.UserService/RevokeUserSessions"); !ok || perm != "sessions.revoke" {
+		t.Fatalf("exact entry: got %q, %v", perm, ok)
+	}
+	if perm, ok := p.Permission("/rpc.user.v1.UserService/LogoutUser"); !ok || perm != "users.manage" {
+		t.Fatalf("service entry: got %q, %v", perm, ok)
+	}
+	if _, ok := p.Permission("/rpc.expense.v1.ExpenseService/GetExpense"); ok {
+		t.Fatal("unlisted procedure requires a permission")
+	}
+
+	if !p.Allows([]string{"user", "finance"}, "ledger.read") {
+		t.Fatal("finance should grant ledger.read")
+	}
+	if p.Allows([]string{"finance"}, "sessions.revoke") || p.Allows(nil, "ledger.read") {
+		t.Fatal("permission granted without a role granting it")
+	}
+	if !p.Allows([]string{"admin"}, "sessions.revoke") {
+		t.Fatal("admin should grant every permission")
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	services := []protoreflect.ServiceDescriptor{userv1.File_registration_user_proto.Services().Get(0)}
+	for proc, ok := range map[string]bool{
+		"/rpc.user.v1.UserService/RevokeUserSessions": true,
+		"/rpc.user.v1.UserService/*":                  true,
+		"/rpc.user.v1.UserService/RevokeSessions":     false,
+		"/rpc.user.v2.UserService/*":                  false,
+	} {
+		p := NewPolicyFromConfig(config.AuthorizationConfig{Procedures: map[string]string{
+			"/rpc.user.v1.UserService/*": "users.manage",
+			proc:                         "p",
+		}})
+		if err := p.Validate(services, nil); (err == nil) != ok {
+			t.Errorf("Validate(%s) = %v, want ok=%v", proc, err, ok)
+		}
+	}
+
+	p := NewPolicyFromConfig(config.AuthorizationConfig{Procedures: map[string]string{
+		"/rpc.user.v1.UserService/LogoutUser": "sessions.logout",
+	}})
+	skip := []string{"/RegisterUser", "/LoginUser", "/RefreshToken"}
+	if err := p.Validate(services, skip); err == nil || !strings.Contains(err.Error(), "/rpc.user.v1.UserService/RevokeUserSessions") {
+		t.Fatalf("uncovered procedure: got %v", err)
+	}
+	p = NewPolicyFromConfig(config.AuthorizationConfig{Procedures: map[string]string{
+		"/rpc.user.v1.UserService/LogoutUser":         "sessions.logout",
+		"/rpc.user.v1.UserService/RevokeUserSessions": "sessions.revoke",
+	}})
+	if err := p.Validate(services, skip); err != nil {
+		t.Fatalf("covered policy: %v", err)
+	}
+	if err := p.Validate(services, nil); err == nil || !strings.Contains(err.Error(), "/rpc.user.v1.UserService/LoginUser") {
+		t.Fatalf("authenticated LoginUser without permission: got %v", err)
+	}
+}
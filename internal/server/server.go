@@ -16,13 +16,16 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/grpc-buf/internal/config"
+	"github.com/grpc-buf/internal/dunning"
 	"github.com/grpc-buf/internal/postgres"
 	"github.com/grpc-buf/internal/security"
 	"github.com/grpc-buf/internal/service"
+	"github.com/grpc-buf/internal/subscription"
 	httptransport "github.com/grpc-buf/internal/transport/http"
 	authmw "github.com/grpc-buf/internal/transport/middleware/auth"
 	"github.com/grpc-buf/internal/transport/middleware/ratelimit"
 	"github.com/grpc-buf/internal/version"
+	"github.com/grpc-buf/internal/webhook"
 	"github.com/rs/cors"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
@@ -52,17 +55,29 @@ func Run(cfg *config.Config) error {
 	paymentService := service.NewPaymentService(db)
 	userService := service.NewUserService(db)
 	expenseService := service.NewExpenseService(db)
+	webhookService := service.NewWebhookService(db)
+	subscriptionService := service.NewSubscriptionService(db)
+	tokenizationService := service.NewTokenizationService(db)
 
-	interceptors := buildInterceptors(cfg)
+	interceptors, err := buildInterceptors(cfg, db.Denylist())
+	if err != nil {
+		return err
+	}
 
 	mux := httptransport.NewMuxWithInterceptors(
 		paymentService,
 		userService,
 		expenseService,
+		webhookService,
+		subscriptionService,
+		tokenizationService,
 		interceptors...,
 	)
 	root := http.NewServeMux()
 	root.Handle("/readyz", readyHandler(db))
+	if h := processorWebhookHandler(cfg, db); h != nil {
+		root.Handle(httptransport.ProcessorWebhookPath, h)
+	}
 	root.HandleFunc("/version", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		if err := json.NewEncoder(w).Encode(version.Get()); err != nil {
@@ -83,6 +98,21 @@ func Run(cfg *config.Config) error {
 		MaxHeaderBytes:    8 * 1024, // 8KiB
 	}
 
+	// The dispatcher stops with ctx; deliveries it leased but did not finish
+	// are retried once the lease expires.
+	go webhook.NewDispatcher(db, cfg.Webhooks).Run(ctx)
+	// Every replica runs the dunning job; the store skips invoices another
+	// replica has claimed.
+	go dunning.NewJob(db, cfg.Dunning).Run(ctx)
+	// Likewise for the subscription scheduler; each period is invoiced once.
+	go subscription.NewScheduler(db, cfg.Subscriptions, nil).Run(ctx)
+	// Expired denylist entries can no longer match a valid token.
+	go security.NewPruner(db.Denylist(), cfg.Security.Denylist.PruneInterval).Run(ctx)
+	// Expired idempotency keys are treated as unseen but stay until pruned.
+	go postgres.NewIdempotencyPruner(db, cfg.Payments.IdempotencyPruneInterval).Run(ctx)
+	// Expired refresh tokens are rejected but stay until pruned.
+	go postgres.NewRefreshTokenPruner(db, cfg.Security.RefreshTokenPruneInterval).Run(ctx)
+
 	slog.Info("Starting gRPC server", "addr", srv.Addr)
 
 	serveErr := make(chan error, 1)
@@ -115,7 +145,11 @@ func Run(cfg *config.Config) error {
 	return shutdownErr
 }
 
-func buildInterceptors(cfg *config.Config) []connect.Interceptor {
+// buildInterceptors returns the rate limiting, authentication and
+// authorization interceptors. It fails when security.authorization names a
+// procedure that is not registered or leaves an authenticated procedure
+// without a permission.
+func buildInterceptors(cfg *config.Config, deny security.Denylist) ([]connect.Interceptor, error) {
 	loginRPS := 5
 	loginBurst := 10
 	if cfg.Server.LoginRPS > 0 {
@@ -128,16 +162,44 @@ func buildInterceptors(cfg *config.Config) []connect.Interceptor {
 		ratelimit.NewLoginInterceptor(float64(loginRPS), loginBurst),
 	}
 
+	skip := cfg.Security.AuthSkipSuffixes
+	if len(skip) == 0 {
+		skip = []string{"/RegisterUser", "/LoginUser", "/RefreshToken"}
+	}
+	policy := security.NewPolicyFromConfig(cfg.Security.Authorization)
+	services, err := httptransport.ServiceDescriptors()
+	if err != nil {
+		return nil, err
+	}
+	if err := policy.Validate(services, skip); err != nil {
+		return nil, err
+	}
+	// Without authentication there is no caller, so the policy still denies
+	// every procedure it lists.
+	rbac := authmw.NewRBACInterceptor(policy)
+
 	verifier, err := security.NewVerifierFromConfig(cfg.Security)
 	if err != nil || verifier == nil {
 		slog.Warn("JWT auth disabled: missing secret")
-		return interceptors
+		return append(interceptors, rbac), nil
 	}
-	skip := cfg.Security.AuthSkipSuffixes
-	if len(skip) == 0 {
-		skip = []string{"/RegisterUser", "/LoginUser"}
+	return append(interceptors, authmw.NewJWTAuthInterceptor(verifier, skip, deny), rbac), nil
+}
+
+// processorWebhookHandler returns the provider event endpoint, or nil when no
+// webhook secret is configured.
+func processorWebhookHandler(cfg *config.Config, store httptransport.ProcessorEventStore) http.Handler {
+	pc := cfg.Payments.Processor
+	secret := strings.TrimSpace(pc.WebhookSecret)
+	if secret == "" {
+		slog.Warn("processor webhook disabled: missing secret")
+		return nil
+	}
+	tolerance := 5 * time.Minute
+	if d, err := time.ParseDuration(strings.TrimSpace(pc.WebhookTolerance)); err == nil && d > 0 {
+		tolerance = d
 	}
-	return append(interceptors, authmw.NewJWTAuthInterceptor(verifier, skip))
+	return httptransport.NewProcessorWebhookHandler(store, []byte(secret), tolerance)
 }
 
 // listenAddr resolves the bind address from (in order): Cloud Run's PORT,
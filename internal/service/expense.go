@@ -14,6 +14,7 @@ type ExpenseService interface {
 	CreateExpense(ctx context.Context, req *connect.Request[expensev1.CreateExpenseRequest]) (*connect.Response[expensev1.Expense], error)
 	GetExpense(ctx context.Context, req *connect.Request[expensev1.GetExpenseRequest]) (*connect.Response[expensev1.Expense], error)
 	ListExpenses(ctx context.Context, req *connect.Request[expensev1.ListExpensesRequest]) (*connect.Response[expensev1.ListExpensesResponse], error)
+	SummarizeExpenses(ctx context.Context, req *connect.Request[expensev1.SummarizeExpensesRequest]) (*connect.Response[expensev1.SummarizeExpensesResponse], error)
 	UpdateExpense(ctx context.Context, req *connect.Request[expensev1.UpdateExpenseRequest]) (*connect.Response[expensev1.Expense], error)
 	DeleteExpense(ctx context.Context, req *connect.Request[expensev1.DeleteExpenseRequest]) (*connect.Response[emptypb.Empty], error)
 }
@@ -39,6 +40,10 @@ func (s *expenseService) ListExpenses(ctx context.Context, req *connect.Request[
 	return s.store.ListExpenses(ctx, req)
 }
 
+func (s *expenseService) SummarizeExpenses(ctx context.Context, req *connect.Request[expensev1.SummarizeExpensesRequest]) (*connect.Response[expensev1.SummarizeExpensesResponse], error) {
+	return s.store.SummarizeExpenses(ctx, req)
+}
+
 func (s *expenseService) UpdateExpense(ctx context.Context, req *connect.Request[expensev1.UpdateExpenseRequest]) (*connect.Response[expensev1.Expense], error) {
 	return s.store.UpdateExpense(ctx, req)
 }
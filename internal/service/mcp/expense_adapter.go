@@ -49,6 +49,16 @@ func (a *ExpenseServiceAdapter) ListExpenses(ctx context.Context, req *expensev1
 	return resp.Msg, nil
 }
 
+// SummarizeExpenses adapts from MCP to Connect
+func (a *ExpenseServiceAdapter) SummarizeExpenses(ctx context.Context, req *expensev1.SummarizeExpensesRequest) (*expensev1.SummarizeExpensesResponse, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.SummarizeExpenses(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
 // UpdateExpense adapts from MCP to Connect
 func (a *ExpenseServiceAdapter) UpdateExpense(ctx context.Context, req *expensev1.UpdateExpenseRequest) (*expensev1.Expense, error) {
 	connectReq := connect.NewRequest(req)
@@ -28,6 +28,96 @@ func (a *PaymentServiceAdapter) MakePayment(ctx context.Context, req *paymentv1.
 	return resp.Msg, nil
 }
 
+// GetPayment adapts from MCP to Connect
+func (a *PaymentServiceAdapter) GetPayment(ctx context.Context, req *paymentv1.GetPaymentRequest) (*paymentv1.Payment, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.GetPayment(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ListPayments adapts from MCP to Connect
+func (a *PaymentServiceAdapter) ListPayments(ctx context.Context, req *paymentv1.ListPaymentsRequest) (*paymentv1.ListPaymentsResponse, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.ListPayments(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// SummarizePayments adapts from MCP to Connect
+func (a *PaymentServiceAdapter) SummarizePayments(ctx context.Context, req *paymentv1.SummarizePaymentsRequest) (*paymentv1.SummarizePaymentsResponse, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.SummarizePayments(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// GetLedgerBalances adapts from MCP to Connect
+func (a *PaymentServiceAdapter) GetLedgerBalances(ctx context.Context, req *paymentv1.GetLedgerBalancesRequest) (*paymentv1.GetLedgerBalancesResponse, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.GetLedgerBalances(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ReconcileSettlement adapts from MCP to Connect
+func (a *PaymentServiceAdapter) ReconcileSettlement(ctx context.Context, req *paymentv1.ReconcileSettlementRequest) (*paymentv1.ReconcileSettlementResponse, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.ReconcileSettlement(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// CreateInvoice adapts from MCP to Connect
+func (a *PaymentServiceAdapter) CreateInvoice(ctx context.Context, req *paymentv1.CreateInvoiceRequest) (*paymentv1.Invoice, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.CreateInvoice(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// GetInvoice adapts from MCP to Connect
+func (a *PaymentServiceAdapter) GetInvoice(ctx context.Context, req *paymentv1.GetInvoiceRequest) (*paymentv1.Invoice, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.GetInvoice(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ListInvoices adapts from MCP to Connect
+func (a *PaymentServiceAdapter) ListInvoices(ctx context.Context, req *paymentv1.ListInvoicesRequest) (*paymentv1.ListInvoicesResponse, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.ListInvoices(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// CancelInvoice adapts from MCP to Connect
+func (a *PaymentServiceAdapter) CancelInvoice(ctx context.Context, req *paymentv1.CancelInvoiceRequest) (*paymentv1.Invoice, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.CancelInvoice(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
 // MarkInvoicePaid adapts from MCP to Connect
 func (a *PaymentServiceAdapter) MarkInvoicePaid(ctx context.Context, req *paymentv1.MarkInvoicePaidRequest) (*paymentv1.Invoice, error) {
 	connectReq := connect.NewRequest(req)
@@ -47,3 +137,123 @@ func (a *PaymentServiceAdapter) PayInvoice(ctx context.Context, req *paymentv1.P
 	}
 	return resp.Msg, nil
 }
+
+// PayInvoices adapts from MCP to Connect
+func (a *PaymentServiceAdapter) PayInvoices(ctx context.Context, req *paymentv1.PayInvoicesRequest) (*paymentv1.PayInvoicesResponse, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.PayInvoices(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// CapturePayment adapts from MCP to Connect
+func (a *PaymentServiceAdapter) CapturePayment(ctx context.Context, req *paymentv1.CapturePaymentRequest) (*paymentv1.Payment, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.CapturePayment(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// VoidPayment adapts from MCP to Connect
+func (a *PaymentServiceAdapter) VoidPayment(ctx context.Context, req *paymentv1.VoidPaymentRequest) (*paymentv1.Payment, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.VoidPayment(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// RefundPayment adapts from MCP to Connect
+func (a *PaymentServiceAdapter) RefundPayment(ctx context.Context, req *paymentv1.RefundPaymentRequest) (*paymentv1.Payment, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.RefundPayment(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// CreateRefund adapts from MCP to Connect
+func (a *PaymentServiceAdapter) CreateRefund(ctx context.Context, req *paymentv1.CreateRefundRequest) (*paymentv1.Refund, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.CreateRefund(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// GetRefund adapts from MCP to Connect
+func (a *PaymentServiceAdapter) GetRefund(ctx context.Context, req *paymentv1.GetRefundRequest) (*paymentv1.Refund, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.GetRefund(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ListRefunds adapts from MCP to Connect
+func (a *PaymentServiceAdapter) ListRefunds(ctx context.Context, req *paymentv1.ListRefundsRequest) (*paymentv1.ListRefundsResponse, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.ListRefunds(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// CreateDispute adapts from MCP to Connect
+func (a *PaymentServiceAdapter) CreateDispute(ctx context.Context, req *paymentv1.CreateDisputeRequest) (*paymentv1.Dispute, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.CreateDispute(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// GetDispute adapts from MCP to Connect
+func (a *PaymentServiceAdapter) GetDispute(ctx context.Context, req *paymentv1.GetDisputeRequest) (*paymentv1.Dispute, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.GetDispute(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ListDisputes adapts from MCP to Connect
+func (a *PaymentServiceAdapter) ListDisputes(ctx context.Context, req *paymentv1.ListDisputesRequest) (*paymentv1.ListDisputesResponse, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.ListDisputes(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// AddDisputeEvidence adapts from MCP to Connect
+func (a *PaymentServiceAdapter) AddDisputeEvidence(ctx context.Context, req *paymentv1.AddDisputeEvidenceRequest) (*paymentv1.Dispute, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.AddDisputeEvidence(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ResolveDispute adapts from MCP to Connect
+func (a *PaymentServiceAdapter) ResolveDispute(ctx context.Context, req *paymentv1.ResolveDisputeRequest) (*paymentv1.Dispute, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.ResolveDispute(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
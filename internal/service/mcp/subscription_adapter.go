@@ -0,0 +1,79 @@
+package mcp
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	subscriptionv1 "github.com/grpc-buf/internal/gen/proto/subscription"
+	"github.com/grpc-buf/internal/service"
+)
+
+// SubscriptionServiceAdapter adapts Connect-based SubscriptionService to MCP interface
+type SubscriptionServiceAdapter struct {
+	svc service.SubscriptionService
+}
+
+// NewSubscriptionServiceAdapter creates a new adapter
+func NewSubscriptionServiceAdapter(svc service.SubscriptionService) *SubscriptionServiceAdapter {
+	return &SubscriptionServiceAdapter{svc: svc}
+}
+
+// CreateSubscription adapts from MCP to Connect
+func (a *SubscriptionServiceAdapter) CreateSubscription(ctx context.Context, req *subscriptionv1.CreateSubscriptionRequest) (*subscriptionv1.Subscription, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.CreateSubscription(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// GetSubscription adapts from MCP to Connect
+func (a *SubscriptionServiceAdapter) GetSubscription(ctx context.Context, req *subscriptionv1.GetSubscriptionRequest) (*subscriptionv1.Subscription, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.GetSubscription(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ListSubscriptions adapts from MCP to Connect
+func (a *SubscriptionServiceAdapter) ListSubscriptions(ctx context.Context, req *subscriptionv1.ListSubscriptionsRequest) (*subscriptionv1.ListSubscriptionsResponse, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.ListSubscriptions(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// PauseSubscription adapts from MCP to Connect
+func (a *SubscriptionServiceAdapter) PauseSubscription(ctx context.Context, req *subscriptionv1.PauseSubscriptionRequest) (*subscriptionv1.Subscription, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.PauseSubscription(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ResumeSubscription adapts from MCP to Connect
+func (a *SubscriptionServiceAdapter) ResumeSubscription(ctx context.Context, req *subscriptionv1.ResumeSubscriptionRequest) (*subscriptionv1.Subscription, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.ResumeSubscription(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// CancelSubscription adapts from MCP to Connect
+func (a *SubscriptionServiceAdapter) CancelSubscription(ctx context.Context, req *subscriptionv1.CancelSubscriptionRequest) (*subscriptionv1.Subscription, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.CancelSubscription(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
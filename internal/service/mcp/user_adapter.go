@@ -28,6 +28,36 @@ func (a *UserServiceAdapter) LoginUser(ctx context.Context, req *userv1.LoginReq
 	return resp.Msg, nil
 }
 
+// RefreshToken adapts from MCP to Connect
+func (a *UserServiceAdapter) RefreshToken(ctx context.Context, req *userv1.RefreshTokenRequest) (*userv1.LoginResponse, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.RefreshToken(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// LogoutUser adapts from MCP to Connect
+func (a *UserServiceAdapter) LogoutUser(ctx context.Context, req *userv1.LogoutRequest) (*userv1.LogoutResponse, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.LogoutUser(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// RevokeUserSessions adapts from MCP to Connect
+func (a *UserServiceAdapter) RevokeUserSessions(ctx context.Context, req *userv1.RevokeUserSessionsRequest) (*userv1.RevokeUserSessionsResponse, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.RevokeUserSessions(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
 // RegisterUser adapts from MCP to Connect
 func (a *UserServiceAdapter) RegisterUser(ctx context.Context, req *userv1.RegisterRequest) (*userv1.RegisterResponse, error) {
 	connectReq := connect.NewRequest(req)
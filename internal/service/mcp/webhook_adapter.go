@@ -0,0 +1,70 @@
+package mcp
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	webhookv1 "github.com/grpc-buf/internal/gen/proto/webhook"
+	"github.com/grpc-buf/internal/service"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// WebhookServiceAdapter adapts Connect-based WebhookService to MCP interface
+type WebhookServiceAdapter struct {
+	svc service.WebhookService
+}
+
+// NewWebhookServiceAdapter creates a new adapter
+func NewWebhookServiceAdapter(svc service.WebhookService) *WebhookServiceAdapter {
+	return &WebhookServiceAdapter{svc: svc}
+}
+
+// CreateWebhookEndpoint adapts from MCP to Connect
+func (a *WebhookServiceAdapter) CreateWebhookEndpoint(ctx context.Context, req *webhookv1.CreateWebhookEndpointRequest) (*webhookv1.WebhookEndpoint, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.CreateWebhookEndpoint(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ListWebhookEndpoints adapts from MCP to Connect
+func (a *WebhookServiceAdapter) ListWebhookEndpoints(ctx context.Context, req *webhookv1.ListWebhookEndpointsRequest) (*webhookv1.ListWebhookEndpointsResponse, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.ListWebhookEndpoints(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// DeleteWebhookEndpoint adapts from MCP to Connect
+func (a *WebhookServiceAdapter) DeleteWebhookEndpoint(ctx context.Context, req *webhookv1.DeleteWebhookEndpointRequest) (*emptypb.Empty, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.DeleteWebhookEndpoint(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ListWebhookDeliveries adapts from MCP to Connect
+func (a *WebhookServiceAdapter) ListWebhookDeliveries(ctx context.Context, req *webhookv1.ListWebhookDeliveriesRequest) (*webhookv1.ListWebhookDeliveriesResponse, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.ListWebhookDeliveries(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+// ReplayWebhookDelivery adapts from MCP to Connect
+func (a *WebhookServiceAdapter) ReplayWebhookDelivery(ctx context.Context, req *webhookv1.ReplayWebhookDeliveryRequest) (*webhookv1.WebhookDelivery, error) {
+	connectReq := connect.NewRequest(req)
+	resp, err := a.svc.ReplayWebhookDelivery(ctx, connectReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"errors"
 
 	"connectrpc.com/connect"
 	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
@@ -12,8 +11,29 @@ import (
 // PaymentService exposes payment operations as Connect handlers.
 type PaymentService interface {
 	MakePayment(ctx context.Context, c *connect.Request[paymentv1.PaymentRequest]) (*connect.Response[paymentv1.PaymentResponse], error)
+	GetPayment(ctx context.Context, c *connect.Request[paymentv1.GetPaymentRequest]) (*connect.Response[paymentv1.Payment], error)
+	ListPayments(ctx context.Context, c *connect.Request[paymentv1.ListPaymentsRequest]) (*connect.Response[paymentv1.ListPaymentsResponse], error)
+	SummarizePayments(ctx context.Context, c *connect.Request[paymentv1.SummarizePaymentsRequest]) (*connect.Response[paymentv1.SummarizePaymentsResponse], error)
+	GetLedgerBalances(ctx context.Context, c *connect.Request[paymentv1.GetLedgerBalancesRequest]) (*connect.Response[paymentv1.GetLedgerBalancesResponse], error)
+	ReconcileSettlement(ctx context.Context, c *connect.Request[paymentv1.ReconcileSettlementRequest]) (*connect.Response[paymentv1.ReconcileSettlementResponse], error)
+	CapturePayment(ctx context.Context, c *connect.Request[paymentv1.CapturePaymentRequest]) (*connect.Response[paymentv1.Payment], error)
+	VoidPayment(ctx context.Context, c *connect.Request[paymentv1.VoidPaymentRequest]) (*connect.Response[paymentv1.Payment], error)
+	RefundPayment(ctx context.Context, c *connect.Request[paymentv1.RefundPaymentRequest]) (*connect.Response[paymentv1.Payment], error)
+	CreateRefund(ctx context.Context, c *connect.Request[paymentv1.CreateRefundRequest]) (*connect.Response[paymentv1.Refund], error)
+	GetRefund(ctx context.Context, c *connect.Request[paymentv1.GetRefundRequest]) (*connect.Response[paymentv1.Refund], error)
+	ListRefunds(ctx context.Context, c *connect.Request[paymentv1.ListRefundsRequest]) (*connect.Response[paymentv1.ListRefundsResponse], error)
+	CreateDispute(ctx context.Context, c *connect.Request[paymentv1.CreateDisputeRequest]) (*connect.Response[paymentv1.Dispute], error)
+	GetDispute(ctx context.Context, c *connect.Request[paymentv1.GetDisputeRequest]) (*connect.Response[paymentv1.Dispute], error)
+	ListDisputes(ctx context.Context, c *connect.Request[paymentv1.ListDisputesRequest]) (*connect.Response[paymentv1.ListDisputesResponse], error)
+	AddDisputeEvidence(ctx context.Context, c *connect.Request[paymentv1.AddDisputeEvidenceRequest]) (*connect.Response[paymentv1.Dispute], error)
+	ResolveDispute(ctx context.Context, c *connect.Request[paymentv1.ResolveDisputeRequest]) (*connect.Response[paymentv1.Dispute], error)
+	CreateInvoice(ctx context.Context, c *connect.Request[paymentv1.CreateInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error)
+	GetInvoice(ctx context.Context, c *connect.Request[paymentv1.GetInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error)
+	ListInvoices(ctx context.Context, c *connect.Request[paymentv1.ListInvoicesRequest]) (*connect.Response[paymentv1.ListInvoicesResponse], error)
+	CancelInvoice(ctx context.Context, c *connect.Request[paymentv1.CancelInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error)
 	MarkInvoicePaid(ctx context.Context, c *connect.Request[paymentv1.MarkInvoicePaidRequest]) (*connect.Response[paymentv1.Invoice], error)
 	PayInvoice(ctx context.Context, c *connect.Request[paymentv1.PayInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error)
+	PayInvoices(ctx context.Context, c *connect.Request[paymentv1.PayInvoicesRequest]) (*connect.Response[paymentv1.PayInvoicesResponse], error)
 }
 
 type paymentService struct {
@@ -25,14 +45,98 @@ func NewPaymentService(data postgres.DataStore) PaymentService {
 	return &paymentService{store: data}
 }
 
-func (s *paymentService) MarkInvoicePaid(_ context.Context, _ *connect.Request[paymentv1.MarkInvoicePaidRequest]) (*connect.Response[paymentv1.Invoice], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("MarkInvoicePaid not implemented"))
+func (s *paymentService) CreateInvoice(ctx context.Context, c *connect.Request[paymentv1.CreateInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error) {
+	return s.store.CreateInvoice(ctx, c)
 }
 
-func (s *paymentService) PayInvoice(_ context.Context, _ *connect.Request[paymentv1.PayInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("PayInvoice not implemented"))
+func (s *paymentService) GetInvoice(ctx context.Context, c *connect.Request[paymentv1.GetInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error) {
+	return s.store.GetInvoice(ctx, c)
+}
+
+func (s *paymentService) ListInvoices(ctx context.Context, c *connect.Request[paymentv1.ListInvoicesRequest]) (*connect.Response[paymentv1.ListInvoicesResponse], error) {
+	return s.store.ListInvoices(ctx, c)
+}
+
+func (s *paymentService) CancelInvoice(ctx context.Context, c *connect.Request[paymentv1.CancelInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error) {
+	return s.store.CancelInvoice(ctx, c)
+}
+
+func (s *paymentService) MarkInvoicePaid(ctx context.Context, c *connect.Request[paymentv1.MarkInvoicePaidRequest]) (*connect.Response[paymentv1.Invoice], error) {
+	return s.store.MarkInvoicePaid(ctx, c)
+}
+
+func (s *paymentService) PayInvoice(ctx context.Context, c *connect.Request[paymentv1.PayInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error) {
+	return s.store.PayInvoice(ctx, c)
+}
+
+func (s *paymentService) PayInvoices(ctx context.Context, c *connect.Request[paymentv1.PayInvoicesRequest]) (*connect.Response[paymentv1.PayInvoicesResponse], error) {
+	return s.store.PayInvoices(ctx, c)
 }
 
 func (s *paymentService) MakePayment(ctx context.Context, c *connect.Request[paymentv1.PaymentRequest]) (*connect.Response[paymentv1.PaymentResponse], error) {
 	return s.store.MakePayment(ctx, c)
 }
+
+func (s *paymentService) GetPayment(ctx context.Context, c *connect.Request[paymentv1.GetPaymentRequest]) (*connect.Response[paymentv1.Payment], error) {
+	return s.store.GetPayment(ctx, c)
+}
+
+func (s *paymentService) ListPayments(ctx context.Context, c *connect.Request[paymentv1.ListPaymentsRequest]) (*connect.Response[paymentv1.ListPaymentsResponse], error) {
+	return s.store.ListPayments(ctx, c)
+}
+
+func (s *paymentService) SummarizePayments(ctx context.Context, c *connect.Request[paymentv1.SummarizePaymentsRequest]) (*connect.Response[paymentv1.SummarizePaymentsResponse], error) {
+	return s.store.SummarizePayments(ctx, c)
+}
+
+func (s *paymentService) GetLedgerBalances(ctx context.Context, c *connect.Request[paymentv1.GetLedgerBalancesRequest]) (*connect.Response[paymentv1.GetLedgerBalancesResponse], error) {
+	return s.store.GetLedgerBalances(ctx, c)
+}
+
+func (s *paymentService) ReconcileSettlement(ctx context.Context, c *connect.Request[paymentv1.ReconcileSettlementRequest]) (*connect.Response[paymentv1.ReconcileSettlementResponse], error) {
+	return s.store.ReconcileSettlement(ctx, c)
+}
+
+func (s *paymentService) CapturePayment(ctx context.Context, c *connect.Request[paymentv1.CapturePaymentRequest]) (*connect.Response[paymentv1.Payment], error) {
+	return s.store.CapturePayment(ctx, c)
+}
+
+func (s *paymentService) VoidPayment(ctx context.Context, c *connect.Request[paymentv1.VoidPaymentRequest]) (*connect.Response[paymentv1.Payment], error) {
+	return s.store.VoidPayment(ctx, c)
+}
+
+func (s *paymentService) RefundPayment(ctx context.Context, c *connect.Request[paymentv1.RefundPaymentRequest]) (*connect.Response[paymentv1.Payment], error) {
+	return s.store.RefundPayment(ctx, c)
+}
+
+func (s *paymentService) CreateRefund(ctx context.Context, c *connect.Request[paymentv1.CreateRefundRequest]) (*connect.Response[paymentv1.Refund], error) {
+	return s.store.CreateRefund(ctx, c)
+}
+
+func (s *paymentService) GetRefund(ctx context.Context, c *connect.Request[paymentv1.GetRefundRequest]) (*connect.Response[paymentv1.Refund], error) {
+	return s.store.GetRefund(ctx, c)
+}
+
+func (s *paymentService) ListRefunds(ctx context.Context, c *connect.Request[paymentv1.ListRefundsRequest]) (*connect.Response[paymentv1.ListRefundsResponse], error) {
+	return s.store.ListRefunds(ctx, c)
+}
+
+func (s *paymentService) CreateDispute(ctx context.Context, c *connect.Request[paymentv1.CreateDisputeRequest]) (*connect.Response[paymentv1.Dispute], error) {
+	return s.store.CreateDispute(ctx, c)
+}
+
+func (s *paymentService) GetDispute(ctx context.Context, c *connect.Request[paymentv1.GetDisputeRequest]) (*connect.Response[paymentv1.Dispute], error) {
+	return s.store.GetDispute(ctx, c)
+}
+
+func (s *paymentService) ListDisputes(ctx context.Context, c *connect.Request[paymentv1.ListDisputesRequest]) (*connect.Response[paymentv1.ListDisputesResponse], error) {
+	return s.store.ListDisputes(ctx, c)
+}
+
+func (s *paymentService) AddDisputeEvidence(ctx context.Context, c *connect.Request[paymentv1.AddDisputeEvidenceRequest]) (*connect.Response[paymentv1.Dispute], error) {
+	return s.store.AddDisputeEvidence(ctx, c)
+}
+
+func (s *paymentService) ResolveDispute(ctx context.Context, c *connect.Request[paymentv1.ResolveDisputeRequest]) (*connect.Response[paymentv1.Dispute], error) {
+	return s.store.ResolveDispute(ctx, c)
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	subscriptionv1 "github.com/grpc-buf/internal/gen/proto/subscription"
+	"github.com/grpc-buf/internal/postgres"
+)
+
+// SubscriptionService exposes recurring subscriptions as Connect handlers.
+type SubscriptionService interface {
+	CreateSubscription(ctx context.Context, req *connect.Request[subscriptionv1.CreateSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error)
+	GetSubscription(ctx context.Context, req *connect.Request[subscriptionv1.GetSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error)
+	ListSubscriptions(ctx context.Context, req *connect.Request[subscriptionv1.ListSubscriptionsRequest]) (*connect.Response[subscriptionv1.ListSubscriptionsResponse], error)
+	PauseSubscription(ctx context.Context, req *connect.Request[subscriptionv1.PauseSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error)
+	ResumeSubscription(ctx context.Context, req *connect.Request[subscriptionv1.ResumeSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error)
+	CancelSubscription(ctx context.Context, req *connect.Request[subscriptionv1.CancelSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error)
+}
+
+type subscriptionService struct {
+	store postgres.DataStore
+}
+
+// NewSubscriptionService returns a SubscriptionService backed by the given
+// DataStore.
+func NewSubscriptionService(data postgres.DataStore) SubscriptionService {
+	return &subscriptionService{store: data}
+}
+
+func (s *subscriptionService) CreateSubscription(ctx context.Context, req *connect.Request[subscriptionv1.CreateSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error) {
+	return s.store.CreateSubscription(ctx, req)
+}
+
+func (s *subscriptionService) GetSubscription(ctx context.Context, req *connect.Request[subscriptionv1.GetSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error) {
+	return s.store.GetSubscription(ctx, req)
+}
+
+func (s *subscriptionService) ListSubscriptions(ctx context.Context, req *connect.Request[subscriptionv1.ListSubscriptionsRequest]) (*connect.Response[subscriptionv1.ListSubscriptionsResponse], error) {
+	return s.store.ListSubscriptions(ctx, req)
+}
+
+func (s *subscriptionService) PauseSubscription(ctx context.Context, req *connect.Request[subscriptionv1.PauseSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error) {
+	return s.store.PauseSubscription(ctx, req)
+}
+
+func (s *subscriptionService) ResumeSubscription(ctx context.Context, req *connect.Request[subscriptionv1.ResumeSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error) {
+	return s.store.ResumeSubscription(ctx, req)
+}
+
+func (s *subscriptionService) CancelSubscription(ctx context.Context, req *connect.Request[subscriptionv1.CancelSubscriptionRequest]) (*connect.Response[subscriptionv1.Subscription], error) {
+	return s.store.CancelSubscription(ctx, req)
+}
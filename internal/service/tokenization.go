@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	tokenizationv1 "github.com/grpc-buf/internal/gen/proto/tokenization"
+	"github.com/grpc-buf/internal/postgres"
+)
+
+// TokenizationService exposes the card vault as Connect handlers. It is
+// deliberately not offered over MCP: raw card data is only accepted here.
+type TokenizationService interface {
+	TokenizeCard(ctx context.Context, req *connect.Request[tokenizationv1.TokenizeCardRequest]) (*connect.Response[tokenizationv1.CardToken], error)
+}
+
+type tokenizationService struct {
+	store postgres.DataStore
+}
+
+// NewTokenizationService returns a TokenizationService backed by the given
+// DataStore.
+func NewTokenizationService(data postgres.DataStore) TokenizationService {
+	return &tokenizationService{store: data}
+}
+
+func (s *tokenizationService) TokenizeCard(ctx context.Context, req *connect.Request[tokenizationv1.TokenizeCardRequest]) (*connect.Response[tokenizationv1.CardToken], error) {
+	return s.store.TokenizeCard(ctx, req)
+}
@@ -11,6 +11,9 @@ import (
 // UserService exposes registration and login as Connect handlers.
 type UserService interface {
 	LoginUser(ctx context.Context, req *connect.Request[userv1.LoginRequest]) (*connect.Response[userv1.LoginResponse], error)
+	RefreshToken(ctx context.Context, req *connect.Request[userv1.RefreshTokenRequest]) (*connect.Response[userv1.LoginResponse], error)
+	LogoutUser(ctx context.Context, req *connect.Request[userv1.LogoutRequest]) (*connect.Response[userv1.LogoutResponse], error)
+	RevokeUserSessions(ctx context.Context, req *connect.Request[userv1.RevokeUserSessionsRequest]) (*connect.Response[userv1.RevokeUserSessionsResponse], error)
 	RegisterUser(ctx context.Context, req *connect.Request[userv1.RegisterRequest]) (*connect.Response[userv1.RegisterResponse], error)
 }
 
@@ -27,6 +30,18 @@ func (s *userService) LoginUser(ctx context.Context, req *connect.Request[userv1
 	return s.store.LoginUser(ctx, req)
 }
 
+func (s *userService) RefreshToken(ctx context.Context, req *connect.Request[userv1.RefreshTokenRequest]) (*connect.Response[userv1.LoginResponse], error) {
+	return s.store.RefreshToken(ctx, req)
+}
+
+func (s *userService) LogoutUser(ctx context.Context, req *connect.Request[userv1.LogoutRequest]) (*connect.Response[userv1.LogoutResponse], error) {
+	return s.store.LogoutUser(ctx, req)
+}
+
+func (s *userService) RevokeUserSessions(ctx context.Context, req *connect.Request[userv1.RevokeUserSessionsRequest]) (*connect.Response[userv1.RevokeUserSessionsResponse], error) {
+	return s.store.RevokeUserSessions(ctx, req)
+}
+
 func (s *userService) RegisterUser(ctx context.Context, req *connect.Request[userv1.RegisterRequest]) (*connect.Response[userv1.RegisterResponse], error) {
 	return s.store.RegisterUser(ctx, req)
 }
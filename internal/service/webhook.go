@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	webhookv1 "github.com/grpc-buf/internal/gen/proto/webhook"
+	"github.com/grpc-buf/internal/postgres"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// WebhookService exposes webhook endpoint registration and the delivery log
+// as Connect handlers.
+type WebhookService interface {
+	CreateWebhookEndpoint(ctx context.Context, req *connect.Request[webhookv1.CreateWebhookEndpointRequest]) (*connect.Response[webhookv1.WebhookEndpoint], error)
+	ListWebhookEndpoints(ctx context.Context, req *connect.Request[webhookv1.ListWebhookEndpointsRequest]) (*connect.Response[webhookv1.ListWebhookEndpointsResponse], error)
+	DeleteWebhookEndpoint(ctx context.Context, req *connect.Request[webhookv1.DeleteWebhookEndpointRequest]) (*connect.Response[emptypb.Empty], error)
+	ListWebhookDeliveries(ctx context.Context, req *connect.Request[webhookv1.ListWebhookDeliveriesRequest]) (*connect.Response[webhookv1.ListWebhookDeliveriesResponse], error)
+	ReplayWebhookDelivery(ctx context.Context, req *connect.Request[webhookv1.ReplayWebhookDeliveryRequest]) (*connect.Response[webhookv1.WebhookDelivery], error)
+}
+
+type webhookService struct {
+	store postgres.DataStore
+}
+
+// NewWebhookService returns a WebhookService backed by the given DataStore.
+func NewWebhookService(data postgres.DataStore) WebhookService {
+	return &webhookService{store: data}
+}
+
+func (s *webhookService) CreateWebhookEndpoint(ctx context.Context, req *connect.Request[webhookv1.CreateWebhookEndpointRequest]) (*connect.Response[webhookv1.WebhookEndpoint], error) {
+	return s.store.CreateWebhookEndpoint(ctx, req)
+}
+
+func (s *webhookService) ListWebhookEndpoints(ctx context.Context, req *connect.Request[webhookv1.ListWebhookEndpointsRequest]) (*connect.Response[webhookv1.ListWebhookEndpointsResponse], error) {
+	return s.store.ListWebhookEndpoints(ctx, req)
+}
+
+func (s *webhookService) DeleteWebhookEndpoint(ctx context.Context, req *connect.Request[webhookv1.DeleteWebhookEndpointRequest]) (*connect.Response[emptypb.Empty], error) {
+	return s.store.DeleteWebhookEndpoint(ctx, req)
+}
+
+func (s *webhookService) ListWebhookDeliveries(ctx context.Context, req *connect.Request[webhookv1.ListWebhookDeliveriesRequest]) (*connect.Response[webhookv1.ListWebhookDeliveriesResponse], error) {
+	return s.store.ListWebhookDeliveries(ctx, req)
+}
+
+func (s *webhookService) ReplayWebhookDelivery(ctx context.Context, req *connect.Request[webhookv1.ReplayWebhookDeliveryRequest]) (*connect.Response[webhookv1.WebhookDelivery], error) {
+	return s.store.ReplayWebhookDelivery(ctx, req)
+}
@@ -0,0 +1,98 @@
+// Package subscription computes subscription billing dates and runs the
+// Scheduler that invoices subscriptions at each period boundary and pays the
+// invoices of subscriptions with a stored card token.
+//
+// Dates are UTC calendar days at midnight. Invoices are issued in advance, on
+// the anchor day that starts each period.
+package subscription
+
+import (
+	"errors"
+	"time"
+
+	"github.com/grpc-buf/internal/fx"
+	subscriptionv1 "github.com/grpc-buf/internal/gen/proto/subscription"
+)
+
+// ErrInvalidAnchor reports an anchor day outside the interval's range.
+var ErrInvalidAnchor = errors.New("anchor day out of range")
+
+// DefaultAnchor returns the anchor day implied by start: its ISO weekday for
+// weekly intervals and its day of month otherwise.
+func DefaultAnchor(interval subscriptionv1.BillingInterval, start time.Time) int {
+	if interval == subscriptionv1.BillingInterval_BILLING_INTERVAL_WEEKLY {
+		return isoWeekday(start)
+	}
+	return start.Day()
+}
+
+// ValidateAnchor checks anchor against interval: 1-7 for weekly and 1-31
+// for monthly and yearly.
+func ValidateAnchor(interval subscriptionv1.BillingInterval, anchor int) error {
+	max := 31
+	if interval == subscriptionv1.BillingInterval_BILLING_INTERVAL_WEEKLY {
+		max = 7
+	}
+	if anchor < 1 || anchor > max {
+		return ErrInvalidAnchor
+	}
+	return nil
+}
+
+// FirstBilling returns the first anchor day on or after start. For yearly
+// intervals the anchor month is start's month.
+func FirstBilling(interval subscriptionv1.BillingInterval, anchor int, start time.Time) time.Time {
+	start = fx.Day(start)
+	switch interval {
+	case subscriptionv1.BillingInterval_BILLING_INTERVAL_WEEKLY:
+		return start.AddDate(0, 0, (anchor-isoWeekday(start)+7)%7)
+	case subscriptionv1.BillingInterval_BILLING_INTERVAL_YEARLY:
+		if d := onDay(start.Year(), start.Month(), anchor); !d.Before(start) {
+			return d
+		}
+		return onDay(start.Year()+1, start.Month(), anchor)
+	default:
+		if d := onDay(start.Year(), start.Month(), anchor); !d.Before(start) {
+			return d
+		}
+		return onDay(start.Year(), start.Month()+1, anchor)
+	}
+}
+
+// Next returns the billing date that follows boundary. Month lengths are
+// clamped per period, so a 31st anchor bills on Feb 28 and then Mar 31.
+func Next(interval subscriptionv1.BillingInterval, anchor int, boundary time.Time) time.Time {
+	boundary = fx.Day(boundary)
+	switch interval {
+	case subscriptionv1.BillingInterval_BILLING_INTERVAL_WEEKLY:
+		return boundary.AddDate(0, 0, 7)
+	case subscriptionv1.BillingInterval_BILLING_INTERVAL_YEARLY:
+		return onDay(boundary.Year()+1, boundary.Month(), anchor)
+	default:
+		return onDay(boundary.Year(), boundary.Month()+1, anchor)
+	}
+}
+
+// NextOnOrAfter returns the first billing date after boundary that is on or
+// after day; it is boundary itself when boundary is not before day.
+func NextOnOrAfter(interval subscriptionv1.BillingInterval, anchor int, boundary, day time.Time) time.Time {
+	boundary, day = fx.Day(boundary), fx.Day(day)
+	for boundary.Before(day) {
+		boundary = Next(interval, anchor, boundary)
+	}
+	return boundary
+}
+
+// onDay returns day of the given month, clamped to the month's last day.
+// month may be 13, meaning January of the following year.
+func onDay(year int, month time.Month, day int) time.Time {
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	return time.Date(year, month, min(day, last), 0, 0, 0, 0, time.UTC)
+}
+
+func isoWeekday(t time.Time) int {
+	if wd := int(t.Weekday()); wd != 0 {
+		return wd
+	}
+	return 7
+}
@@ -0,0 +1,83 @@
+package subscription
+
+import (
+	"testing"
+	"time"
+
+	subscriptionv1 "github.com/grpc-buf/internal/gen/proto/subscription"
+)
+
+const (
+	weekly  = subscriptionv1.BillingInterval_BILLING_INTERVAL_WEEKLY
+	monthly = subscriptionv1.BillingInterval_BILLING_INTERVAL_MONTHLY
+	yearly  = subscriptionv1.BillingInterval_BILLING_INTERVAL_YEARLY
+)
+
+func day(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestFirstBilling(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval subscriptionv1.BillingInterval
+		anchor   int
+		start    time.Time
+		want     time.Time
+	}{
+		// 2026-03-04 is a Wednesday.
+		{"weekly same day", weekly, 3, day(2026, 3, 4), day(2026, 3, 4)},
+		{"weekly next monday", weekly, 1, day(2026, 3, 4), day(2026, 3, 9)},
+		{"weekly sunday", weekly, 7, day(2026, 3, 4), day(2026, 3, 8)},
+		{"monthly later this month", monthly, 15, day(2026, 3, 4), day(2026, 3, 15)},
+		{"monthly next month", monthly, 1, day(2026, 3, 4), day(2026, 4, 1)},
+		{"monthly clamped", monthly, 31, day(2026, 2, 10), day(2026, 2, 28)},
+		{"yearly this year", yearly, 20, day(2026, 3, 4), day(2026, 3, 20)},
+		{"yearly next year", yearly, 1, day(2026, 3, 4), day(2027, 3, 1)},
+	}
+	for _, tt := range tests {
+		if got := FirstBilling(tt.interval, tt.anchor, tt.start); !got.Equal(tt.want) {
+			t.Errorf("%s: FirstBilling = %s, want %s", tt.name, got.Format(time.DateOnly), tt.want.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestNextClampsPerPeriod(t *testing.T) {
+	got := []time.Time{FirstBilling(monthly, 31, day(2026, 1, 1))}
+	for range 3 {
+		got = append(got, Next(monthly, 31, got[len(got)-1]))
+	}
+	want := []time.Time{day(2026, 1, 31), day(2026, 2, 28), day(2026, 3, 31), day(2026, 4, 30)}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Fatalf("monthly schedule = %v, want %v", got, want)
+		}
+	}
+	if n := Next(yearly, 29, day(2028, 2, 29)); !n.Equal(day(2029, 2, 28)) {
+		t.Fatalf("yearly leap day next = %s", n)
+	}
+	if n := Next(monthly, 15, day(2026, 12, 15)); !n.Equal(day(2027, 1, 15)) {
+		t.Fatalf("monthly year wrap = %s", n)
+	}
+}
+
+func TestNextOnOrAfter(t *testing.T) {
+	if got := NextOnOrAfter(weekly, 3, day(2026, 3, 4), day(2026, 3, 20)); !got.Equal(day(2026, 3, 25)) {
+		t.Fatalf("NextOnOrAfter = %s", got)
+	}
+	if got := NextOnOrAfter(weekly, 3, day(2026, 3, 25), day(2026, 3, 20)); !got.Equal(day(2026, 3, 25)) {
+		t.Fatalf("NextOnOrAfter future = %s", got)
+	}
+}
+
+func TestAnchor(t *testing.T) {
+	if a := DefaultAnchor(weekly, day(2026, 3, 8)); a != 7 {
+		t.Fatalf("DefaultAnchor(Sunday) = %d", a)
+	}
+	if a := DefaultAnchor(monthly, day(2026, 3, 8)); a != 8 {
+		t.Fatalf("DefaultAnchor(monthly) = %d", a)
+	}
+	if ValidateAnchor(weekly, 8) == nil || ValidateAnchor(monthly, 0) == nil || ValidateAnchor(yearly, 31) != nil {
+		t.Fatal("ValidateAnchor bounds")
+	}
+}
@@ -0,0 +1,201 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grpc-buf/internal/config"
+	"github.com/grpc-buf/internal/fx"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Defaults used when the corresponding SubscriptionsConfig field is unset.
+const (
+	DefaultInterval             = time.Minute
+	DefaultBatchSize            = 50
+	DefaultAutoPayRetryInterval = 5 * time.Minute
+	DefaultAutoPayMaxAttempts   = 5
+)
+
+// Invoiced is an invoice the Store issued for a subscription period.
+type Invoiced struct {
+	SubscriptionID string
+	InvoiceID      string
+}
+
+// AutoPay is a subscription invoice waiting to be paid with the
+// subscription's card.
+type AutoPay struct {
+	SubscriptionID string
+	InvoiceID      string
+	// CardToken is the subscription's current card token; empty when the
+	// card was removed.
+	CardToken string
+	// CardType is the type of the card behind CardToken.
+	CardType paymentv1.CardType
+	// Attempt counts the payment attempts, including the one this claim is
+	// for.
+	Attempt int
+}
+
+// Store is the datastore the Scheduler works on.
+type Store interface {
+	// InvoiceDueSubscriptions issues the invoice for the current period of up
+	// to limit active subscriptions whose next billing date is on or before
+	// today, advances each to its next billing date and returns the issued
+	// invoices. Subscriptions claimed by another replica are skipped. The
+	// invoice of a subscription with a card token is recorded as an AutoPay
+	// in the same transaction.
+	InvoiceDueSubscriptions(ctx context.Context, today time.Time, limit int) ([]Invoiced, error)
+	// ClaimAutoPays returns up to limit recorded AutoPays due at or before
+	// now and postpones each until retryAt, so other runs and replicas skip
+	// it until then.
+	ClaimAutoPays(ctx context.Context, now, retryAt time.Time, limit int) ([]AutoPay, error)
+	// FinishAutoPay forgets the AutoPay of invoiceID.
+	FinishAutoPay(ctx context.Context, invoiceID string) error
+	PayInvoice(ctx context.Context, req *connect.Request[paymentv1.PayInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error)
+}
+
+// Scheduler invoices due subscriptions and pays the invoices of those with a
+// card token. Several schedulers may share one Store.
+type Scheduler struct {
+	store       Store
+	now         func() time.Time
+	interval    time.Duration
+	batchSize   int
+	retry       time.Duration
+	maxAttempts int
+}
+
+// NewScheduler returns a Scheduler for store tuned by cfg that reads the
+// date from now, or from time.Now when now is nil. Invalid or unset
+// intervals fall back to the defaults; config.Validate rejects them at
+// startup.
+func NewScheduler(store Store, cfg config.SubscriptionsConfig, now func() time.Time) *Scheduler {
+	if now == nil {
+		now = time.Now
+	}
+	s := &Scheduler{
+		store:       store,
+		now:         now,
+		interval:    DefaultInterval,
+		batchSize:   DefaultBatchSize,
+		retry:       DefaultAutoPayRetryInterval,
+		maxAttempts: DefaultAutoPayMaxAttempts,
+	}
+	if d, err := time.ParseDuration(strings.TrimSpace(cfg.Interval)); err == nil && d > 0 {
+		s.interval = d
+	}
+	if cfg.BatchSize > 0 {
+		s.batchSize = cfg.BatchSize
+	}
+	if d, err := time.ParseDuration(strings.TrimSpace(cfg.AutoPayRetryInterval)); err == nil && d > 0 {
+		s.retry = d
+	}
+	if cfg.AutoPayMaxAttempts > 0 {
+		s.maxAttempts = cfg.AutoPayMaxAttempts
+	}
+	return s
+}
+
+// Run executes the scheduler every interval until ctx is cancelled.
+func (s *Scheduler) Run(ctx context.Context) {
+	slog.Info("Starting subscription scheduler", "interval", s.interval)
+	t := time.NewTicker(s.interval)
+	defer t.Stop()
+	for {
+		if _, err := s.RunOnce(ctx); err != nil {
+			slog.Error("subscription run failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// RunOnce issues every invoice due today, draining full batches back to
+// back so a subscription that missed several periods is caught up, and then
+// attempts payment of every recorded AutoPay that is due, including those
+// left by earlier runs. It returns the invoices issued.
+func (s *Scheduler) RunOnce(ctx context.Context) ([]Invoiced, error) {
+	now := s.now()
+	today := fx.Day(now)
+	var all []Invoiced
+	for {
+		batch, err := s.store.InvoiceDueSubscriptions(ctx, today, s.batchSize)
+		if err != nil {
+			return all, fmt.Errorf("invoice due subscriptions: %w", err)
+		}
+		for _, inv := range batch {
+			slog.Info("Subscription invoiced", "subscription_id", inv.SubscriptionID, "invoice_id", inv.InvoiceID)
+		}
+		all = append(all, batch...)
+		if len(batch) < s.batchSize {
+			break
+		}
+	}
+	for {
+		batch, err := s.store.ClaimAutoPays(ctx, now, now.Add(s.retry), s.batchSize)
+		if err != nil {
+			return all, fmt.Errorf("claim subscription payments: %w", err)
+		}
+		for _, p := range batch {
+			s.pay(ctx, p)
+		}
+		if len(batch) < s.batchSize {
+			return all, nil
+		}
+	}
+}
+
+// pay charges p's card under an idempotency key derived from the invoice. A
+// payment that committed is replayed rather than charged again, so finishing
+// an AutoPay may safely fail; a charge whose transaction did not commit was
+// voided or refunded. A decline, a fraud deny or an invoice that is no longer
+// open is final and leaves the invoice to dunning. Any other failure is retried
+// after the retry interval, up to the attempt limit.
+func (s *Scheduler) pay(ctx context.Context, p AutoPay) {
+	if p.CardToken != "" {
+		_, err := s.store.PayInvoice(ctx, connect.NewRequest(&paymentv1.PayInvoiceRequest{
+			Id:             p.InvoiceID,
+			CardToken:      p.CardToken,
+			Card:           p.CardType,
+			IdempotencyKey: "subscription-" + p.InvoiceID,
+		}))
+		switch {
+		case err == nil:
+			slog.Info("Subscription invoice paid", "subscription_id", p.SubscriptionID, "invoice_id", p.InvoiceID)
+		case retryable(err) && p.Attempt < s.maxAttempts:
+			slog.Warn("Subscription invoice payment failed; will retry", "subscription_id", p.SubscriptionID,
+				"invoice_id", p.InvoiceID, "attempt", p.Attempt, "error", err)
+			return
+		default:
+			slog.Warn("Subscription invoice payment failed", "subscription_id", p.SubscriptionID,
+				"invoice_id", p.InvoiceID, "attempt", p.Attempt, "error", err)
+		}
+	}
+	if err := s.store.FinishAutoPay(ctx, p.InvoiceID); err != nil {
+		slog.Error("subscription payment finish failed", "error", err, "invoice_id", p.InvoiceID)
+	}
+}
+
+// retryable reports whether a PayInvoice error may succeed on a later
+// attempt. Declines and invoices that cannot be paid return
+// FailedPrecondition, NotFound or InvalidArgument, a fraud deny returns
+// PermissionDenied and a reused idempotency key AlreadyExists; none of these
+// are retried.
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.FailedPrecondition, codes.NotFound, codes.InvalidArgument, codes.PermissionDenied, codes.AlreadyExists:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,159 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grpc-buf/internal/config"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeStore has one subscription billed every 7 days from next, with a card
+// token. It issues at most limit invoices per call and records an AutoPay
+// for each; PayInvoice fails with fail when it is set.
+type fakeStore struct {
+	next    time.Time
+	issued  int
+	days    []time.Time
+	paid    []*paymentv1.PayInvoiceRequest
+	fail    error
+	pending map[string]*fakeAutoPay
+}
+
+type fakeAutoPay struct {
+	attempts int
+	due      time.Time
+}
+
+func (f *fakeStore) InvoiceDueSubscriptions(_ context.Context, today time.Time, limit int) ([]Invoiced, error) {
+	f.days = append(f.days, today)
+	if f.pending == nil {
+		f.pending = map[string]*fakeAutoPay{}
+	}
+	var out []Invoiced
+	for len(out) < limit && !f.next.After(today) {
+		f.issued++
+		id := f.next.Format(time.DateOnly)
+		out = append(out, Invoiced{SubscriptionID: "sub", InvoiceID: id})
+		f.pending[id] = &fakeAutoPay{}
+		f.next = f.next.AddDate(0, 0, 7)
+	}
+	return out, nil
+}
+
+func (f *fakeStore) ClaimAutoPays(_ context.Context, now, retryAt time.Time, limit int) ([]AutoPay, error) {
+	var out []AutoPay
+	for id, p := range f.pending {
+		if len(out) == limit || p.due.After(now) {
+			continue
+		}
+		p.attempts++
+		p.due = retryAt
+		out = append(out, AutoPay{SubscriptionID: "sub", InvoiceID: id, CardToken: "tok_sub",
+			CardType: paymentv1.CardType_CARD_TYPE_DEBIT, Attempt: p.attempts})
+	}
+	return out, nil
+}
+
+func (f *fakeStore) FinishAutoPay(_ context.Context, invoiceID string) error {
+	delete(f.pending, invoiceID)
+	return nil
+}
+
+func (f *fakeStore) PayInvoice(_ context.Context, req *connect.Request[paymentv1.PayInvoiceRequest]) (*connect.Response[paymentv1.Invoice], error) {
+	f.paid = append(f.paid, req.Msg)
+	if f.fail != nil {
+		return nil, f.fail
+	}
+	return connect.NewResponse(&paymentv1.Invoice{Id: req.Msg.GetId(), Paid: true}), nil
+}
+
+func TestRunOnceCatchesUpAndPays(t *testing.T) {
+	clock := day(2026, 3, 22).Add(13 * time.Hour)
+	store := &fakeStore{next: day(2026, 3, 1)}
+	s := NewScheduler(store, config.SubscriptionsConfig{BatchSize: 2}, func() time.Time { return clock })
+
+	issued, err := s.RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("RunOnce: %v", err)
+	}
+	// Mar 1, 8, 15 and 22 are due on Mar 22.
+	if len(issued) != 4 || store.issued != 4 {
+		t.Fatalf("issued %d invoices, want 4", len(issued))
+	}
+	if !store.days[0].Equal(day(2026, 3, 22)) {
+		t.Fatalf("today = %s", store.days[0])
+	}
+	if len(store.paid) != 4 || store.paid[0].GetCardToken() != "tok_sub" ||
+		store.paid[0].GetCard() != paymentv1.CardType_CARD_TYPE_DEBIT || len(store.pending) != 0 {
+		t.Fatalf("payments = %v, pending %d", store.paid, len(store.pending))
+	}
+	for _, p := range store.paid {
+		if p.GetIdempotencyKey() != "subscription-"+p.GetId() {
+			t.Fatalf("idempotency key %q for invoice %s", p.GetIdempotencyKey(), p.GetId())
+		}
+	}
+
+	// Nothing more is due until the clock reaches the next boundary.
+	if issued, _ := s.RunOnce(context.Background()); len(issued) != 0 {
+		t.Fatalf("second run issued %d", len(issued))
+	}
+	clock = clock.AddDate(0, 0, 7)
+	store.fail = status.Error(codes.FailedPrecondition, "card declined")
+	issued, err = s.RunOnce(context.Background())
+	if err != nil || len(issued) != 1 {
+		t.Fatalf("declined payment run = %v, %v; want one invoice and no error", issued, err)
+	}
+	if len(store.pending) != 0 {
+		t.Fatal("a declined payment was kept for retry")
+	}
+}
+
+func TestRunOnceRetriesFailedPayments(t *testing.T) {
+	clock := day(2026, 3, 1).Add(9 * time.Hour)
+	store := &fakeStore{next: day(2026, 3, 1), fail: errors.New("connection reset")}
+	s := NewScheduler(store, config.SubscriptionsConfig{AutoPayRetryInterval: "10m", AutoPayMaxAttempts: 3},
+		func() time.Time { return clock })
+	run := func() {
+		t.Helper()
+		if _, err := s.RunOnce(context.Background()); err != nil {
+			t.Fatalf("RunOnce: %v", err)
+		}
+	}
+
+	run()
+	if len(store.paid) != 1 || len(store.pending) != 1 {
+		t.Fatalf("first run: %d attempts, %d pending; want 1 and 1", len(store.paid), len(store.pending))
+	}
+	// Not retried before the retry interval.
+	clock = clock.Add(5 * time.Minute)
+	run()
+	if len(store.paid) != 1 {
+		t.Fatalf("retried after 5m: %d attempts", len(store.paid))
+	}
+	clock = clock.Add(5 * time.Minute)
+	store.fail = nil
+	run()
+	if len(store.paid) != 2 || len(store.pending) != 0 {
+		t.Fatalf("retry: %d attempts, %d pending; want 2 and 0", len(store.paid), len(store.pending))
+	}
+	if store.paid[1].GetIdempotencyKey() != store.paid[0].GetIdempotencyKey() {
+		t.Fatal("retry used a different idempotency key")
+	}
+
+	// After the last attempt the invoice is left to dunning.
+	clock = clock.AddDate(0, 0, 7)
+	store.fail = status.Error(codes.Unavailable, "payment processor unavailable")
+	for range 5 {
+		run()
+		clock = clock.Add(10 * time.Minute)
+	}
+	if len(store.paid) != 5 || len(store.pending) != 0 {
+		t.Fatalf("gave up after %d attempts with %d pending; want 3 more and 0", len(store.paid)-2, len(store.pending))
+	}
+}
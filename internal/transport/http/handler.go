@@ -1,6 +1,7 @@
 package httptransport
 
 import (
+	"fmt"
 	"log/slog"
 	"net/http"
 
@@ -10,44 +11,77 @@ import (
 	"github.com/grpc-buf/internal/gen/proto/expense/expensev1connect"
 	"github.com/grpc-buf/internal/gen/proto/payment/paymentv1connect"
 	"github.com/grpc-buf/internal/gen/proto/registration/userv1connect"
+	"github.com/grpc-buf/internal/gen/proto/subscription/subscriptionv1connect"
+	"github.com/grpc-buf/internal/gen/proto/tokenization/tokenizationv1connect"
+	"github.com/grpc-buf/internal/gen/proto/webhook/webhookv1connect"
 	"github.com/grpc-buf/internal/service"
+	"github.com/grpc-buf/internal/transport/middleware/grpcstatus"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 )
 
+// ServiceNames lists the services NewMuxWithInterceptors registers.
+var ServiceNames = []string{
+	paymentv1connect.PaymentServiceName,
+	expensev1connect.ExpenseServiceName,
+	userv1connect.UserServiceName,
+	webhookv1connect.WebhookServiceName,
+	subscriptionv1connect.SubscriptionServiceName,
+	tokenizationv1connect.TokenizationServiceName,
+}
+
+// ServiceDescriptors returns the descriptors of ServiceNames, e.g. to check
+// configuration that names procedures.
+func ServiceDescriptors() ([]protoreflect.ServiceDescriptor, error) {
+	services := make([]protoreflect.ServiceDescriptor, 0, len(ServiceNames))
+	for _, name := range ServiceNames {
+		d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return nil, fmt.Errorf("service %s: %w", name, err)
+		}
+		sd, ok := d.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("service %s: not a service", name)
+		}
+		services = append(services, sd)
+	}
+	return services, nil
+}
+
 // NewMux wires RPC handlers and returns an http.ServeMux.
-func NewMux(payment service.PaymentService, user service.UserService, expense service.ExpenseService) *http.ServeMux {
-	return NewMuxWithInterceptors(payment, user, expense)
+func NewMux(payment service.PaymentService, user service.UserService, expense service.ExpenseService, webhook service.WebhookService, subscription service.SubscriptionService, tokenization service.TokenizationService) *http.ServeMux {
+	return NewMuxWithInterceptors(payment, user, expense, webhook, subscription, tokenization)
 }
 
 // NewMuxWithInterceptors wires RPC handlers with optional unary interceptors
-// (e.g. rate limiting, auth).
+// (e.g. rate limiting, auth). gRPC status errors returned by the services are
+// always translated to Connect errors so clients see the intended code.
 func NewMuxWithInterceptors(
 	payment service.PaymentService,
 	user service.UserService,
 	expense service.ExpenseService,
+	webhook service.WebhookService,
+	subscription service.SubscriptionService,
+	tokenization service.TokenizationService,
 	interceptors ...connect.Interceptor,
 ) *http.ServeMux {
 	mux := http.NewServeMux()
 	compress1KB := connect.WithCompressMinBytes(1024)
-	opts := []connect.HandlerOption{compress1KB}
-	if len(interceptors) > 0 {
-		opts = append(opts, connect.WithInterceptors(interceptors...))
+	opts := []connect.HandlerOption{
+		compress1KB,
+		connect.WithInterceptors(append([]connect.Interceptor{grpcstatus.NewInterceptor()}, interceptors...)...),
 	}
 	mux.Handle(paymentv1connect.NewPaymentServiceHandler(payment, opts...))
 	mux.Handle(expensev1connect.NewExpenseServiceHandler(expense, opts...))
 	mux.Handle(userv1connect.NewUserServiceHandler(user, opts...))
+	mux.Handle(webhookv1connect.NewWebhookServiceHandler(webhook, opts...))
+	mux.Handle(subscriptionv1connect.NewSubscriptionServiceHandler(subscription, opts...))
+	mux.Handle(tokenizationv1connect.NewTokenizationServiceHandler(tokenization, opts...))
 
-	checker := grpchealth.NewStaticChecker(
-		paymentv1connect.PaymentServiceName,
-		expensev1connect.ExpenseServiceName,
-		userv1connect.UserServiceName,
-	)
+	checker := grpchealth.NewStaticChecker(ServiceNames...)
 	mux.Handle(grpchealth.NewHandler(checker, compress1KB))
 
-	reflector := grpcreflect.NewStaticReflector(
-		paymentv1connect.PaymentServiceName,
-		expensev1connect.ExpenseServiceName,
-		userv1connect.UserServiceName,
-	)
+	reflector := grpcreflect.NewStaticReflector(ServiceNames...)
 	mux.Handle(grpcreflect.NewHandlerV1(reflector, compress1KB))
 	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector, compress1KB))
 
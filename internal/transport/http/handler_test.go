@@ -0,0 +1,28 @@
+package httptransport
+
+import (
+	"testing"
+
+	"github.com/grpc-buf/internal/config"
+	"github.com/grpc-buf/internal/security"
+)
+
+func TestShippedPoliciesCoverServices(t *testing.T) {
+	services, err := ServiceDescriptors()
+	if err != nil {
+		t.Fatalf("ServiceDescriptors: %v", err)
+	}
+	if len(services) != len(ServiceNames) {
+		t.Fatalf("got %d descriptors, want %d", len(services), len(ServiceNames))
+	}
+	for _, path := range []string{"", "../../../config/local.yaml", "../../../config/production.yaml"} {
+		cfg, err := config.Load(path)
+		if err != nil {
+			t.Fatalf("Load(%q): %v", path, err)
+		}
+		policy := security.NewPolicyFromConfig(cfg.Security.Authorization)
+		if err := policy.Validate(services, cfg.Security.AuthSkipSuffixes); err != nil {
+			t.Fatalf("policy of %q: %v", path, err)
+		}
+	}
+}
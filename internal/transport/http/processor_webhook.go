@@ -0,0 +1,82 @@
+package httptransport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/grpc-buf/internal/processor"
+	"github.com/grpc-buf/internal/webhook"
+)
+
+// ProcessorWebhookPath is where the payment provider POSTs its events.
+const ProcessorWebhookPath = "/webhooks/processor"
+
+// maxProcessorEventBytes caps the size of a provider event body.
+const maxProcessorEventBytes = 1 << 20
+
+// ProcessorEventStore records and applies provider events.
+type ProcessorEventStore interface {
+	ApplyProcessorEvent(ctx context.Context, ev processor.Event) (processor.EventStatus, error)
+}
+
+type processorWebhookHandler struct {
+	store     ProcessorEventStore
+	secret    []byte
+	tolerance time.Duration
+	now       func() time.Time
+}
+
+// NewProcessorWebhookHandler returns the provider event endpoint. Requests
+// must carry the webhook.HeaderTimestamp and webhook.HeaderSignature headers
+// signed with secret; stale or unsigned requests get 401. Accepted events,
+// including duplicates and ones that could not be applied, get 200 so the
+// provider stops redelivering them; storage failures get 500.
+func NewProcessorWebhookHandler(store ProcessorEventStore, secret []byte, tolerance time.Duration) http.Handler {
+	return &processorWebhookHandler{store: store, secret: secret, tolerance: tolerance, now: time.Now}
+}
+
+func (h *processorWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxProcessorEventBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "event too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "failed to read event", http.StatusBadRequest)
+		return
+	}
+	if err := webhook.Verify(h.secret, r.Header.Get(webhook.HeaderTimestamp), r.Header.Get(webhook.HeaderSignature),
+		body, h.tolerance, h.now()); err != nil {
+		slog.Warn("processor webhook rejected", "error", err, "remote", r.RemoteAddr)
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+	ev, err := processor.ParseEvent(body)
+	if err != nil {
+		http.Error(w, "invalid event: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.store.ApplyProcessorEvent(r.Context(), ev)
+	if err != nil {
+		slog.Error("processor event failed", "error", err, "event_id", ev.ID, "type", ev.Type)
+		http.Error(w, "failed to process event", http.StatusInternalServerError)
+		return
+	}
+	slog.Info("Processor event received", "event_id", ev.ID, "type", ev.Type, "status", string(result))
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(map[string]string{"status": string(result)}); err != nil {
+		slog.Debug("processor webhook write failed", "error", err)
+	}
+}
@@ -0,0 +1,100 @@
+package httptransport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-buf/internal/processor"
+	"github.com/grpc-buf/internal/webhook"
+)
+
+type fakeEventStore struct {
+	seen   map[string]bool
+	events []processor.Event
+	err    error
+}
+
+func (f *fakeEventStore) ApplyProcessorEvent(_ context.Context, ev processor.Event) (processor.EventStatus, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+	if f.seen[ev.ID] {
+		return processor.EventDuplicate, nil
+	}
+	f.seen[ev.ID] = true
+	f.events = append(f.events, ev)
+	if ev.Type != processor.EventPaymentSettled {
+		return processor.EventUnhandled, nil
+	}
+	return processor.EventApplied, nil
+}
+
+func TestProcessorWebhookHandler(t *testing.T) {
+	secret := []byte("whsec_provider")
+	now := time.Unix(1_700_000_000, 0)
+	settled := `{"id":"evt_1","type":"payment.settled","data":{"processor_ref":"fake_1"}}`
+
+	signed := func(body string, at time.Time) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, ProcessorWebhookPath, strings.NewReader(body))
+		r.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(at.Unix(), 10))
+		r.Header.Set(webhook.HeaderSignature, webhook.Sign(secret, at, []byte(body)))
+		return r
+	}
+
+	tests := []struct {
+		name     string
+		req      func() *http.Request
+		storeErr error
+		wantCode int
+		wantBody string
+	}{
+		{name: "applied", req: func() *http.Request { return signed(settled, now) }, wantCode: http.StatusOK, wantBody: `"applied"`},
+		{name: "duplicate", req: func() *http.Request { return signed(settled, now) }, wantCode: http.StatusOK, wantBody: `"duplicate"`},
+		{name: "unknown type stored", req: func() *http.Request {
+			return signed(`{"id":"evt_2","type":"payout.created","data":{"x":1}}`, now)
+		}, wantCode: http.StatusOK, wantBody: `"unhandled"`},
+		{name: "stale", req: func() *http.Request { return signed(settled, now.Add(-10*time.Minute)) }, wantCode: http.StatusUnauthorized},
+		{name: "unsigned", req: func() *http.Request {
+			return httptest.NewRequest(http.MethodPost, ProcessorWebhookPath, strings.NewReader(settled))
+		}, wantCode: http.StatusUnauthorized},
+		{name: "tampered", req: func() *http.Request {
+			r := signed(settled, now)
+			r.Body = http.NoBody
+			return r
+		}, wantCode: http.StatusUnauthorized},
+		{name: "missing id", req: func() *http.Request { return signed(`{"type":"payment.settled"}`, now) }, wantCode: http.StatusBadRequest},
+		{name: "wrong method", req: func() *http.Request { return httptest.NewRequest(http.MethodGet, ProcessorWebhookPath, nil) }, wantCode: http.StatusMethodNotAllowed},
+		{name: "store failure", req: func() *http.Request {
+			return signed(`{"id":"evt_3","type":"payment.settled"}`, now)
+		}, storeErr: errors.New("db down"), wantCode: http.StatusInternalServerError},
+	}
+
+	store := &fakeEventStore{seen: map[string]bool{}}
+	h := NewProcessorWebhookHandler(store, secret, 5*time.Minute).(*processorWebhookHandler)
+	h.now = func() time.Time { return now }
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store.err = tt.storeErr
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, tt.req())
+			if w.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d (body %q)", w.Code, tt.wantCode, w.Body.String())
+			}
+			if tt.wantBody != "" && !strings.Contains(w.Body.String(), tt.wantBody) {
+				t.Fatalf("body = %q, want it to contain %s", w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+	if len(store.events) != 2 {
+		t.Fatalf("store received %d new events, want 2", len(store.events))
+	}
+	if got := string(store.events[1].Raw); !strings.Contains(got, "payout.created") {
+		t.Fatalf("raw body not kept for unknown event: %q", got)
+	}
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/grpc-buf/internal/auth"
 	expensev1mcp "github.com/grpc-buf/internal/gen/proto/expense/expensev1mcp"
 	paymentv1mcp "github.com/grpc-buf/internal/gen/proto/payment/paymentv1mcp"
 	userv1mcp "github.com/grpc-buf/internal/gen/proto/registration/userv1mcp"
+	subscriptionv1mcp "github.com/grpc-buf/internal/gen/proto/subscription/subscriptionv1mcp"
+	webhookv1mcp "github.com/grpc-buf/internal/gen/proto/webhook/webhookv1mcp"
 	"github.com/grpc-buf/internal/postgres"
+	"github.com/grpc-buf/internal/security"
 	"github.com/grpc-buf/internal/service"
 	mcpadapter "github.com/grpc-buf/internal/service/mcp"
 	"github.com/grpc-buf/internal/version"
@@ -17,6 +21,11 @@ import (
 
 const serverName = "grpc-buf-mcp-server"
 
+// operator is the caller every MCP tool runs as. The stdio transport is only
+// reachable by whoever started the process, who already holds the database
+// credentials, so it acts as an admin without a subject of its own.
+var operator = auth.User{Roles: []string{security.RoleAdmin}}
+
 // Server exposes the Connect services as MCP tools over stdio. The underlying
 // *mcp.Server is retained so Serve can drive its own transport loop.
 type Server struct {
@@ -27,18 +36,31 @@ type Server struct {
 // modelcontextprotocol/go-sdk via the protoc-gen-go-mcp gosdk adapter.
 func NewServer(dataStore postgres.DataStore) (*Server, error) {
 	raw, registrar := gosdk.NewServer(serverName, version.Get().Version)
+	raw.AddReceivingMiddleware(func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			return next(auth.WithUser(ctx, operator), method, req)
+		}
+	})
 
 	expenseSvc := service.NewExpenseService(dataStore)
 	userSvc := service.NewUserService(dataStore)
 	paymentSvc := service.NewPaymentService(dataStore)
+	webhookSvc := service.NewWebhookService(dataStore)
+	subscriptionSvc := service.NewSubscriptionService(dataStore)
 
 	expenseAdapter := mcpadapter.NewExpenseServiceAdapter(expenseSvc)
 	userAdapter := mcpadapter.NewUserServiceAdapter(userSvc)
 	paymentAdapter := mcpadapter.NewPaymentServiceAdapter(paymentSvc)
+	webhookAdapter := mcpadapter.NewWebhookServiceAdapter(webhookSvc)
+	subscriptionAdapter := mcpadapter.NewSubscriptionServiceAdapter(subscriptionSvc)
 
 	expensev1mcp.RegisterExpenseServiceHandler(registrar, expenseAdapter)
 	userv1mcp.RegisterUserServiceHandler(registrar, userAdapter)
 	paymentv1mcp.RegisterPaymentServiceHandler(registrar, paymentAdapter)
+	webhookv1mcp.RegisterWebhookServiceHandler(registrar, webhookAdapter)
+	subscriptionv1mcp.RegisterSubscriptionServiceHandler(registrar, subscriptionAdapter)
+	// TokenizationService is not offered: raw card numbers are only accepted
+	// over the Connect API, so no MCP stubs are generated for it.
 
 	slog.Info("MCP server initialized with all service handlers")
 
@@ -2,40 +2,44 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"log/slog"
+	"net/http"
 	"strings"
 
 	"connectrpc.com/connect"
+	"github.com/grpc-buf/internal/auth"
 	"github.com/grpc-buf/internal/security"
 )
 
+// errRevoked is returned for verified tokens found on the denylist.
+var errRevoked = errors.New("token revoked")
+
 type JWTAuthInterceptor struct {
 	v      *security.Verifier
+	deny   security.Denylist
 	skip   map[string]bool
 	header string
 }
 
 // NewJWTAuthInterceptor creates an interceptor that validates Bearer tokens
 // for all RPCs except those with procedures listed in skipSuffixes, which are
-// matched by HasSuffix (e.g., "/LoginUser").
-func NewJWTAuthInterceptor(v *security.Verifier, skipSuffixes []string) connect.Interceptor {
+// matched by HasSuffix (e.g., "/LoginUser"). Verified tokens are rejected
+// when deny lists them as revoked; a nil deny skips the check. Accepted
+// callers are available to handlers through auth.UserFromContext.
+func NewJWTAuthInterceptor(v *security.Verifier, skipSuffixes []string, deny security.Denylist) connect.Interceptor {
 	s := map[string]bool{}
 	for _, suf := range skipSuffixes {
 		s[suf] = true
 	}
-	return &JWTAuthInterceptor{v: v, skip: s, header: "Authorization"}
+	return &JWTAuthInterceptor{v: v, deny: deny, skip: s, header: "Authorization"}
 }
 
 func (i *JWTAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-		if i.shouldSkip(req.Spec().Procedure) {
-			return next(ctx, req)
-		}
-		token := bearer(req.Header().Get(i.header))
-		if token == "" {
-			return nil, connect.NewError(connect.CodeUnauthenticated, nil)
-		}
-		if _, err := i.v.Verify(token); err != nil {
-			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		ctx, err := i.authenticate(ctx, req.Spec().Procedure, req.Header())
+		if err != nil {
+			return nil, err
 		}
 		return next(ctx, req)
 	}
@@ -44,8 +48,44 @@ func (i *JWTAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc
 func (i *JWTAuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
 	return next
 }
+
 func (i *JWTAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
-	return next
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authenticate(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+// authenticate verifies the bearer token in h for procedure and returns ctx
+// carrying the caller. Skipped procedures pass through unchanged.
+func (i *JWTAuthInterceptor) authenticate(ctx context.Context, procedure string, h http.Header) (context.Context, error) {
+	if i.shouldSkip(procedure) {
+		return ctx, nil
+	}
+	token := security.BearerToken(h.Get(i.header))
+	if token == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+	claims, err := i.v.Verify(token)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if i.deny != nil {
+		denied, err := i.deny.Denied(ctx, claims)
+		if err != nil {
+			// Fail closed: a revoked token must not pass while the
+			// denylist is unreachable.
+			slog.Error("token denylist check failed", "error", err)
+			return nil, connect.NewError(connect.CodeUnavailable, errors.New("authentication temporarily unavailable"))
+		}
+		if denied {
+			return nil, connect.NewError(connect.CodeUnauthenticated, errRevoked)
+		}
+	}
+	return auth.WithUser(ctx, auth.UserFromClaims(claims)), nil
 }
 
 func (i *JWTAuthInterceptor) shouldSkip(proc string) bool {
@@ -56,14 +96,3 @@ func (i *JWTAuthInterceptor) shouldSkip(proc string) bool {
 	}
 	return false
 }
-
-func bearer(h string) string {
-	if h == "" {
-		return ""
-	}
-	const p = "Bearer "
-	if strings.HasPrefix(h, p) {
-		return strings.TrimSpace(h[len(p):])
-	}
-	return ""
-}
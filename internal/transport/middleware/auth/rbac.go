@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/grpc-buf/internal/auth"
+	"github.com/grpc-buf/internal/security"
+)
+
+// RBACInterceptor enforces a security.Policy on unary and streaming handlers.
+// It reads the caller attached by JWTAuthInterceptor, so it must be installed
+// after it.
+type RBACInterceptor struct {
+	policy *security.Policy
+}
+
+// NewRBACInterceptor creates an interceptor that rejects calls to procedures
+// requiring a permission none of the caller's roles grants. Procedures the
+// policy does not list pass through; Policy.Validate ensures those are only
+// the ones that skip authentication.
+func NewRBACInterceptor(policy *security.Policy) connect.Interceptor {
+	return &RBACInterceptor{policy: policy}
+}
+
+func (i *RBACInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := i.authorize(ctx, req.Spec().Procedure); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *RBACInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *RBACInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := i.authorize(ctx, conn.Spec().Procedure); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (i *RBACInterceptor) authorize(ctx context.Context, procedure string) error {
+	perm, ok := i.policy.Permission(procedure)
+	if !ok {
+		return nil
+	}
+	caller, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+	if !i.policy.Allows(caller.Roles, perm) {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission %q required", perm))
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/grpc-buf/internal/auth"
+	"github.com/grpc-buf/internal/config"
+	"github.com/grpc-buf/internal/security"
+)
+
+func TestRBACAuthorize(t *testing.T) {
+	const revoke = "/rpc.user.v1.UserService/RevokeUserSessions"
+	i := NewRBACInterceptor(security.NewPolicyFromConfig(config.AuthorizationConfig{
+		Roles:      map[string][]string{"admin": {config.PermissionAll}},
+		Procedures: map[string]string{revoke: "sessions.revoke"},
+	})).(*RBACInterceptor)
+
+	user := auth.WithUser(context.Background(), auth.User{Subject: "u1", Roles: []string{"user"}})
+	admin := auth.WithUser(context.Background(), auth.User{Subject: "u2", Roles: []string{"admin"}})
+
+	cases := []struct {
+		name string
+		ctx  context.Context
+		proc string
+		want connect.Code
+	}{
+		{"unlisted procedure", user, "/rpc.user.v1.UserService/LogoutUser", 0},
+		{"no caller", context.Background(), revoke, connect.CodeUnauthenticated},
+		{"missing permission", user, revoke, connect.CodePermissionDenied},
+		{"granted", admin, revoke, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := i.authorize(c.ctx, c.proc)
+			if (err == nil) != (c.want == 0) || err != nil && connect.CodeOf(err) != c.want {
+				t.Fatalf("authorize = %v, want code %v", err, c.want)
+			}
+		})
+	}
+}
+
+func TestShippedPolicyKeepsUsersOffSharedPaymentData(t *testing.T) {
+	const svc = "/rpc.payment.v1.PaymentService/"
+	user := auth.WithUser(context.Background(), auth.User{Subject: "u1", Roles: []string{"user"}})
+	finance := auth.WithUser(context.Background(), auth.User{Subject: "u2", Roles: []string{"user", "finance"}})
+
+	for _, path := range []string{"", "../../../../config/local.yaml", "../../../../config/production.yaml"} {
+		cfg, err := config.Load(path)
+		if err != nil {
+			t.Fatalf("Load(%q): %v", path, err)
+		}
+		i := NewRBACInterceptor(security.NewPolicyFromConfig(cfg.Security.Authorization)).(*RBACInterceptor)
+		for _, rpc := range []string{
+			"GetPayment", "ListPayments", "SummarizePayments", "CapturePayment", "VoidPayment",
+			"CreateInvoice", "GetInvoice", "ListInvoices", "CancelInvoice",
+			"GetRefund", "ListRefunds", "CreateDispute", "GetDispute", "ListDisputes", "AddDisputeEvidence",
+		} {
+			if err := i.authorize(user, svc+rpc); connect.CodeOf(err) != connect.CodePermissionDenied {
+				t.Errorf("%q: user calling %s = %v, want PermissionDenied", path, rpc, err)
+			}
+			if err := i.authorize(finance, svc+rpc); err != nil {
+				t.Errorf("%q: finance calling %s = %v", path, rpc, err)
+			}
+		}
+		for _, rpc := range []string{"MakePayment", "PayInvoice", "PayInvoices"} {
+			if err := i.authorize(user, svc+rpc); err != nil {
+				t.Errorf("%q: user calling %s = %v", path, rpc, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package grpcstatus
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/status"
+)
+
+// NewInterceptor creates a server-side Connect interceptor that converts
+// errors built with google.golang.org/grpc/status (as returned by the store
+// and service layers) into *connect.Error values with the same code, message
+// and details. Connect otherwise reports such errors as CodeUnknown.
+func NewInterceptor() connect.Interceptor {
+	return &interceptor{}
+}
+
+type interceptor struct{}
+
+func (interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		res, err := next(ctx, req)
+		if err != nil {
+			return nil, ToConnect(err)
+		}
+		return res, nil
+	}
+}
+
+func (interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return ToConnect(next(ctx, conn))
+	}
+}
+
+// ToConnect returns err unchanged when it is nil, already a *connect.Error, or
+// not a gRPC status error; otherwise it returns the equivalent *connect.Error.
+func ToConnect(err error) error {
+	if err == nil {
+		return nil
+	}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return err
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	out := connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	for _, d := range st.Proto().GetDetails() {
+		msg, uerr := d.UnmarshalNew()
+		if uerr != nil {
+			slog.Warn("dropping undecodable status detail", "type", d.GetTypeUrl(), "error", uerr)
+			continue
+		}
+		detail, derr := connect.NewErrorDetail(msg)
+		if derr != nil {
+			slog.Warn("dropping status detail", "type", d.GetTypeUrl(), "error", derr)
+			continue
+		}
+		out.AddDetail(detail)
+	}
+	return out
+}
@@ -0,0 +1,57 @@
+package grpcstatus
+
+import (
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToConnectMapsCodeAndMessage(t *testing.T) {
+	err := ToConnect(status.Error(codes.FailedPrecondition, "invoice already paid"))
+	if got := connect.CodeOf(err); got != connect.CodeFailedPrecondition {
+		t.Fatalf("code = %v, want FailedPrecondition", got)
+	}
+	var ce *connect.Error
+	if !errors.As(err, &ce) || ce.Message() != "invoice already paid" {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestToConnectKeepsDetails(t *testing.T) {
+	st, err := status.New(codes.PermissionDenied, "denied").WithDetails(&errdetails.ErrorInfo{Reason: "TEST"})
+	if err != nil {
+		t.Fatalf("with details: %v", err)
+	}
+	var ce *connect.Error
+	if !errors.As(ToConnect(st.Err()), &ce) {
+		t.Fatalf("expected *connect.Error")
+	}
+	if len(ce.Details()) != 1 {
+		t.Fatalf("details = %d, want 1", len(ce.Details()))
+	}
+	v, err := ce.Details()[0].Value()
+	if err != nil {
+		t.Fatalf("detail value: %v", err)
+	}
+	if info, ok := v.(*errdetails.ErrorInfo); !ok || info.GetReason() != "TEST" {
+		t.Fatalf("unexpected detail %v", v)
+	}
+}
+
+func TestToConnectPassThrough(t *testing.T) {
+	if ToConnect(nil) != nil {
+		t.Fatalf("nil must stay nil")
+	}
+	ce := connect.NewError(connect.CodeNotFound, errors.New("x"))
+	if ToConnect(ce) != error(ce) {
+		t.Fatalf("connect errors must pass through unchanged")
+	}
+	plain := errors.New("plain")
+	if ToConnect(plain) != plain {
+		t.Fatalf("non-status errors must pass through unchanged")
+	}
+}
@@ -0,0 +1,155 @@
+// Package vault validates card data and encrypts it for the card_tokens
+// table. Card numbers are sealed with AES-256-GCM under the configured key;
+// the token is the additional data, so a sealed card cannot be moved to
+// another token's row.
+package vault
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TokenPrefix starts every token the vault issues.
+const TokenPrefix = "tok_"
+
+// KeySize is the length in bytes of a vault key.
+const KeySize = 32
+
+// Card is a validated card. It has no CVC: ParseCard checks the CVC and drops
+// it.
+type Card struct {
+	PAN      string
+	ExpMonth int
+	ExpYear  int
+}
+
+// Last4 returns the last four digits of the card number.
+func (c Card) Last4() string {
+	return c.PAN[len(c.PAN)-4:]
+}
+
+// ExpiresAt returns the first instant (UTC) after the card's expiry month.
+func (c Card) ExpiresAt() time.Time {
+	return time.Date(c.ExpYear, time.Month(c.ExpMonth)+1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// ParseCard normalises pan and checks it and the expiry and CVC, as of now.
+// Spaces and dashes in pan are ignored.
+func ParseCard(pan string, expMonth, expYear int, cvc string, now time.Time) (Card, error) {
+	pan = strings.NewReplacer(" ", "", "-", "").Replace(pan)
+	if len(pan) < 12 || len(pan) > 19 || !isDigits(pan) {
+		return Card{}, errors.New("pan must be 12-19 digits")
+	}
+	if !Luhn(pan) {
+		return Card{}, errors.New("pan fails the Luhn check")
+	}
+	if expMonth < 1 || expMonth > 12 {
+		return Card{}, errors.New("exp_month must be 1-12")
+	}
+	if expYear < 1000 || expYear > 9999 {
+		return Card{}, errors.New("exp_year must have four digits")
+	}
+	if cvc = strings.TrimSpace(cvc); (len(cvc) != 3 && len(cvc) != 4) || !isDigits(cvc) {
+		return Card{}, errors.New("cvc must be 3 or 4 digits")
+	}
+	c := Card{PAN: pan, ExpMonth: expMonth, ExpYear: expYear}
+	if !now.Before(c.ExpiresAt()) {
+		return Card{}, errors.New("card has expired")
+	}
+	return c, nil
+}
+
+// Luhn reports whether the digit string s passes the Luhn checksum.
+func Luhn(s string) bool {
+	if s == "" {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(s) - 1; i >= 0; i-- {
+		d := int(s[i] - '0')
+		if d < 0 || d > 9 {
+			return false
+		}
+		if double {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// NewToken returns a fresh random token.
+func NewToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate token: %w", err)
+	}
+	return TokenPrefix + hex.EncodeToString(b), nil
+}
+
+// Cipher seals and opens card numbers.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher returns a Cipher for a base64-encoded KeySize-byte key.
+func NewCipher(key string) (*Cipher, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return nil, errors.New("vault key must be base64")
+	}
+	if len(raw) != KeySize {
+		return nil, fmt.Errorf("vault key must be %d bytes, got %d", KeySize, len(raw))
+	}
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// Seal encrypts pan for token. The nonce is prepended to the result.
+func (c *Cipher) Seal(token, pan string) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	return c.aead.Seal(nonce, nonce, []byte(pan), []byte(token)), nil
+}
+
+// Open decrypts a card number sealed for token.
+func (c *Cipher) Open(token string, sealed []byte) (string, error) {
+	n := c.aead.NonceSize()
+	if len(sealed) < n {
+		return "", errors.New("sealed card is too short")
+	}
+	pan, err := c.aead.Open(nil, sealed[:n], sealed[n:], []byte(token))
+	if err != nil {
+		return "", fmt.Errorf("open sealed card: %w", err)
+	}
+	return string(pan), nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
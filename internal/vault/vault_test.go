@@ -0,0 +1,101 @@
+package vault
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLuhn(t *testing.T) {
+	for s, want := range map[string]bool{
+		"4242424242424242": true,
+		"4242424242424241": false,
+		"378282246310005":  true,
+		"79927398713":      true,
+		"79927398710":      false,
+		"":                 false,
+		"4242a24242424242": false,
+	} {
+		if got := Luhn(s); got != want {
+			t.Errorf("Luhn(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestParseCard(t *testing.T) {
+	now := time.Date(2026, 5, 15, 12, 0, 0, 0, time.UTC)
+	c, err := ParseCard("4242 4242-4242 4242", 5, 2026, "123", now)
+	if err != nil {
+		t.Fatalf("ParseCard: %v", err)
+	}
+	if c.PAN != "4242424242424242" || c.Last4() != "4242" {
+		t.Fatalf("card = %+v, last4 %q", c, c.Last4())
+	}
+	if want := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC); !c.ExpiresAt().Equal(want) {
+		t.Fatalf("ExpiresAt = %v, want %v", c.ExpiresAt(), want)
+	}
+	if c, err := ParseCard("4242424242424242", 12, 2026, "1234", now); err != nil || c.ExpiresAt().Year() != 2027 {
+		t.Fatalf("December expiry: %+v, %v", c, err)
+	}
+
+	tests := []struct {
+		name, pan, cvc   string
+		month, year      int
+		wantErrSubstring string
+	}{
+		{"short", "42424242", "123", 5, 2030, "12-19 digits"},
+		{"letters", "4242424242424a42", "123", 5, 2030, "12-19 digits"},
+		{"luhn", "4242424242424241", "123", 5, 2030, "Luhn"},
+		{"month", "4242424242424242", "123", 13, 2030, "exp_month"},
+		{"year", "4242424242424242", "123", 5, 30, "exp_year"},
+		{"cvc", "4242424242424242", "12", 5, 2030, "cvc"},
+		{"expired", "4242424242424242", "123", 4, 2026, "expired"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCard(tt.pan, tt.month, tt.year, tt.cvc, now)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErrSubstring) {
+				t.Fatalf("err = %v, want %q", err, tt.wantErrSubstring)
+			}
+		})
+	}
+}
+
+func TestNewToken(t *testing.T) {
+	a, err := NewToken()
+	if err != nil {
+		t.Fatalf("NewToken: %v", err)
+	}
+	b, _ := NewToken()
+	if !strings.HasPrefix(a, TokenPrefix) || len(a) != len(TokenPrefix)+32 || a == b {
+		t.Fatalf("tokens %q, %q", a, b)
+	}
+}
+
+func TestCipher(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", KeySize)))
+	c, err := NewCipher(key)
+	if err != nil {
+		t.Fatalf("NewCipher: %v", err)
+	}
+	sealed, err := c.Seal("tok_a", "4242424242424242")
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+	if strings.Contains(string(sealed), "4242424242424242") {
+		t.Fatalf("sealed card contains the card number")
+	}
+	if pan, err := c.Open("tok_a", sealed); err != nil || pan != "4242424242424242" {
+		t.Fatalf("Open = %q, %v", pan, err)
+	}
+	if _, err := c.Open("tok_b", sealed); err == nil {
+		t.Fatalf("Open succeeded under another token")
+	}
+
+	for _, bad := range []string{"not base64!", base64.StdEncoding.EncodeToString([]byte("short"))} {
+		if _, err := NewCipher(bad); err == nil {
+			t.Errorf("NewCipher(%q) succeeded", bad)
+		}
+	}
+}
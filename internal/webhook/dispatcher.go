@@ -0,0 +1,280 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grpc-buf/internal/config"
+	webhookv1 "github.com/grpc-buf/internal/gen/proto/webhook"
+)
+
+// Defaults used when the corresponding WebhooksConfig field is unset.
+const (
+	DefaultMaxAttempts    = 8
+	DefaultInitialBackoff = 30 * time.Second
+	DefaultMaxBackoff     = time.Hour
+	DefaultTimeout        = 10 * time.Second
+	DefaultPollInterval   = 5 * time.Second
+	DefaultBatchSize      = 20
+)
+
+// maxErrorBody caps how much of a failed response is kept in last_error.
+const maxErrorBody = 512
+
+// EventName returns the dotted envelope name of t, e.g. "payment.succeeded".
+func EventName(t webhookv1.WebhookEventType) string {
+	switch t {
+	case webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_PAYMENT_SUCCEEDED:
+		return "payment.succeeded"
+	case webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_INVOICE_PAID:
+		return "invoice.paid"
+	case webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_INVOICE_OVERDUE:
+		return "invoice.overdue"
+	case webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_INVOICE_REMINDER:
+		return "invoice.reminder"
+	}
+	return strings.ToLower(strings.TrimPrefix(t.String(), "WEBHOOK_EVENT_TYPE_"))
+}
+
+// Delivery is one event queued for one endpoint.
+type Delivery struct {
+	ID         string
+	EndpointID string
+	EventID    string
+	EventType  webhookv1.WebhookEventType
+	URL        string
+	Secret     string
+	// Payload is the JSON event data, sent as the envelope's "data".
+	Payload []byte
+	// Attempts counts the attempts already made.
+	Attempts  int
+	CreatedAt time.Time
+}
+
+// Outcome is the result of one delivery attempt.
+type Outcome struct {
+	Delivered  bool
+	StatusCode int
+	Error      string
+	// NextAttempt is when to retry a failed attempt; zero marks the delivery
+	// FAILED.
+	NextAttempt time.Time
+}
+
+// Queue is the delivery store the Dispatcher drains.
+type Queue interface {
+	// ClaimWebhookDeliveries returns up to limit due deliveries and pushes
+	// their next attempt lease into the future so concurrent dispatchers
+	// skip them.
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]Delivery, error)
+	// RecordWebhookAttempt stores the outcome of an attempt on delivery id.
+	RecordWebhookAttempt(ctx context.Context, id string, o Outcome) error
+}
+
+// envelope is the JSON body POSTed to endpoints.
+type envelope struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	CreateTime time.Time       `json:"createTime"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// Dispatcher sends due deliveries and schedules retries with exponential
+// backoff. Several dispatchers may share one Queue.
+type Dispatcher struct {
+	queue          Queue
+	client         *http.Client
+	now            func() time.Time
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	timeout        time.Duration
+	pollInterval   time.Duration
+	batchSize      int
+}
+
+// NewDispatcher returns a Dispatcher for q tuned by cfg. Invalid or unset
+// durations fall back to the package defaults; config.Validate rejects them
+// at startup.
+func NewDispatcher(q Queue, cfg config.WebhooksConfig) *Dispatcher {
+	d := &Dispatcher{
+		queue:          q,
+		now:            time.Now,
+		maxAttempts:    DefaultMaxAttempts,
+		initialBackoff: parseDuration(cfg.InitialBackoff, DefaultInitialBackoff),
+		maxBackoff:     parseDuration(cfg.MaxBackoff, DefaultMaxBackoff),
+		timeout:        parseDuration(cfg.Timeout, DefaultTimeout),
+		pollInterval:   parseDuration(cfg.PollInterval, DefaultPollInterval),
+		batchSize:      DefaultBatchSize,
+	}
+	if cfg.MaxAttempts > 0 {
+		d.maxAttempts = cfg.MaxAttempts
+	}
+	if cfg.BatchSize > 0 {
+		d.batchSize = cfg.BatchSize
+	}
+	dialer := &net.Dialer{Timeout: d.timeout}
+	if !cfg.AllowPrivateTargets {
+		dialer.Control = dialPublicOnly
+	}
+	d.client = &http.Client{
+		Timeout: d.timeout,
+		// No proxy, so the dialer sees the endpoint's own address.
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: d.timeout,
+			MaxIdleConnsPerHost: 2,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		// A redirect could point anywhere, so it counts as a failed attempt.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return d
+}
+
+func parseDuration(s string, def time.Duration) time.Duration {
+	if d, err := time.ParseDuration(strings.TrimSpace(s)); err == nil && d > 0 {
+		return d
+	}
+	return def
+}
+
+// Run delivers due webhooks every poll interval until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	slog.Info("Starting webhook dispatcher", "poll_interval", d.pollInterval, "max_attempts", d.maxAttempts)
+	t := time.NewTicker(d.pollInterval)
+	defer t.Stop()
+	for {
+		// Drain full batches back to back so a backlog clears quickly.
+		for {
+			n, err := d.DeliverDue(ctx)
+			if err != nil {
+				slog.Error("webhook dispatch failed", "error", err)
+			}
+			if err != nil || n < d.batchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// DeliverDue claims one batch of due deliveries, attempts them concurrently
+// and records the outcomes. It returns the number of deliveries attempted.
+func (d *Dispatcher) DeliverDue(ctx context.Context) (int, error) {
+	// Every delivery in the batch is sent at once, so the lease only has to
+	// outlive one attempt; a dispatcher that dies mid-send only delays the
+	// delivery.
+	batch, err := d.queue.ClaimWebhookDeliveries(ctx, d.batchSize, 2*d.timeout+time.Minute)
+	if err != nil {
+		return 0, fmt.Errorf("claim webhook deliveries: %w", err)
+	}
+	errs := make([]error, len(batch))
+	var wg sync.WaitGroup
+	for i, dl := range batch {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = d.deliver(ctx, dl)
+		}()
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return 0, err
+	}
+	return len(batch), nil
+}
+
+// deliver makes one attempt at dl and records its outcome.
+func (d *Dispatcher) deliver(ctx context.Context, dl Delivery) error {
+	o := d.Send(ctx, dl)
+	if err := d.queue.RecordWebhookAttempt(ctx, dl.ID, o); err != nil {
+		return fmt.Errorf("record webhook attempt %s: %w", dl.ID, err)
+	}
+	if o.Delivered {
+		slog.Info("Webhook delivered", "id", dl.ID, "event_type", EventName(dl.EventType), "status_code", o.StatusCode)
+	} else {
+		slog.Warn("Webhook attempt failed", "id", dl.ID, "attempt", dl.Attempts+1, "status_code", o.StatusCode,
+			"error", o.Error, "retry", !o.NextAttempt.IsZero())
+	}
+	return nil
+}
+
+// Send makes one signed attempt at dl. Any 2xx response counts as
+// delivered; anything else, including a redirect, is retried after Backoff
+// until the attempt budget is spent.
+func (d *Dispatcher) Send(ctx context.Context, dl Delivery) Outcome {
+	o := d.post(ctx, dl)
+	if o.Delivered {
+		return o
+	}
+	if attempts := dl.Attempts + 1; attempts < d.maxAttempts {
+		o.NextAttempt = d.now().Add(d.Backoff(attempts))
+	}
+	return o
+}
+
+func (d *Dispatcher) post(ctx context.Context, dl Delivery) Outcome {
+	body, err := json.Marshal(envelope{
+		ID:         dl.EventID,
+		Type:       EventName(dl.EventType),
+		CreateTime: dl.CreatedAt.UTC(),
+		Data:       json.RawMessage(dl.Payload),
+	})
+	if err != nil {
+		return Outcome{Error: "encode envelope: " + err.Error()}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dl.URL, bytes.NewReader(body))
+	if err != nil {
+		return Outcome{Error: err.Error()}
+	}
+	now := d.now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "grpc-buf-webhooks/1")
+	req.Header.Set(HeaderID, dl.ID)
+	req.Header.Set(HeaderTimestamp, fmt.Sprint(now.Unix()))
+	req.Header.Set(HeaderSignature, Sign([]byte(dl.Secret), now, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return Outcome{Error: err.Error()}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return Outcome{Delivered: true, StatusCode: resp.StatusCode}
+	}
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	msg := resp.Status
+	if s := strings.TrimSpace(string(snippet)); s != "" {
+		msg += ": " + s
+	}
+	return Outcome{StatusCode: resp.StatusCode, Error: msg}
+}
+
+// Backoff returns the delay before the next attempt after attempts failed
+// attempts: the initial backoff doubled per further failure, capped at the
+// maximum.
+func (d *Dispatcher) Backoff(attempts int) time.Duration {
+	b := d.initialBackoff
+	for i := 1; i < attempts && b < d.maxBackoff; i++ {
+		b *= 2
+	}
+	return min(b, d.maxBackoff)
+}
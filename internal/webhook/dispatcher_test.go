@@ -0,0 +1,207 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/grpc-buf/internal/config"
+	webhookv1 "github.com/grpc-buf/internal/gen/proto/webhook"
+)
+
+// memQueue is an in-memory Queue that hands out each pending delivery once
+// per claim and keeps the last outcome per delivery.
+type memQueue struct {
+	mu       sync.Mutex
+	pending  []Delivery
+	outcomes map[string][]Outcome
+}
+
+func (q *memQueue) ClaimWebhookDeliveries(_ context.Context, limit int, _ time.Duration) ([]Delivery, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	n := min(limit, len(q.pending))
+	batch := q.pending[:n]
+	q.pending = q.pending[n:]
+	return batch, nil
+}
+
+func (q *memQueue) RecordWebhookAttempt(_ context.Context, id string, o Outcome) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.outcomes == nil {
+		q.outcomes = map[string][]Outcome{}
+	}
+	q.outcomes[id] = append(q.outcomes[id], o)
+	return nil
+}
+
+func TestDispatcherDeliversSignedEnvelope(t *testing.T) {
+	const secret = "whsec_test"
+	now := time.Unix(1_700_000_000, 0)
+
+	type received struct {
+		header http.Header
+		body   []byte
+	}
+	got := make(chan received, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got <- received{header: r.Header.Clone(), body: body}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	q := &memQueue{pending: []Delivery{{
+		ID:        "dlv_1",
+		EventID:   "evt_1",
+		EventType: webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_INVOICE_PAID,
+		URL:       srv.URL,
+		Secret:    secret,
+		Payload:   []byte(`{"id":"inv_1"}`),
+		CreatedAt: now,
+	}}}
+	d := NewDispatcher(q, config.WebhooksConfig{AllowPrivateTargets: true})
+	d.now = func() time.Time { return now }
+
+	n, err := d.DeliverDue(context.Background())
+	if err != nil || n != 1 {
+		t.Fatalf("DeliverDue = %d, %v; want 1, nil", n, err)
+	}
+	if o := q.outcomes["dlv_1"]; len(o) != 1 || !o[0].Delivered || o[0].StatusCode != http.StatusNoContent {
+		t.Fatalf("outcomes = %+v, want one delivered 204", o)
+	}
+
+	r := <-got
+	if r.header.Get(HeaderID) != "dlv_1" {
+		t.Fatalf("%s = %q", HeaderID, r.header.Get(HeaderID))
+	}
+	if err := Verify([]byte(secret), r.header.Get(HeaderTimestamp), r.header.Get(HeaderSignature), r.body, time.Minute, now); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	var env struct {
+		ID   string          `json:"id"`
+		Type string          `json:"type"`
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(r.body, &env); err != nil {
+		t.Fatalf("decode envelope: %v", err)
+	}
+	if env.ID != "evt_1" || env.Type != "invoice.paid" || string(env.Data) != `{"id":"inv_1"}` {
+		t.Fatalf("envelope = %+v", env)
+	}
+}
+
+func TestDispatcherRetriesWithBackoff(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "try later", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	now := time.Unix(1_700_000_000, 0)
+	d := NewDispatcher(&memQueue{}, config.WebhooksConfig{MaxAttempts: 3, InitialBackoff: "10s", MaxBackoff: "15s", AllowPrivateTargets: true})
+	d.now = func() time.Time { return now }
+	dl := Delivery{ID: "dlv_1", URL: srv.URL, Secret: "s", Payload: []byte(`{}`)}
+
+	for attempts, wantNext := range []time.Duration{10 * time.Second, 15 * time.Second, 0} {
+		dl.Attempts = attempts
+		o := d.Send(context.Background(), dl)
+		if o.Delivered || o.StatusCode != http.StatusServiceUnavailable || o.Error == "" {
+			t.Fatalf("attempt %d: outcome = %+v, want failed 503", attempts+1, o)
+		}
+		if wantNext == 0 {
+			if !o.NextAttempt.IsZero() {
+				t.Fatalf("attempt %d: NextAttempt = %v, want none after the last attempt", attempts+1, o.NextAttempt)
+			}
+			continue
+		}
+		if got := o.NextAttempt.Sub(now); got != wantNext {
+			t.Fatalf("attempt %d: retry in %v, want %v", attempts+1, got, wantNext)
+		}
+	}
+}
+
+func TestDispatcherUnreachableEndpoint(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	d := NewDispatcher(&memQueue{}, config.WebhooksConfig{Timeout: "1s", AllowPrivateTargets: true})
+	o := d.Send(context.Background(), Delivery{ID: "dlv_1", URL: url, Secret: "s", Payload: []byte(`{}`)})
+	if o.Delivered || o.StatusCode != 0 || o.Error == "" || o.NextAttempt.IsZero() {
+		t.Fatalf("outcome = %+v, want a scheduled retry after a transport error", o)
+	}
+}
+
+func TestDispatcherRefusesPrivateTargets(t *testing.T) {
+	hit := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hit = true
+	}))
+	defer srv.Close()
+
+	d := NewDispatcher(&memQueue{}, config.WebhooksConfig{})
+	o := d.Send(context.Background(), Delivery{ID: "dlv_1", URL: srv.URL, Secret: "s", Payload: []byte(`{}`)})
+	if o.Delivered || !strings.Contains(o.Error, ErrPrivateTarget.Error()) || hit {
+		t.Fatalf("outcome = %+v (endpoint hit: %v), want the dial refused", o, hit)
+	}
+}
+
+func TestDispatcherDoesNotFollowRedirects(t *testing.T) {
+	followed := false
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		followed = true
+	}))
+	defer target.Close()
+	srv := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer srv.Close()
+
+	d := NewDispatcher(&memQueue{}, config.WebhooksConfig{AllowPrivateTargets: true})
+	o := d.Send(context.Background(), Delivery{ID: "dlv_1", URL: srv.URL, Secret: "s", Payload: []byte(`{}`)})
+	if o.Delivered || o.StatusCode != http.StatusTemporaryRedirect || followed {
+		t.Fatalf("outcome = %+v (redirect followed: %v), want a failed 307", o, followed)
+	}
+}
+
+func TestDeliverDueSendsBatchConcurrently(t *testing.T) {
+	const n = 5
+	var arrived sync.WaitGroup
+	arrived.Add(n)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		// Each handler waits for the others, so serial sends would time out.
+		arrived.Done()
+		arrived.Wait()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	q := &memQueue{}
+	for i := range n {
+		q.pending = append(q.pending, Delivery{ID: fmt.Sprintf("dlv_%d", i), URL: srv.URL, Secret: "s", Payload: []byte(`{}`)})
+	}
+	d := NewDispatcher(q, config.WebhooksConfig{Timeout: "2s", AllowPrivateTargets: true})
+	if got, err := d.DeliverDue(context.Background()); err != nil || got != n {
+		t.Fatalf("DeliverDue = %d, %v; want %d, nil", got, err, n)
+	}
+	for id, o := range q.outcomes {
+		if len(o) != 1 || !o[0].Delivered {
+			t.Fatalf("%s: outcomes = %+v, want one delivered", id, o)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	d := NewDispatcher(&memQueue{}, config.WebhooksConfig{InitialBackoff: "1s", MaxBackoff: "5s"})
+	for attempts, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 5 * time.Second, 30: 5 * time.Second} {
+		if got := d.Backoff(attempts); got != want {
+			t.Errorf("Backoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Package webhook signs and delivers outbound event notifications and
+// verifies signed inbound ones.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers set on every delivery.
+const (
+	// HeaderID carries the delivery id; receivers can use it to drop
+	// duplicates, since a delivery may be sent more than once.
+	HeaderID = "Webhook-Id"
+	// HeaderTimestamp carries the signing time in Unix seconds.
+	HeaderTimestamp = "Webhook-Timestamp"
+	// HeaderSignature carries "v1=" followed by the hex HMAC-SHA256 of
+	// "<timestamp>.<body>".
+	HeaderSignature = "Webhook-Signature"
+)
+
+const signaturePrefix = "v1="
+
+var (
+	// ErrBadSignature reports a missing or non-matching signature.
+	ErrBadSignature = errors.New("webhook: signature mismatch")
+	// ErrStaleTimestamp reports a timestamp outside the allowed tolerance.
+	ErrStaleTimestamp = errors.New("webhook: timestamp outside tolerance")
+)
+
+// Sign returns the HeaderSignature value for body signed at ts.
+func Sign(secret []byte, ts time.Time, body []byte) string {
+	return signaturePrefix + hex.EncodeToString(mac(secret, strconv.FormatInt(ts.Unix(), 10), body))
+}
+
+// Verify checks a signature produced by Sign. timestamp is the
+// HeaderTimestamp value and signature the HeaderSignature value, which may
+// list several comma-separated signatures (e.g. during secret rotation).
+// Timestamps further than tolerance from now are rejected with
+// ErrStaleTimestamp to limit replays.
+func Verify(secret []byte, timestamp, signature string, body []byte, tolerance time.Duration, now time.Time) error {
+	sec, err := strconv.ParseInt(strings.TrimSpace(timestamp), 10, 64)
+	if err != nil {
+		return ErrBadSignature
+	}
+	if d := now.Sub(time.Unix(sec, 0)); d > tolerance || d < -tolerance {
+		return ErrStaleTimestamp
+	}
+	want := mac(secret, strconv.FormatInt(sec, 10), body)
+	for _, part := range strings.Split(signature, ",") {
+		got, ok := strings.CutPrefix(strings.TrimSpace(part), signaturePrefix)
+		if !ok {
+			continue
+		}
+		if b, err := hex.DecodeString(got); err == nil && hmac.Equal(b, want) {
+			return nil
+		}
+	}
+	return ErrBadSignature
+}
+
+func mac(secret []byte, timestamp string, body []byte) []byte {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte(timestamp))
+	h.Write([]byte{'.'})
+	h.Write(body)
+	return h.Sum(nil)
+}
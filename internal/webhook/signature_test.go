@@ -0,0 +1,43 @@
+package webhook
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSignVerify(t *testing.T) {
+	secret := []byte("whsec_test")
+	body := []byte(`{"id":"evt_1"}`)
+	signedAt := time.Unix(1_700_000_000, 0)
+	sig := Sign(secret, signedAt, body)
+	ts := "1700000000"
+
+	tests := []struct {
+		name      string
+		secret    []byte
+		timestamp string
+		signature string
+		body      []byte
+		now       time.Time
+		want      error
+	}{
+		{name: "valid", secret: secret, timestamp: ts, signature: sig, body: body, now: signedAt.Add(time.Minute)},
+		{name: "rotated secret list", secret: secret, timestamp: ts, signature: "v1=00ff, " + sig, body: body, now: signedAt},
+		{name: "tampered body", secret: secret, timestamp: ts, signature: sig, body: []byte(`{"id":"evt_2"}`), now: signedAt, want: ErrBadSignature},
+		{name: "wrong secret", secret: []byte("other"), timestamp: ts, signature: sig, body: body, now: signedAt, want: ErrBadSignature},
+		{name: "timestamp not signed", secret: secret, timestamp: "1700000001", signature: sig, body: body, now: signedAt, want: ErrBadSignature},
+		{name: "missing prefix", secret: secret, timestamp: ts, signature: sig[len("v1="):], body: body, now: signedAt, want: ErrBadSignature},
+		{name: "bad timestamp", secret: secret, timestamp: "yesterday", signature: sig, body: body, now: signedAt, want: ErrBadSignature},
+		{name: "stale", secret: secret, timestamp: ts, signature: sig, body: body, now: signedAt.Add(6 * time.Minute), want: ErrStaleTimestamp},
+		{name: "future", secret: secret, timestamp: ts, signature: sig, body: body, now: signedAt.Add(-6 * time.Minute), want: ErrStaleTimestamp},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(tt.secret, tt.timestamp, tt.signature, tt.body, 5*time.Minute, tt.now)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Verify = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
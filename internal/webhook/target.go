@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+)
+
+// ErrPrivateTarget reports an endpoint URL or address that is not allowed
+// because it is not https or does not point at a public host.
+var ErrPrivateTarget = errors.New("webhook target must be a public https URL")
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which
+// netip does not classify as private.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// CheckURL validates an endpoint URL: absolute, with a host, and unless
+// allowPrivate is set, https with a host that is not a loopback, private or
+// link-local address or "localhost". Hostnames are resolved again at every
+// delivery, where the dialer refuses non-public addresses.
+func CheckURL(raw string, allowPrivate bool) (string, error) {
+	raw = strings.TrimSpace(raw)
+	u, err := url.Parse(raw)
+	if raw == "" || err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return "", errors.New("url must be an absolute http or https URL")
+	}
+	if allowPrivate {
+		return raw, nil
+	}
+	if u.Scheme != "https" {
+		return "", ErrPrivateTarget
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return "", ErrPrivateTarget
+	}
+	if ip, err := netip.ParseAddr(host); err == nil && !publicAddr(ip) {
+		return "", ErrPrivateTarget
+	}
+	return raw, nil
+}
+
+// publicAddr reports whether ip is globally routable.
+func publicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsValid() && ip.IsGlobalUnicast() && !ip.IsPrivate() && !sharedAddressSpace.Contains(ip)
+}
+
+// dialPublicOnly is a net.Dialer Control hook that refuses connections to
+// non-public addresses after DNS resolution, so a hostname that resolves to
+// an internal address is not reached.
+func dialPublicOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !publicAddr(ip) {
+		return fmt.Errorf("%w: %s resolves to %s", ErrPrivateTarget, address, ip)
+	}
+	return nil
+}
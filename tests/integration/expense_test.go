@@ -20,12 +20,12 @@ func TestExpenseCRUD(t *testing.T) {
 		http.DefaultClient,
 		"http://localhost:8080",
 		connect.WithGRPC(),
+		loginAs(t, "expenses@example.com"),
 	)
 
-	// Create
+	// Create; user_id defaults to the caller
 	createReq := connect.NewRequest(&expensev1.CreateExpenseRequest{
 		Expense: &expensev1.Expense{
-			UserId:      "00000000-0000-0000-0000-000000000001",
 			Amount:      &money.Money{CurrencyCode: "USD", Units: 12},
 			Category:    "test",
 			Description: "integration",
@@ -74,3 +74,46 @@ func TestExpenseCRUD(t *testing.T) {
 		t.Fatalf("DeleteExpense failed: %v", err)
 	}
 }
+
+func TestExpenseOwnership(t *testing.T) {
+	alice := expensev1connect.NewExpenseServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+		loginAs(t, "expenses-alice@example.com"),
+	)
+	bob := expensev1connect.NewExpenseServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+		loginAs(t, "expenses-bob@example.com"),
+	)
+	ctx := context.Background()
+
+	created, err := alice.CreateExpense(ctx, connect.NewRequest(&expensev1.CreateExpenseRequest{
+		Expense: &expensev1.Expense{Amount: &money.Money{CurrencyCode: "USD", Units: 5}},
+	}))
+	if err != nil {
+		t.Fatalf("CreateExpense failed: %v", err)
+	}
+	id, owner := created.Msg.GetId(), created.Msg.GetUserId()
+
+	if _, err := bob.GetExpense(ctx, connect.NewRequest(&expensev1.GetExpenseRequest{Id: id})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("GetExpense of another user's expense: got %v, want NotFound", err)
+	}
+	if _, err := bob.DeleteExpense(ctx, connect.NewRequest(&expensev1.DeleteExpenseRequest{Id: id})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("DeleteExpense of another user's expense: got %v, want NotFound", err)
+	}
+	if _, err := bob.ListExpenses(ctx, connect.NewRequest(&expensev1.ListExpensesRequest{UserId: owner})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("ListExpenses for another user: got %v, want PermissionDenied", err)
+	}
+	list, err := bob.ListExpenses(ctx, connect.NewRequest(&expensev1.ListExpensesRequest{}))
+	if err != nil {
+		t.Fatalf("ListExpenses failed: %v", err)
+	}
+	for _, e := range list.Msg.GetExpenses() {
+		if e.GetUserId() == owner {
+			t.Fatalf("ListExpenses without user_id returned another user's expense %s", e.GetId())
+		}
+	}
+}
@@ -0,0 +1,131 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"github.com/grpc-buf/internal/gen/proto/payment/paymentv1connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+// missingInvoiceID is a well-formed id no invoice has.
+const missingInvoiceID = "00000000-0000-0000-0000-000000000000"
+
+// loginWithRoles registers email (if needed), sets its roles in the database
+// and logs in, so the access token carries them.
+func loginWithRoles(t *testing.T, email string, roles ...string) connect.ClientOption {
+	t.Helper()
+	loginAs(t, email)
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, testDSN())
+	require.NoError(t, err, "connect to database")
+	defer conn.Close(ctx)
+	_, err = conn.Exec(ctx, `UPDATE users SET roles=$2 WHERE email=$1`, email, roles)
+	require.NoError(t, err, "set roles")
+	return loginAs(t, email)
+}
+
+func newInvoice(t *testing.T, client paymentv1connect.PaymentServiceClient, name string) *paymentv1.Invoice {
+	t.Helper()
+	res, err := client.CreateInvoice(context.Background(), connect.NewRequest(&paymentv1.CreateInvoiceRequest{
+		Invoice: &paymentv1.Invoice{
+			InvoiceName: name,
+			LineItems: []*paymentv1.InvoiceLineItem{
+				{Description: "Widget", Quantity: 1, UnitPrice: &money.Money{CurrencyCode: "USD", Units: 25}},
+			},
+		},
+	}))
+	require.NoError(t, err, "CreateInvoice failed")
+	return res.Msg
+}
+
+func TestMarkInvoicePaid(t *testing.T) {
+	user := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+		loginAs(t, "invoice-user@example.com"),
+	)
+	finance := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+		loginWithRoles(t, "invoice-finance@example.com", "user", "finance"),
+	)
+	ctx := context.Background()
+	markPaid := func(client paymentv1connect.PaymentServiceClient, id string) (*paymentv1.Invoice, error) {
+		res, err := client.MarkInvoicePaid(ctx, connect.NewRequest(&paymentv1.MarkInvoicePaidRequest{Id: id}))
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}
+
+	inv := newInvoice(t, finance, "integration-mark-paid")
+	_, err := markPaid(user, inv.GetId())
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), "MarkInvoicePaid requires the finance role")
+	_, err = user.GetInvoice(ctx, connect.NewRequest(&paymentv1.GetInvoiceRequest{Id: inv.GetId()}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), "invoices are not scoped to their owner")
+
+	paid, err := markPaid(finance, inv.GetId())
+	require.NoError(t, err, "MarkInvoicePaid failed")
+	require.True(t, paid.GetPaid())
+	require.Equal(t, paymentv1.InvoiceState_INVOICE_STATE_PAID, paid.GetState())
+	require.Zero(t, paid.GetAmountDue().GetUnits())
+
+	_, err = markPaid(finance, inv.GetId())
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "an already paid invoice")
+
+	cancelled := newInvoice(t, finance, "integration-mark-paid-cancelled")
+	_, err = finance.CancelInvoice(ctx, connect.NewRequest(&paymentv1.CancelInvoiceRequest{Id: cancelled.GetId()}))
+	require.NoError(t, err, "CancelInvoice failed")
+	_, err = markPaid(finance, cancelled.GetId())
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "a cancelled invoice")
+
+	_, err = markPaid(finance, missingInvoiceID)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err), "a missing invoice")
+	_, err = markPaid(finance, "")
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "an empty id")
+}
+
+func TestPayInvoiceRejectsClosedInvoices(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+		loginWithRoles(t, "invoice-payer@example.com", "user", "finance"),
+	)
+	ctx := context.Background()
+	pay := func(id string) error {
+		_, err := client.PayInvoice(ctx, connect.NewRequest(&paymentv1.PayInvoiceRequest{
+			Id:        id,
+			CardToken: "tok_integration_invoice_states",
+			Card:      paymentv1.CardType_CARD_TYPE_DEBIT,
+		}))
+		return err
+	}
+
+	marked := newInvoice(t, client, "integration-pay-marked")
+	_, err := client.MarkInvoicePaid(ctx, connect.NewRequest(&paymentv1.MarkInvoicePaidRequest{Id: marked.GetId()}))
+	require.NoError(t, err, "MarkInvoicePaid failed")
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(pay(marked.GetId())), "an already paid invoice")
+
+	cancelled := newInvoice(t, client, "integration-pay-cancelled")
+	_, err = client.CancelInvoice(ctx, connect.NewRequest(&paymentv1.CancelInvoiceRequest{Id: cancelled.GetId()}))
+	require.NoError(t, err, "CancelInvoice failed")
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(pay(cancelled.GetId())), "a cancelled invoice")
+
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(pay(missingInvoiceID)), "a missing invoice")
+
+	// An open invoice still pays with the same card.
+	open := newInvoice(t, client, "integration-pay-open")
+	require.NoError(t, pay(open.GetId()), "PayInvoice failed")
+}
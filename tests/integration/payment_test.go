@@ -5,6 +5,7 @@ package integration
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
@@ -13,7 +14,10 @@ import (
 	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
 	"github.com/grpc-buf/internal/gen/proto/payment/paymentv1connect"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/genproto/googleapis/type/date"
 	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/genproto/googleapis/type/postaladdress"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -38,3 +42,729 @@ func TestPayment(t *testing.T) {
 	require.NoError(t, err, "MakePayment failed")
 	t.Logf("payment response: %+v", res.Msg)
 }
+
+func TestInvoiceLifecycle(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+
+	created, err := client.CreateInvoice(ctx, connect.NewRequest(&paymentv1.CreateInvoiceRequest{
+		Invoice: &paymentv1.Invoice{
+			InvoiceName: "integration-invoice",
+			LineItems: []*paymentv1.InvoiceLineItem{
+				{Description: "Consulting", Quantity: 2, UnitPrice: &money.Money{CurrencyCode: "USD", Units: 15}, TaxRateBps: 2000},
+				{Description: "Travel", Quantity: 1, UnitPrice: &money.Money{CurrencyCode: "USD", Units: 12, Nanos: 500_000_000}},
+			},
+			Discount: &paymentv1.InvoiceDiscount{Value: &paymentv1.InvoiceDiscount_AmountOff{
+				AmountOff: &money.Money{CurrencyCode: "USD", Units: 5},
+			}},
+		},
+	}))
+	require.NoError(t, err, "CreateInvoice failed")
+	require.NotEmpty(t, created.Msg.GetId())
+	require.Equal(t, paymentv1.InvoiceState_INVOICE_STATE_OPEN, created.Msg.GetState())
+	// Subtotal 42.50; the 5.00 discount splits 3.53/1.47; tax is 20% of 26.47.
+	require.Equal(t, int64(42), created.Msg.GetSubtotal().GetUnits())
+	require.Equal(t, int32(500_000_000), created.Msg.GetSubtotal().GetNanos())
+	require.Equal(t, int64(5), created.Msg.GetTax().GetUnits())
+	require.Equal(t, int32(290_000_000), created.Msg.GetTax().GetNanos())
+	require.Equal(t, int64(42), created.Msg.GetAmount().GetUnits())
+	require.Equal(t, int32(790_000_000), created.Msg.GetAmount().GetNanos())
+
+	got, err := client.GetInvoice(ctx, connect.NewRequest(&paymentv1.GetInvoiceRequest{Id: created.Msg.GetId()}))
+	require.NoError(t, err, "GetInvoice failed")
+	require.Equal(t, "integration-invoice", got.Msg.GetInvoiceName())
+	require.Len(t, got.Msg.GetLineItems(), 2)
+	require.Equal(t, "Consulting", got.Msg.GetLineItems()[0].GetDescription())
+
+	_, err = client.CreateInvoice(ctx, connect.NewRequest(&paymentv1.CreateInvoiceRequest{
+		Invoice: &paymentv1.Invoice{
+			InvoiceName: "client-priced",
+			Amount:      &money.Money{CurrencyCode: "USD", Units: 1},
+		},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "client-supplied amounts must be rejected")
+
+	open, err := client.ListInvoices(ctx, connect.NewRequest(&paymentv1.ListInvoicesRequest{
+		State: paymentv1.InvoiceState_INVOICE_STATE_OPEN,
+	}))
+	require.NoError(t, err, "ListInvoices failed")
+	for _, inv := range open.Msg.GetInvoices() {
+		require.Equal(t, paymentv1.InvoiceState_INVOICE_STATE_OPEN, inv.GetState())
+	}
+
+	paid, err := client.PayInvoice(ctx, connect.NewRequest(&paymentv1.PayInvoiceRequest{
+		Id:        created.Msg.GetId(),
+		CardToken: "tok_integration_invoice_1",
+		Card:      paymentv1.CardType_CARD_TYPE_DEBIT,
+	}))
+	require.NoError(t, err, "PayInvoice failed")
+	require.True(t, paid.Msg.GetPaid())
+
+	_, err = client.PayInvoice(ctx, connect.NewRequest(&paymentv1.PayInvoiceRequest{
+		Id:        created.Msg.GetId(),
+		CardToken: "tok_integration_invoice_1",
+		Card:      paymentv1.CardType_CARD_TYPE_DEBIT,
+	}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	_, err = client.CancelInvoice(ctx, connect.NewRequest(&paymentv1.CancelInvoiceRequest{Id: created.Msg.GetId()}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+}
+
+func TestPaymentLifecycle(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+
+	authorize := func() string {
+		res, err := client.MakePayment(ctx, connect.NewRequest(&paymentv1.PaymentRequest{
+			CardToken:    "tok_integration_lifecycle_1",
+			Card:         paymentv1.CardType_CARD_TYPE_CREDIT,
+			Name:         "Lifecycle",
+			AddressLines: []string{"1 Lifecycle Way"},
+			Amount:       &money.Money{CurrencyCode: "USD", Units: 20},
+		}))
+		require.NoError(t, err, "MakePayment failed")
+		require.Equal(t, paymentv1.PaymentStatus_PAYMENT_STATUS_AUTHORIZED, res.Msg.GetStatus())
+		require.NotEmpty(t, res.Msg.GetId())
+		return res.Msg.GetId()
+	}
+
+	voidID := authorize()
+	voided, err := client.VoidPayment(ctx, connect.NewRequest(&paymentv1.VoidPaymentRequest{Id: voidID}))
+	require.NoError(t, err, "VoidPayment failed")
+	require.Equal(t, paymentv1.PaymentStatus_PAYMENT_STATUS_VOIDED, voided.Msg.GetStatus())
+	_, err = client.CapturePayment(ctx, connect.NewRequest(&paymentv1.CapturePaymentRequest{Id: voidID}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	id := authorize()
+	_, err = client.RefundPayment(ctx, connect.NewRequest(&paymentv1.RefundPaymentRequest{Id: id}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "refund before capture must fail")
+
+	captured, err := client.CapturePayment(ctx, connect.NewRequest(&paymentv1.CapturePaymentRequest{Id: id}))
+	require.NoError(t, err, "CapturePayment failed")
+	require.Equal(t, paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED, captured.Msg.GetStatus())
+
+	partial, err := client.RefundPayment(ctx, connect.NewRequest(&paymentv1.RefundPaymentRequest{
+		Id:     id,
+		Amount: &money.Money{CurrencyCode: "USD", Units: 5},
+	}))
+	require.NoError(t, err, "partial RefundPayment failed")
+	require.Equal(t, paymentv1.PaymentStatus_PAYMENT_STATUS_PARTIALLY_REFUNDED, partial.Msg.GetStatus())
+
+	full, err := client.RefundPayment(ctx, connect.NewRequest(&paymentv1.RefundPaymentRequest{Id: id}))
+	require.NoError(t, err, "final RefundPayment failed")
+	require.Equal(t, paymentv1.PaymentStatus_PAYMENT_STATUS_REFUNDED, full.Msg.GetStatus())
+	require.Equal(t, int64(20), full.Msg.GetRefundedAmount().GetUnits())
+}
+
+func TestRefundsAndDisputes(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+
+	res, err := client.MakePayment(ctx, connect.NewRequest(&paymentv1.PaymentRequest{
+		CardToken:    "tok_integration_disputes_1",
+		Card:         paymentv1.CardType_CARD_TYPE_CREDIT,
+		Name:         "Disputes",
+		AddressLines: []string{"1 Dispute Way"},
+		Amount:       &money.Money{CurrencyCode: "USD", Units: 100},
+		Capture:      true,
+	}))
+	require.NoError(t, err, "MakePayment failed")
+	id := res.Msg.GetId()
+
+	refund, err := client.CreateRefund(ctx, connect.NewRequest(&paymentv1.CreateRefundRequest{
+		PaymentId: id,
+		Amount:    &money.Money{CurrencyCode: "USD", Units: 20},
+		Reason:    paymentv1.RefundReason_REFUND_REASON_REQUESTED_BY_CUSTOMER,
+		Note:      "damaged in transit",
+	}))
+	require.NoError(t, err, "CreateRefund failed")
+	require.Equal(t, id, refund.Msg.GetPaymentId())
+	require.Equal(t, int64(20), refund.Msg.GetAmount().GetUnits())
+
+	got, err := client.GetRefund(ctx, connect.NewRequest(&paymentv1.GetRefundRequest{Id: refund.Msg.GetId()}))
+	require.NoError(t, err, "GetRefund failed")
+	require.Equal(t, "damaged in transit", got.Msg.GetNote())
+
+	_, err = client.CreateRefund(ctx, connect.NewRequest(&paymentv1.CreateRefundRequest{
+		PaymentId: id,
+		Amount:    &money.Money{CurrencyCode: "USD", Units: 81},
+	}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "refunds must not exceed the captured amount")
+
+	dispute, err := client.CreateDispute(ctx, connect.NewRequest(&paymentv1.CreateDisputeRequest{
+		PaymentId: id,
+		Amount:    &money.Money{CurrencyCode: "USD", Units: 30},
+		Reason:    "product not received",
+	}))
+	require.NoError(t, err, "CreateDispute failed")
+	require.Equal(t, paymentv1.DisputeStatus_DISPUTE_STATUS_OPEN, dispute.Msg.GetStatus())
+	require.NotNil(t, dispute.Msg.GetEvidenceDueTime())
+
+	_, err = client.CreateDispute(ctx, connect.NewRequest(&paymentv1.CreateDisputeRequest{PaymentId: id}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "a payment has one open dispute at a time")
+
+	withEvidence, err := client.AddDisputeEvidence(ctx, connect.NewRequest(&paymentv1.AddDisputeEvidenceRequest{
+		Id:   dispute.Msg.GetId(),
+		Note: "carrier tracking shows delivery",
+	}))
+	require.NoError(t, err, "AddDisputeEvidence failed")
+	require.Len(t, withEvidence.Msg.GetEvidence(), 1)
+
+	lost, err := client.ResolveDispute(ctx, connect.NewRequest(&paymentv1.ResolveDisputeRequest{
+		Id:      dispute.Msg.GetId(),
+		Outcome: paymentv1.DisputeStatus_DISPUTE_STATUS_LOST,
+	}))
+	require.NoError(t, err, "ResolveDispute failed")
+	require.Equal(t, paymentv1.DisputeStatus_DISPUTE_STATUS_LOST, lost.Msg.GetStatus())
+	require.NotNil(t, lost.Msg.GetResolveTime())
+
+	_, err = client.AddDisputeEvidence(ctx, connect.NewRequest(&paymentv1.AddDisputeEvidenceRequest{Id: dispute.Msg.GetId(), Note: "late"}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "resolved disputes take no evidence")
+
+	p, err := client.GetPayment(ctx, connect.NewRequest(&paymentv1.GetPaymentRequest{Id: id}))
+	require.NoError(t, err, "GetPayment failed")
+	require.Equal(t, int64(30), p.Msg.GetDisputedAmount().GetUnits())
+	require.Equal(t, int64(50), p.Msg.GetNetAmount().GetUnits(), "net is captured less refunds and lost disputes")
+
+	_, err = client.CreateRefund(ctx, connect.NewRequest(&paymentv1.CreateRefundRequest{
+		PaymentId: id,
+		Amount:    &money.Money{CurrencyCode: "USD", Units: 51},
+	}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "lost disputes are not refundable")
+
+	refunds, err := client.ListRefunds(ctx, connect.NewRequest(&paymentv1.ListRefundsRequest{PaymentId: id}))
+	require.NoError(t, err, "ListRefunds failed")
+	require.Len(t, refunds.Msg.GetRefunds(), 1)
+
+	disputes, err := client.ListDisputes(ctx, connect.NewRequest(&paymentv1.ListDisputesRequest{
+		PaymentId: id,
+		Status:    paymentv1.DisputeStatus_DISPUTE_STATUS_LOST,
+	}))
+	require.NoError(t, err, "ListDisputes failed")
+	require.Len(t, disputes.Msg.GetDisputes(), 1)
+}
+
+func TestLostDisputeReopensInvoice(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+	receivable := func() int64 {
+		res, err := client.GetLedgerBalances(ctx, connect.NewRequest(&paymentv1.GetLedgerBalancesRequest{Account: "accounts_receivable"}))
+		require.NoError(t, err, "GetLedgerBalances failed")
+		for _, b := range res.Msg.GetBalances() {
+			if b.GetBalance().GetCurrencyCode() == "USD" {
+				return b.GetBalance().GetUnits()
+			}
+		}
+		return 0
+	}
+
+	inv := newInvoice(t, client, "integration-dispute-invoice")
+	paid, err := client.PayInvoices(ctx, connect.NewRequest(&paymentv1.PayInvoicesRequest{
+		CardToken:   "tok_integration_dispute_invoice",
+		Card:        paymentv1.CardType_CARD_TYPE_DEBIT,
+		Allocations: []*paymentv1.InvoiceAllocation{{InvoiceId: inv.GetId()}},
+	}))
+	require.NoError(t, err, "PayInvoices failed")
+	require.True(t, paid.Msg.GetInvoices()[0].GetPaid())
+
+	dispute, err := client.CreateDispute(ctx, connect.NewRequest(&paymentv1.CreateDisputeRequest{
+		PaymentId: paid.Msg.GetPayment().GetId(),
+		Amount:    &money.Money{CurrencyCode: "USD", Units: 10},
+		Reason:    "not received",
+	}))
+	require.NoError(t, err, "CreateDispute failed")
+
+	before := receivable()
+	_, err = client.ResolveDispute(ctx, connect.NewRequest(&paymentv1.ResolveDisputeRequest{
+		Id:      dispute.Msg.GetId(),
+		Outcome: paymentv1.DisputeStatus_DISPUTE_STATUS_LOST,
+	}))
+	require.NoError(t, err, "ResolveDispute failed")
+
+	// Losing the dispute takes the amount back off the invoice it paid.
+	got, err := client.GetInvoice(ctx, connect.NewRequest(&paymentv1.GetInvoiceRequest{Id: inv.GetId()}))
+	require.NoError(t, err, "GetInvoice failed")
+	require.False(t, got.Msg.GetPaid())
+	require.Equal(t, paymentv1.InvoiceState_INVOICE_STATE_OPEN, got.Msg.GetState())
+	require.Equal(t, int64(10), got.Msg.GetAmountDue().GetUnits())
+	require.Equal(t, before+10, receivable(), "the reopened amount is receivable again")
+}
+
+func TestPaymentDeclines(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	tests := []struct {
+		token  string
+		code   connect.Code
+		reason string
+	}{
+		{token: "tok_decline", code: connect.CodeFailedPrecondition, reason: "CARD_DECLINED"},
+		{token: "tok_insufficient_funds", code: connect.CodeFailedPrecondition, reason: "INSUFFICIENT_FUNDS"},
+		{token: "tok_processor_unavailable", code: connect.CodeUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.token, func(t *testing.T) {
+			_, err := client.MakePayment(context.Background(), connect.NewRequest(&paymentv1.PaymentRequest{
+				CardToken:    tt.token,
+				Card:         paymentv1.CardType_CARD_TYPE_DEBIT,
+				Name:         "Declined",
+				AddressLines: []string{"1 Decline Road"},
+				Amount:       &money.Money{CurrencyCode: "USD", Units: 10},
+			}))
+			require.Equal(t, tt.code, connect.CodeOf(err))
+			if tt.reason == "" {
+				return
+			}
+			var connectErr *connect.Error
+			require.ErrorAs(t, err, &connectErr)
+			var reason string
+			for _, d := range connectErr.Details() {
+				v, derr := d.Value()
+				require.NoError(t, derr)
+				if info, ok := v.(*errdetails.ErrorInfo); ok {
+					reason = info.GetReason()
+				}
+			}
+			require.Equal(t, tt.reason, reason)
+		})
+	}
+}
+
+func TestPaymentFraudChecks(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+
+	ok, err := client.MakePayment(ctx, connect.NewRequest(&paymentv1.PaymentRequest{
+		CardToken:    "tok_fraud_ok_" + time.Now().Format("150405.000000"),
+		Card:         paymentv1.CardType_CARD_TYPE_DEBIT,
+		Name:         "Careful Shopper",
+		AddressLines: []string{"1 Safe Street"},
+		Amount:       &money.Money{CurrencyCode: "USD", Units: 10},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, paymentv1.FraudDecision_FRAUD_DECISION_ALLOW, ok.Msg.GetFraudDecision())
+	require.Empty(t, ok.Msg.GetFraudReasons())
+
+	tests := []struct {
+		name   string
+		holder string
+		amount *money.Money
+		reason string
+	}{
+		{name: "amount limit", holder: "Big Spender", amount: &money.Money{CurrencyCode: "USD", Units: 10001}, reason: "AMOUNT_LIMIT"},
+		{name: "blocked name", holder: "Fraud Test Holder", amount: &money.Money{CurrencyCode: "USD", Units: 10}, reason: "BLOCKED_NAME"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.MakePayment(ctx, connect.NewRequest(&paymentv1.PaymentRequest{
+				CardToken:    "tok_fraud_" + time.Now().Format("150405.000000"),
+				Card:         paymentv1.CardType_CARD_TYPE_DEBIT,
+				Name:         tt.holder,
+				AddressLines: []string{"1 Risky Road"},
+				Amount:       tt.amount,
+			}))
+			require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+			var connectErr *connect.Error
+			require.ErrorAs(t, err, &connectErr)
+			var info *errdetails.ErrorInfo
+			for _, d := range connectErr.Details() {
+				v, derr := d.Value()
+				require.NoError(t, derr)
+				if i, ok := v.(*errdetails.ErrorInfo); ok {
+					info = i
+				}
+			}
+			require.NotNil(t, info)
+			require.Equal(t, "PAYMENT_DENIED", info.GetReason())
+			require.Equal(t, "fraud.grpc-buf", info.GetDomain())
+			require.Contains(t, info.GetMetadata()["reasons"], tt.reason)
+		})
+	}
+}
+
+func TestGetAndListPayments(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+	start := time.Now().Add(-time.Minute)
+
+	made, err := client.MakePayment(ctx, connect.NewRequest(&paymentv1.PaymentRequest{
+		CardToken:    "tok_integration_list_4242",
+		Card:         paymentv1.CardType_CARD_TYPE_CREDIT,
+		Name:         "Lister",
+		AddressLines: []string{"1 List Lane"},
+		Amount:       &money.Money{CurrencyCode: "EUR", Units: 7},
+	}))
+	require.NoError(t, err, "MakePayment failed")
+
+	got, err := client.GetPayment(ctx, connect.NewRequest(&paymentv1.GetPaymentRequest{Id: made.Msg.GetId()}))
+	require.NoError(t, err, "GetPayment failed")
+	require.Equal(t, "4242", got.Msg.GetCardTokenLast4())
+	require.Equal(t, paymentv1.PaymentStatus_PAYMENT_STATUS_AUTHORIZED, got.Msg.GetStatus())
+
+	list, err := client.ListPayments(ctx, connect.NewRequest(&paymentv1.ListPaymentsRequest{
+		Status:       paymentv1.PaymentStatus_PAYMENT_STATUS_AUTHORIZED,
+		CurrencyCode: "EUR",
+		StartTime:    timestamppb.New(start),
+		PageSize:     1000,
+	}))
+	require.NoError(t, err, "ListPayments failed")
+	var found bool
+	for _, p := range list.Msg.GetPayments() {
+		require.Equal(t, "EUR", p.GetAmount().GetCurrencyCode())
+		found = found || p.GetId() == made.Msg.GetId()
+	}
+	require.True(t, found, "new payment missing from ListPayments")
+
+	_, err = client.GetPayment(ctx, connect.NewRequest(&paymentv1.GetPaymentRequest{Id: "00000000-0000-0000-0000-000000000000"}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestPaymentCardTypeAndAddress(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+	address := &postaladdress.PostalAddress{
+		RegionCode:   "US",
+		PostalCode:   "94105",
+		Locality:     "San Francisco",
+		AddressLines: []string{"1 Market St", "Suite 300"},
+	}
+
+	_, err := client.MakePayment(ctx, connect.NewRequest(&paymentv1.PaymentRequest{
+		CardToken:      "tok_integration_card_type",
+		Name:           "No Card Type",
+		BillingAddress: address,
+		Amount:         &money.Money{CurrencyCode: "USD", Units: 3},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	made, err := client.MakePayment(ctx, connect.NewRequest(&paymentv1.PaymentRequest{
+		CardToken:      "tok_integration_card_type",
+		Card:           paymentv1.CardType_CARD_TYPE_MASTERCARD,
+		Name:           "Full Address",
+		BillingAddress: address,
+		Amount:         &money.Money{CurrencyCode: "USD", Units: 3},
+	}))
+	require.NoError(t, err, "MakePayment failed")
+
+	got, err := client.GetPayment(ctx, connect.NewRequest(&paymentv1.GetPaymentRequest{Id: made.Msg.GetId()}))
+	require.NoError(t, err, "GetPayment failed")
+	require.Equal(t, paymentv1.CardType_CARD_TYPE_MASTERCARD, got.Msg.GetCardType())
+	require.Equal(t, address.GetAddressLines(), got.Msg.GetBillingAddress().GetAddressLines())
+	require.Equal(t, "94105", got.Msg.GetBillingAddress().GetPostalCode())
+}
+
+func TestPaymentMinorUnits(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+	pay := func(amount *money.Money) (*connect.Response[paymentv1.PaymentResponse], error) {
+		return client.MakePayment(ctx, connect.NewRequest(&paymentv1.PaymentRequest{
+			CardToken:      "tok_integration_minor_units",
+			Card:           paymentv1.CardType_CARD_TYPE_DEBIT,
+			Name:           "Minor Units",
+			BillingAddress: &postaladdress.PostalAddress{AddressLines: []string{"1 Yen Street"}},
+			Amount:         amount,
+		}))
+	}
+
+	for _, amount := range []*money.Money{
+		{CurrencyCode: "JPY", Units: 500},
+		{CurrencyCode: "KWD", Units: 1, Nanos: 234_000_000},
+	} {
+		made, err := pay(amount)
+		require.NoError(t, err, "MakePayment %s failed", amount.GetCurrencyCode())
+		got, err := client.GetPayment(ctx, connect.NewRequest(&paymentv1.GetPaymentRequest{Id: made.Msg.GetId()}))
+		require.NoError(t, err, "GetPayment failed")
+		require.Equal(t, amount.GetUnits(), got.Msg.GetAmount().GetUnits())
+		require.Equal(t, amount.GetNanos(), got.Msg.GetAmount().GetNanos())
+	}
+
+	_, err := pay(&money.Money{CurrencyCode: "USD", Units: 1, Nanos: 5_000_000})
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "sub-cent amounts must be rejected")
+	_, err = pay(&money.Money{CurrencyCode: "USD", Units: 1, Nanos: -500_000_000})
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "mixed-sign amounts must be rejected")
+}
+
+func TestSummarizePayments(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+
+	_, err := client.SummarizePayments(ctx, connect.NewRequest(&paymentv1.SummarizePaymentsRequest{TargetCurrency: "XXX"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "unknown target currency must be rejected")
+
+	// A window with no payments needs no rates and sums to zero.
+	res, err := client.SummarizePayments(ctx, connect.NewRequest(&paymentv1.SummarizePaymentsRequest{
+		TargetCurrency: "EUR",
+		StartTime:      timestamppb.New(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)),
+		EndTime:        timestamppb.New(time.Date(2100, 1, 2, 0, 0, 0, 0, time.UTC)),
+	}))
+	require.NoError(t, err, "SummarizePayments failed")
+	require.Equal(t, "EUR", res.Msg.GetTotal().GetCurrencyCode())
+	require.Zero(t, res.Msg.GetTotal().GetUnits())
+	require.Empty(t, res.Msg.GetByCurrency())
+}
+
+func TestLedgerBalances(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+	cash := func() int64 {
+		res, err := client.GetLedgerBalances(ctx, connect.NewRequest(&paymentv1.GetLedgerBalancesRequest{Account: "cash"}))
+		require.NoError(t, err, "GetLedgerBalances failed")
+		for _, b := range res.Msg.GetBalances() {
+			if b.GetBalance().GetCurrencyCode() == "CHF" {
+				return b.GetBalance().GetUnits()
+			}
+		}
+		return 0
+	}
+
+	before := cash()
+	_, err := client.MakePayment(ctx, connect.NewRequest(&paymentv1.PaymentRequest{
+		CardToken:      "tok_integration_ledger",
+		Card:           paymentv1.CardType_CARD_TYPE_CREDIT,
+		Name:           "Ledger",
+		BillingAddress: &postaladdress.PostalAddress{AddressLines: []string{"1 Ledger Lane"}},
+		Amount:         &money.Money{CurrencyCode: "CHF", Units: 7},
+		Capture:        true,
+	}))
+	require.NoError(t, err, "MakePayment failed")
+	require.Equal(t, before+7, cash(), "a captured payment must debit cash")
+
+	_, err = client.GetLedgerBalances(ctx, connect.NewRequest(&paymentv1.GetLedgerBalancesRequest{Account: "nope"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "unknown accounts must be rejected")
+}
+
+func TestInvoiceDunning(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+
+	// Due a month ago: the next dunning run marks it overdue and sends only
+	// the latest reminder of the default 3/7/14-day schedule.
+	due := time.Now().UTC().AddDate(0, -1, 0)
+	created, err := client.CreateInvoice(ctx, connect.NewRequest(&paymentv1.CreateInvoiceRequest{
+		Invoice: &paymentv1.Invoice{
+			InvoiceName: "integration-dunning",
+			LineItems: []*paymentv1.InvoiceLineItem{
+				{Description: "Late", Quantity: 1, UnitPrice: &money.Money{CurrencyCode: "USD", Units: 9}},
+			},
+			DueDate: &date.Date{Year: int32(due.Year()), Month: int32(due.Month()), Day: int32(due.Day())},
+		},
+	}))
+	require.NoError(t, err, "CreateInvoice failed")
+
+	var got *paymentv1.Invoice
+	require.Eventually(t, func() bool {
+		res, err := client.GetInvoice(ctx, connect.NewRequest(&paymentv1.GetInvoiceRequest{Id: created.Msg.GetId()}))
+		if err != nil {
+			return false
+		}
+		got = res.Msg
+		return got.GetState() == paymentv1.InvoiceState_INVOICE_STATE_OVERDUE && got.GetRemindersSent() > 0
+	}, 30*time.Second, 500*time.Millisecond, "invoice never became overdue")
+	require.Equal(t, int32(3), got.GetRemindersSent())
+
+	paid, err := client.MarkInvoicePaid(ctx, connect.NewRequest(&paymentv1.MarkInvoicePaidRequest{Id: created.Msg.GetId()}))
+	require.NoError(t, err, "overdue invoices must stay payable")
+	require.Equal(t, paymentv1.InvoiceState_INVOICE_STATE_PAID, paid.Msg.GetState())
+
+	_, err = client.CreateInvoice(ctx, connect.NewRequest(&paymentv1.CreateInvoiceRequest{
+		Invoice: &paymentv1.Invoice{
+			InvoiceName: "bad-due-date",
+			LineItems: []*paymentv1.InvoiceLineItem{
+				{Description: "x", Quantity: 1, UnitPrice: &money.Money{CurrencyCode: "USD", Units: 1}},
+			},
+			DueDate: &date.Date{Year: 2026, Month: 2, Day: 30},
+		},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestInvoicePartialPayments(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+
+	create := func(name string, units int64) *paymentv1.Invoice {
+		res, err := client.CreateInvoice(ctx, connect.NewRequest(&paymentv1.CreateInvoiceRequest{
+			Invoice: &paymentv1.Invoice{
+				InvoiceName: name,
+				LineItems: []*paymentv1.InvoiceLineItem{
+					{Description: name, Quantity: 1, UnitPrice: &money.Money{CurrencyCode: "USD", Units: units}},
+				},
+			},
+		}))
+		require.NoError(t, err, "CreateInvoice failed")
+		return res.Msg
+	}
+
+	// 30 USD paid in two instalments; only the second settles it.
+	inv := create("integration-instalments", 30)
+	first, err := client.PayInvoice(ctx, connect.NewRequest(&paymentv1.PayInvoiceRequest{
+		Id:        inv.GetId(),
+		CardToken: "tok_integration_instalment",
+		Card:      paymentv1.CardType_CARD_TYPE_DEBIT,
+		Amount:    &money.Money{CurrencyCode: "USD", Units: 10},
+	}))
+	require.NoError(t, err, "first instalment failed")
+	require.Equal(t, paymentv1.InvoiceState_INVOICE_STATE_OPEN, first.Msg.GetState())
+	require.Equal(t, int64(10), first.Msg.GetAmountPaid().GetUnits())
+	require.Equal(t, int64(20), first.Msg.GetAmountDue().GetUnits())
+
+	_, err = client.PayInvoice(ctx, connect.NewRequest(&paymentv1.PayInvoiceRequest{
+		Id:        inv.GetId(),
+		CardToken: "tok_integration_instalment",
+		Card:      paymentv1.CardType_CARD_TYPE_DEBIT,
+		Amount:    &money.Money{CurrencyCode: "USD", Units: 21},
+	}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "overpayment must be rejected")
+
+	_, err = client.CancelInvoice(ctx, connect.NewRequest(&paymentv1.CancelInvoiceRequest{Id: inv.GetId()}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "partially paid invoices cannot be cancelled")
+
+	second, err := client.PayInvoice(ctx, connect.NewRequest(&paymentv1.PayInvoiceRequest{
+		Id:        inv.GetId(),
+		CardToken: "tok_integration_instalment",
+		Card:      paymentv1.CardType_CARD_TYPE_DEBIT,
+	}))
+	require.NoError(t, err, "final instalment failed")
+	require.Equal(t, paymentv1.InvoiceState_INVOICE_STATE_PAID, second.Msg.GetState())
+	require.Equal(t, int64(30), second.Msg.GetAmountPaid().GetUnits())
+	require.Equal(t, int64(0), second.Msg.GetAmountDue().GetUnits())
+
+	// One payment covering one invoice in full and another in part.
+	a := create("integration-allocation-a", 5)
+	b := create("integration-allocation-b", 8)
+	res, err := client.PayInvoices(ctx, connect.NewRequest(&paymentv1.PayInvoicesRequest{
+		CardToken: "tok_integration_allocation",
+		Card:      paymentv1.CardType_CARD_TYPE_DEBIT,
+		Allocations: []*paymentv1.InvoiceAllocation{
+			{InvoiceId: a.GetId()},
+			{InvoiceId: b.GetId(), Amount: &money.Money{CurrencyCode: "USD", Units: 3}},
+		},
+	}))
+	require.NoError(t, err, "PayInvoices failed")
+	require.Equal(t, int64(8), res.Msg.GetPayment().GetAmount().GetUnits())
+	require.Equal(t, paymentv1.CardType_CARD_TYPE_DEBIT, res.Msg.GetPayment().GetCardType())
+	require.Empty(t, res.Msg.GetPayment().GetInvoiceId(), "a payment across invoices has no single invoice")
+	require.Len(t, res.Msg.GetInvoices(), 2)
+	require.Equal(t, paymentv1.InvoiceState_INVOICE_STATE_PAID, res.Msg.GetInvoices()[0].GetState())
+	require.Equal(t, paymentv1.InvoiceState_INVOICE_STATE_OPEN, res.Msg.GetInvoices()[1].GetState())
+	require.Equal(t, int64(5), res.Msg.GetInvoices()[1].GetAmountDue().GetUnits())
+
+	_, err = client.PayInvoices(ctx, connect.NewRequest(&paymentv1.PayInvoicesRequest{
+		CardToken: "tok_integration_allocation",
+		Card:      paymentv1.CardType_CARD_TYPE_DEBIT,
+		Allocations: []*paymentv1.InvoiceAllocation{
+			{InvoiceId: b.GetId()},
+			{InvoiceId: b.GetId()},
+		},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestReconcileSettlement(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+
+	// Odd amounts keep other tests' payments from matching these rows.
+	cents := 100000 + time.Now().UnixNano()%90000
+	pay := func(minor int64) string {
+		res, err := client.MakePayment(ctx, connect.NewRequest(&paymentv1.PaymentRequest{
+			CardToken:    "tok_reconcile",
+			Card:         paymentv1.CardType_CARD_TYPE_DEBIT,
+			Name:         "Reconcile",
+			AddressLines: []string{"1 Ledger Lane"},
+			Amount:       &money.Money{CurrencyCode: "USD", Units: minor / 100, Nanos: int32(minor%100) * 10_000_000},
+			Capture:      true,
+		}))
+		require.NoError(t, err, "MakePayment failed")
+		return res.Msg.GetId()
+	}
+	settledID := pay(cents)
+	unsettledID := pay(cents + 1)
+
+	today := time.Now().UTC().Format(time.DateOnly)
+	csv := "date,reference,amount,currency\n" +
+		today + ",," + fmt.Sprintf("%d.%02d", cents/100, cents%100) + ",USD\n" +
+		today + ",ref_not_ours,1.23,USD\n"
+	res, err := client.ReconcileSettlement(ctx, connect.NewRequest(&paymentv1.ReconcileSettlementRequest{
+		SettlementCsv: []byte(csv),
+		ReportFormat:  paymentv1.ReportFormat_REPORT_FORMAT_JSON,
+	}))
+	require.NoError(t, err, "ReconcileSettlement failed")
+	require.Equal(t, "application/json", res.Msg.GetReportContentType())
+	require.NotEmpty(t, res.Msg.GetReport())
+
+	statuses := map[string]paymentv1.ReconciliationStatus{}
+	for _, it := range res.Msg.GetItems() {
+		if it.GetPaymentId() != "" {
+			statuses[it.GetPaymentId()] = it.GetStatus()
+		}
+		if it.GetReference() == "ref_not_ours" {
+			require.Equal(t, paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_MISSING_OURS, it.GetStatus())
+			require.Equal(t, int32(3), it.GetLine())
+		}
+	}
+	require.Equal(t, paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_MATCHED, statuses[settledID])
+	require.Equal(t, paymentv1.ReconciliationStatus_RECONCILIATION_STATUS_MISSING_PROVIDER, statuses[unsettledID])
+	require.GreaterOrEqual(t, res.Msg.GetMissingOurs(), int32(1))
+
+	_, err = client.ReconcileSettlement(ctx, connect.NewRequest(&paymentv1.ReconcileSettlementRequest{
+		SettlementCsv: []byte("date,amount\n"),
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
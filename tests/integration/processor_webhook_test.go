@@ -0,0 +1,204 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"github.com/grpc-buf/internal/gen/proto/payment/paymentv1connect"
+	"github.com/grpc-buf/internal/webhook"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+// processorWebhookSecret matches payments.processor.webhook_secret in
+// config/local.yaml.
+const processorWebhookSecret = "insecure-dev-webhook-secret"
+
+func postProcessorEvent(t *testing.T, body string, at time.Time) (int, string) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, "http://localhost:8080/webhooks/processor", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(at.Unix(), 10))
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign([]byte(processorWebhookSecret), at, []byte(body)))
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var out struct {
+		Status string `json:"status"`
+	}
+	_ = json.NewDecoder(resp.Body).Decode(&out)
+	return resp.StatusCode, out.Status
+}
+
+func TestProcessorWebhook(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+	now := time.Now()
+	suffix := strconv.FormatInt(now.UnixNano(), 36)
+
+	inv, err := client.CreateInvoice(ctx, connect.NewRequest(&paymentv1.CreateInvoiceRequest{
+		Invoice: &paymentv1.Invoice{
+			InvoiceName: "integration-provider-invoice",
+			LineItems: []*paymentv1.InvoiceLineItem{
+				{Description: "Provider test", Quantity: 1, UnitPrice: &money.Money{CurrencyCode: "USD", Units: 12}},
+			},
+		},
+	}))
+	require.NoError(t, err, "CreateInvoice failed")
+
+	paid := fmt.Sprintf(`{"id":"evt_paid_%s","type":"invoice.paid","data":{"invoice_id":%q}}`, suffix, inv.Msg.GetId())
+	code, st := postProcessorEvent(t, paid, now)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "applied", st)
+
+	got, err := client.GetInvoice(ctx, connect.NewRequest(&paymentv1.GetInvoiceRequest{Id: inv.Msg.GetId()}))
+	require.NoError(t, err, "GetInvoice failed")
+	require.True(t, got.Msg.GetPaid())
+
+	code, st = postProcessorEvent(t, paid, now)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "duplicate", st)
+
+	code, st = postProcessorEvent(t, fmt.Sprintf(`{"id":"evt_ref_%s","type":"payment.settled","data":{"processor_ref":"fake_missing"}}`, suffix), now)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "rejected", st)
+
+	code, st = postProcessorEvent(t, fmt.Sprintf(`{"id":"evt_badinv_%s","type":"invoice.paid","data":{"invoice_id":"inv_not_a_uuid"}}`, suffix), now)
+	require.Equal(t, http.StatusOK, code, "a malformed invoice_id must not be redelivered")
+	require.Equal(t, "rejected", st)
+
+	code, st = postProcessorEvent(t, fmt.Sprintf(`{"id":"evt_new_%s","type":"payout.created","data":{}}`, suffix), now)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "unhandled", st)
+
+	code, _ = postProcessorEvent(t, fmt.Sprintf(`{"id":"evt_old_%s","type":"invoice.paid"}`, suffix), now.Add(-time.Hour))
+	require.Equal(t, http.StatusUnauthorized, code, "stale events must be rejected")
+}
+
+func TestProcessorChargebackOpensLostDispute(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+	now := time.Now()
+	suffix := strconv.FormatInt(now.UnixNano(), 36)
+
+	res, err := client.MakePayment(ctx, connect.NewRequest(&paymentv1.PaymentRequest{
+		CardToken:    "tok_integration_chargeback",
+		Card:         paymentv1.CardType_CARD_TYPE_CREDIT,
+		Name:         "Chargeback",
+		AddressLines: []string{"1 Chargeback Way"},
+		Amount:       &money.Money{CurrencyCode: "USD", Units: 10},
+		Capture:      true,
+	}))
+	require.NoError(t, err, "MakePayment failed")
+	id := res.Msg.GetId()
+
+	conn, err := pgx.Connect(ctx, testDSN())
+	require.NoError(t, err, "connect to database")
+	defer conn.Close(ctx)
+	var ref string
+	require.NoError(t, conn.QueryRow(ctx, `SELECT processor_ref FROM payments WHERE id=$1`, id).Scan(&ref))
+
+	ev := fmt.Sprintf(`{"id":"evt_cb_%s","type":"payment.chargeback","data":{"processor_ref":%q,"amount":400,"currency":"USD"}}`, suffix, ref)
+	code, st := postProcessorEvent(t, ev, now)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "applied", st)
+
+	p, err := client.GetPayment(ctx, connect.NewRequest(&paymentv1.GetPaymentRequest{Id: id}))
+	require.NoError(t, err, "GetPayment failed")
+	require.Equal(t, paymentv1.PaymentStatus_PAYMENT_STATUS_CAPTURED, p.Msg.GetStatus(), "a chargeback is not a refund")
+	require.Equal(t, int64(4), p.Msg.GetDisputedAmount().GetUnits())
+	require.Equal(t, int64(6), p.Msg.GetNetAmount().GetUnits())
+
+	ds, err := client.ListDisputes(ctx, connect.NewRequest(&paymentv1.ListDisputesRequest{PaymentId: id}))
+	require.NoError(t, err, "ListDisputes failed")
+	require.Len(t, ds.Msg.GetDisputes(), 1)
+	require.Equal(t, paymentv1.DisputeStatus_DISPUTE_STATUS_LOST, ds.Msg.GetDisputes()[0].GetStatus())
+	require.Equal(t, int64(4), ds.Msg.GetDisputes()[0].GetAmount().GetUnits())
+}
+
+func TestProcessorChargebackReopensPartiallyRefundedInvoice(t *testing.T) {
+	client := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+		loginWithRoles(t, "chargeback-finance@example.com", "user", "finance"),
+	)
+	ctx := context.Background()
+	now := time.Now()
+	suffix := strconv.FormatInt(now.UnixNano(), 36)
+
+	inv := newInvoice(t, client, "integration-chargeback-invoice")
+	_, err := client.PayInvoice(ctx, connect.NewRequest(&paymentv1.PayInvoiceRequest{
+		Id:        inv.GetId(),
+		CardToken: "tok_integration_invoice_chargeback",
+		Card:      paymentv1.CardType_CARD_TYPE_DEBIT,
+	}))
+	require.NoError(t, err, "PayInvoice failed")
+
+	conn, err := pgx.Connect(ctx, testDSN())
+	require.NoError(t, err, "connect to database")
+	defer conn.Close(ctx)
+	var id, ref string
+	require.NoError(t, conn.QueryRow(ctx,
+		`SELECT p.id, p.processor_ref FROM payments p JOIN invoice_payments ip ON ip.payment_id = p.id WHERE ip.invoice_id=$1`,
+		inv.GetId()).Scan(&id, &ref))
+
+	_, err = client.RefundPayment(ctx, connect.NewRequest(&paymentv1.RefundPaymentRequest{
+		Id:     id,
+		Amount: &money.Money{CurrencyCode: "USD", Units: 5},
+	}))
+	require.NoError(t, err, "partial RefundPayment failed")
+
+	getInvoice := func() *paymentv1.Invoice {
+		t.Helper()
+		got, err := client.GetInvoice(ctx, connect.NewRequest(&paymentv1.GetInvoiceRequest{Id: inv.GetId()}))
+		require.NoError(t, err, "GetInvoice failed")
+		return got.Msg
+	}
+
+	// A partial chargeback reopens only the amount charged back.
+	ev := fmt.Sprintf(`{"id":"evt_cb1_%s","type":"payment.chargeback","data":{"processor_ref":%q,"amount":800,"currency":"USD"}}`, suffix, ref)
+	code, st := postProcessorEvent(t, ev, now)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "applied", st)
+	got := getInvoice()
+	require.False(t, got.GetPaid())
+	require.Equal(t, paymentv1.InvoiceState_INVOICE_STATE_OPEN, got.GetState())
+	require.Equal(t, int64(17), got.GetAmountPaid().GetUnits())
+	require.Equal(t, int64(8), got.GetAmountDue().GetUnits())
+
+	// The rest of the net amount: the refunded part is not taken off again.
+	ev = fmt.Sprintf(`{"id":"evt_cb2_%s","type":"payment.chargeback","data":{"processor_ref":%q}}`, suffix, ref)
+	code, st = postProcessorEvent(t, ev, now)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "applied", st)
+	got = getInvoice()
+	require.Equal(t, int64(5), got.GetAmountPaid().GetUnits())
+	require.Equal(t, int64(20), got.GetAmountDue().GetUnits())
+
+	var receivable int64
+	require.NoError(t, conn.QueryRow(ctx,
+		`SELECT COALESCE(SUM(debit_minor), 0) FROM ledger_entries
+         WHERE kind='invoice.reopened' AND account='accounts_receivable' AND invoice_id=$1`, inv.GetId()).Scan(&receivable))
+	require.Equal(t, int64(2000), receivable, "the ledger reopens what the invoice gives back")
+}
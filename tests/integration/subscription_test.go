@@ -0,0 +1,110 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"github.com/grpc-buf/internal/gen/proto/payment/paymentv1connect"
+	subscriptionv1 "github.com/grpc-buf/internal/gen/proto/subscription"
+	"github.com/grpc-buf/internal/gen/proto/subscription/subscriptionv1connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+func TestSubscriptionLifecycle(t *testing.T) {
+	client := subscriptionv1connect.NewSubscriptionServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	payments := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+
+	// Starting today, the first period is due right away: the next scheduler
+	// run invoices it and pays it with the stored card.
+	today := time.Now().UTC()
+	created, err := client.CreateSubscription(ctx, connect.NewRequest(&subscriptionv1.CreateSubscriptionRequest{
+		Subscription: &subscriptionv1.Subscription{
+			Name:       "integration-plan",
+			Price:      &money.Money{CurrencyCode: "USD", Units: 12},
+			TaxRateBps: 1000,
+			Interval:   subscriptionv1.BillingInterval_BILLING_INTERVAL_MONTHLY,
+			StartDate:  &date.Date{Year: int32(today.Year()), Month: int32(today.Month()), Day: int32(today.Day())},
+			CardToken:  "tok_integration_subscription",
+			Card:       paymentv1.CardType_CARD_TYPE_CREDIT,
+		},
+	}))
+	require.NoError(t, err, "CreateSubscription failed")
+	require.Equal(t, subscriptionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE, created.Msg.GetStatus())
+	require.Equal(t, int32(today.Day()), created.Msg.GetAnchorDay())
+	require.Equal(t, "tion", created.Msg.GetCardToken(), "only the last four characters of the card token are returned")
+	require.Equal(t, paymentv1.CardType_CARD_TYPE_CREDIT, created.Msg.GetCard())
+	id := created.Msg.GetId()
+
+	var sub *subscriptionv1.Subscription
+	require.Eventually(t, func() bool {
+		res, err := client.GetSubscription(ctx, connect.NewRequest(&subscriptionv1.GetSubscriptionRequest{Id: id}))
+		if err != nil {
+			return false
+		}
+		sub = res.Msg
+		return sub.GetLatestInvoiceId() != ""
+	}, 30*time.Second, 500*time.Millisecond, "subscription was never invoiced")
+	require.Greater(t, sub.GetNextBillingDate().GetMonth()+12*sub.GetNextBillingDate().GetYear(),
+		int32(today.Month())+12*int32(today.Year()), "next billing date must advance a period")
+
+	var inv *paymentv1.Invoice
+	require.Eventually(t, func() bool {
+		res, err := payments.GetInvoice(ctx, connect.NewRequest(&paymentv1.GetInvoiceRequest{Id: sub.GetLatestInvoiceId()}))
+		if err != nil {
+			return false
+		}
+		inv = res.Msg
+		return inv.GetState() == paymentv1.InvoiceState_INVOICE_STATE_PAID
+	}, 10*time.Second, 200*time.Millisecond, "subscription invoice was never paid")
+	require.Equal(t, id, inv.GetSubscriptionId())
+	require.Equal(t, int64(13), inv.GetAmount().GetUnits())
+	require.Equal(t, int32(200_000_000), inv.GetAmount().GetNanos())
+
+	paused, err := client.PauseSubscription(ctx, connect.NewRequest(&subscriptionv1.PauseSubscriptionRequest{Id: id}))
+	require.NoError(t, err, "PauseSubscription failed")
+	require.Equal(t, subscriptionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_PAUSED, paused.Msg.GetStatus())
+
+	_, err = client.PauseSubscription(ctx, connect.NewRequest(&subscriptionv1.PauseSubscriptionRequest{Id: id}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	resumed, err := client.ResumeSubscription(ctx, connect.NewRequest(&subscriptionv1.ResumeSubscriptionRequest{Id: id}))
+	require.NoError(t, err, "ResumeSubscription failed")
+	require.Equal(t, subscriptionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE, resumed.Msg.GetStatus())
+
+	cancelled, err := client.CancelSubscription(ctx, connect.NewRequest(&subscriptionv1.CancelSubscriptionRequest{Id: id}))
+	require.NoError(t, err, "CancelSubscription failed")
+	require.Equal(t, subscriptionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELLED, cancelled.Msg.GetStatus())
+	require.NotNil(t, cancelled.Msg.GetCancelTime())
+	require.Nil(t, cancelled.Msg.GetNextBillingDate())
+
+	_, err = client.ResumeSubscription(ctx, connect.NewRequest(&subscriptionv1.ResumeSubscriptionRequest{Id: id}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	_, err = client.CreateSubscription(ctx, connect.NewRequest(&subscriptionv1.CreateSubscriptionRequest{
+		Subscription: &subscriptionv1.Subscription{
+			Name:      "bad-anchor",
+			Price:     &money.Money{CurrencyCode: "USD", Units: 1},
+			Interval:  subscriptionv1.BillingInterval_BILLING_INTERVAL_WEEKLY,
+			AnchorDay: 8,
+		},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
@@ -0,0 +1,74 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"github.com/grpc-buf/internal/gen/proto/payment/paymentv1connect"
+	tokenizationv1 "github.com/grpc-buf/internal/gen/proto/tokenization"
+	"github.com/grpc-buf/internal/gen/proto/tokenization/tokenizationv1connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+func TestTokenizeCard(t *testing.T) {
+	client := tokenizationv1connect.NewTokenizationServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	payments := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+	expYear := int32(time.Now().Year() + 2)
+
+	res, err := client.TokenizeCard(ctx, connect.NewRequest(&tokenizationv1.TokenizeCardRequest{
+		Pan:      "4242 4242 4242 4242",
+		ExpMonth: 12,
+		ExpYear:  expYear,
+		Cvc:      "123",
+	}))
+	require.NoError(t, err, "TokenizeCard failed")
+	tok := res.Msg
+	require.True(t, strings.HasPrefix(tok.GetToken(), "tok_"), "token %q", tok.GetToken())
+	require.NotContains(t, tok.GetToken(), "4242424242424242")
+	require.Equal(t, "4242", tok.GetLast4())
+	require.Equal(t, expYear, tok.GetExpYear())
+	require.Equal(t, int(expYear)+1, tok.GetExpireTime().AsTime().Year())
+
+	paid, err := payments.MakePayment(ctx, connect.NewRequest(&paymentv1.PaymentRequest{
+		CardToken:    tok.GetToken(),
+		Card:         paymentv1.CardType_CARD_TYPE_CREDIT,
+		Name:         "Vaulted Card",
+		AddressLines: []string{"1 Vault Street"},
+		Amount:       &money.Money{CurrencyCode: "USD", Units: 5},
+	}))
+	require.NoError(t, err, "MakePayment with a vault token failed")
+	require.NotEmpty(t, paid.Msg.GetId())
+
+	tests := []struct {
+		name string
+		req  *tokenizationv1.TokenizeCardRequest
+	}{
+		{name: "luhn", req: &tokenizationv1.TokenizeCardRequest{Pan: "4242424242424241", ExpMonth: 12, ExpYear: expYear, Cvc: "123"}},
+		{name: "expired", req: &tokenizationv1.TokenizeCardRequest{Pan: "4242424242424242", ExpMonth: 1, ExpYear: 2020, Cvc: "123"}},
+		{name: "cvc", req: &tokenizationv1.TokenizeCardRequest{Pan: "4242424242424242", ExpMonth: 12, ExpYear: expYear}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.TokenizeCard(ctx, connect.NewRequest(tt.req))
+			require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		})
+	}
+}
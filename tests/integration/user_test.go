@@ -7,10 +7,12 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	userv1 "github.com/grpc-buf/internal/gen/proto/registration"
 	"github.com/grpc-buf/internal/gen/proto/registration/userv1connect"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/require"
 )
 
@@ -45,3 +47,161 @@ func TestUsersLogin(t *testing.T) {
 	require.NoError(t, err)
 	t.Log(res.Msg)
 }
+
+func TestUsersRefreshTokenRotation(t *testing.T) {
+	client := userv1connect.NewUserServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+
+	_, err := client.RegisterUser(ctx, connect.NewRequest(&userv1.RegisterRequest{
+		Email:    "refresh@example.com",
+		Password: "Password1",
+	}))
+	if connect.CodeOf(err) != connect.CodeAlreadyExists {
+		require.NoError(t, err)
+	}
+	login, err := client.LoginUser(ctx, connect.NewRequest(&userv1.LoginRequest{
+		Email:    "refresh@example.com",
+		Password: "Password1",
+	}))
+	require.NoError(t, err)
+	first := login.Msg.GetRefreshToken()
+	require.NotEmpty(t, first)
+	require.NotNil(t, login.Msg.GetAccessTokenExpireTime())
+
+	rotated, err := client.RefreshToken(ctx, connect.NewRequest(&userv1.RefreshTokenRequest{RefreshToken: first}))
+	require.NoError(t, err, "RefreshToken failed")
+	second := rotated.Msg.GetRefreshToken()
+	require.NotEmpty(t, rotated.Msg.GetAccessToken())
+	require.NotEqual(t, first, second)
+
+	_, err = client.RefreshToken(ctx, connect.NewRequest(&userv1.RefreshTokenRequest{RefreshToken: first}))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "reusing a rotated token must fail")
+
+	_, err = client.RefreshToken(ctx, connect.NewRequest(&userv1.RefreshTokenRequest{RefreshToken: second}))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "reuse must revoke the whole family")
+}
+
+func TestUsersLogoutRevokesAccessToken(t *testing.T) {
+	client := userv1connect.NewUserServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+
+	_, err := client.RegisterUser(ctx, connect.NewRequest(&userv1.RegisterRequest{
+		Email:    "logout@example.com",
+		Password: "Password1",
+	}))
+	if connect.CodeOf(err) != connect.CodeAlreadyExists {
+		require.NoError(t, err)
+	}
+	login, err := client.LoginUser(ctx, connect.NewRequest(&userv1.LoginRequest{
+		Email:    "logout@example.com",
+		Password: "Password1",
+	}))
+	require.NoError(t, err)
+
+	logout := func() error {
+		req := connect.NewRequest(&userv1.LogoutRequest{RefreshToken: login.Msg.GetRefreshToken()})
+		req.Header().Set("Authorization", "Bearer "+login.Msg.GetAccessToken())
+		_, err := client.LogoutUser(ctx, req)
+		return err
+	}
+	require.NoError(t, logout(), "LogoutUser failed")
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(logout()), "a logged-out access token must be rejected")
+
+	_, err = client.RefreshToken(ctx, connect.NewRequest(&userv1.RefreshTokenRequest{RefreshToken: login.Msg.GetRefreshToken()}))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "logout must revoke the refresh token")
+}
+
+func TestUsersRevokeSessionsRequiresAdmin(t *testing.T) {
+	client := userv1connect.NewUserServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+		loginAs(t, "no-roles@example.com"),
+	)
+	_, err := client.RevokeUserSessions(context.Background(), connect.NewRequest(&userv1.RevokeUserSessionsRequest{
+		UserId: "00000000-0000-0000-0000-000000000001",
+	}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), "plain users must not revoke sessions")
+}
+
+func TestUsersRevokeSessionsWithinOneSecond(t *testing.T) {
+	const email = "revoked@example.com"
+	client := userv1connect.NewUserServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	admin := userv1connect.NewUserServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+		loginWithRoles(t, "revoke-admin@example.com", "user", "admin"),
+	)
+	ctx := context.Background()
+	_, err := client.RegisterUser(ctx, connect.NewRequest(&userv1.RegisterRequest{Email: email, Password: "Password1"}))
+	if connect.CodeOf(err) != connect.CodeAlreadyExists {
+		require.NoError(t, err)
+	}
+	login := func() *userv1.LoginResponse {
+		t.Helper()
+		res, err := client.LoginUser(ctx, connect.NewRequest(&userv1.LoginRequest{Email: email, Password: "Password1"}))
+		require.NoError(t, err)
+		return res.Msg
+	}
+	logout := func(token string) error {
+		req := connect.NewRequest(&userv1.LogoutRequest{})
+		req.Header().Set("Authorization", "Bearer "+token)
+		_, err := client.LogoutUser(ctx, req)
+		return err
+	}
+
+	conn, err := pgx.Connect(ctx, testDSN())
+	require.NoError(t, err, "connect to database")
+	defer conn.Close(ctx)
+	var userID string
+	require.NoError(t, conn.QueryRow(ctx, `SELECT id FROM users WHERE email=$1`, email).Scan(&userID))
+
+	// Start at the top of a second so the login, the revocation and the login
+	// after it all land in the same second.
+	time.Sleep(time.Until(time.Now().Truncate(time.Second).Add(time.Second)))
+	old := login()
+	_, err = admin.RevokeUserSessions(ctx, connect.NewRequest(&userv1.RevokeUserSessionsRequest{UserId: userID}))
+	require.NoError(t, err, "RevokeUserSessions failed")
+	fresh := login()
+
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(logout(old.GetAccessToken())), "a token issued just before the revocation must be rejected")
+	require.NoError(t, logout(fresh.GetAccessToken()), "a login after the revocation must stay valid")
+}
+
+// loginAs registers email (if needed) and logs in, returning a client option
+// that sends the access token on every call.
+func loginAs(t *testing.T, email string) connect.ClientOption {
+	t.Helper()
+	client := userv1connect.NewUserServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+	_, err := client.RegisterUser(ctx, connect.NewRequest(&userv1.RegisterRequest{Email: email, Password: "Password1"}))
+	if connect.CodeOf(err) != connect.CodeAlreadyExists {
+		require.NoError(t, err)
+	}
+	login, err := client.LoginUser(ctx, connect.NewRequest(&userv1.LoginRequest{Email: email, Password: "Password1"}))
+	require.NoError(t, err)
+	token := login.Msg.GetAccessToken()
+	return connect.WithInterceptors(connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer "+token)
+			return next(ctx, req)
+		}
+	}))
+}
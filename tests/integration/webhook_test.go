@@ -0,0 +1,88 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	paymentv1 "github.com/grpc-buf/internal/gen/proto/payment"
+	"github.com/grpc-buf/internal/gen/proto/payment/paymentv1connect"
+	webhookv1 "github.com/grpc-buf/internal/gen/proto/webhook"
+	"github.com/grpc-buf/internal/gen/proto/webhook/webhookv1connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+func TestWebhookDeliveries(t *testing.T) {
+	hooks := webhookv1connect.NewWebhookServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	payments := paymentv1connect.NewPaymentServiceClient(
+		http.DefaultClient,
+		"http://localhost:8080",
+		connect.WithGRPC(),
+	)
+	ctx := context.Background()
+
+	_, err := hooks.CreateWebhookEndpoint(ctx, connect.NewRequest(&webhookv1.CreateWebhookEndpointRequest{
+		Endpoint: &webhookv1.WebhookEndpoint{
+			Url:        "not a url",
+			EventTypes: []webhookv1.WebhookEventType{webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_INVOICE_PAID},
+		},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	// Nothing listens on port 1, so deliveries stay pending with retries.
+	ep, err := hooks.CreateWebhookEndpoint(ctx, connect.NewRequest(&webhookv1.CreateWebhookEndpointRequest{
+		Endpoint: &webhookv1.WebhookEndpoint{
+			Url:         "http://127.0.0.1:1/hook",
+			EventTypes:  []webhookv1.WebhookEventType{webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_INVOICE_PAID},
+			Description: "integration",
+		},
+	}))
+	require.NoError(t, err, "CreateWebhookEndpoint failed")
+	require.Contains(t, ep.Msg.GetSecret(), "whsec_")
+
+	inv, err := payments.CreateInvoice(ctx, connect.NewRequest(&paymentv1.CreateInvoiceRequest{
+		Invoice: &paymentv1.Invoice{
+			InvoiceName: "integration-webhook-invoice",
+			LineItems: []*paymentv1.InvoiceLineItem{
+				{Description: "Webhook test", Quantity: 1, UnitPrice: &money.Money{CurrencyCode: "USD", Units: 7}},
+			},
+		},
+	}))
+	require.NoError(t, err, "CreateInvoice failed")
+	_, err = payments.MarkInvoicePaid(ctx, connect.NewRequest(&paymentv1.MarkInvoicePaidRequest{Id: inv.Msg.GetId()}))
+	require.NoError(t, err, "MarkInvoicePaid failed")
+
+	list, err := hooks.ListWebhookDeliveries(ctx, connect.NewRequest(&webhookv1.ListWebhookDeliveriesRequest{
+		EndpointId: ep.Msg.GetId(),
+	}))
+	require.NoError(t, err, "ListWebhookDeliveries failed")
+	require.Len(t, list.Msg.GetDeliveries(), 1)
+	dl := list.Msg.GetDeliveries()[0]
+	require.Equal(t, webhookv1.WebhookEventType_WEBHOOK_EVENT_TYPE_INVOICE_PAID, dl.GetEventType())
+	require.Equal(t, webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING, dl.GetStatus())
+
+	_, err = hooks.ReplayWebhookDelivery(ctx, connect.NewRequest(&webhookv1.ReplayWebhookDeliveryRequest{Id: dl.GetId()}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "pending deliveries cannot be replayed")
+
+	_, err = hooks.DeleteWebhookEndpoint(ctx, connect.NewRequest(&webhookv1.DeleteWebhookEndpointRequest{Id: ep.Msg.GetId()}))
+	require.NoError(t, err, "DeleteWebhookEndpoint failed")
+
+	failed, err := hooks.ListWebhookDeliveries(ctx, connect.NewRequest(&webhookv1.ListWebhookDeliveriesRequest{
+		EndpointId: ep.Msg.GetId(),
+		Status:     webhookv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED,
+	}))
+	require.NoError(t, err, "ListWebhookDeliveries failed")
+	require.Len(t, failed.Msg.GetDeliveries(), 1)
+
+	_, err = hooks.ReplayWebhookDelivery(ctx, connect.NewRequest(&webhookv1.ReplayWebhookDeliveryRequest{Id: dl.GetId()}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "deliveries to deleted endpoints cannot be replayed")
+}